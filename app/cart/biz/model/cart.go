@@ -25,6 +25,7 @@ type Cart struct {
 	Base
 	UserId    uint32 `json:"user_id"`
 	ProductId uint32 `json:"product_id"`
+	SkuId     uint32 `json:"sku_id"`
 	Qty       uint32 `json:"qty"`
 }
 
@@ -39,12 +40,12 @@ func GetCartByUserId(db *gorm.DB, ctx context.Context, userId uint32) (cartList
 
 func AddCart(db *gorm.DB, ctx context.Context, c *Cart) error {
 	var find Cart
-	err := db.WithContext(ctx).Model(&Cart{}).Where(&Cart{UserId: c.UserId, ProductId: c.ProductId}).First(&find).Error
+	err := db.WithContext(ctx).Model(&Cart{}).Where(map[string]any{"user_id": c.UserId, "product_id": c.ProductId, "sku_id": c.SkuId}).First(&find).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
 	if find.ID != 0 {
-		err = db.WithContext(ctx).Model(&Cart{}).Where(&Cart{UserId: c.UserId, ProductId: c.ProductId}).UpdateColumn("qty", gorm.Expr("qty+?", c.Qty)).Error
+		err = db.WithContext(ctx).Model(&Cart{}).Where(map[string]any{"user_id": c.UserId, "product_id": c.ProductId, "sku_id": c.SkuId}).UpdateColumn("qty", gorm.Expr("qty+?", c.Qty)).Error
 	} else {
 		err = db.WithContext(ctx).Model(&Cart{}).Create(c).Error
 	}
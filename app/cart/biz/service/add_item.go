@@ -44,10 +44,18 @@ func (s *AddItemService) Run(req *cart.AddItemReq) (resp *cart.AddItemResp, err
 	if getProduct.Product == nil || getProduct.Product.Id == 0 {
 		return nil, kerrors.NewBizStatusError(40004, "product not exist")
 	}
+	sku, err := pickSku(getProduct.Product, req.Item.GetSkuId())
+	if err != nil {
+		return nil, err
+	}
+	if sku.Stock < req.Item.Quantity {
+		return nil, kerrors.NewBizStatusError(40009, "insufficient stock")
+	}
 
 	err = model.AddCart(mysql.DB, s.ctx, &model.Cart{
 		UserId:    req.UserId,
 		ProductId: req.Item.ProductId,
+		SkuId:     sku.Id,
 		Qty:       uint32(req.Item.Quantity),
 	})
 	if err != nil {
@@ -56,3 +64,19 @@ func (s *AddItemService) Run(req *cart.AddItemReq) (resp *cart.AddItemResp, err
 
 	return &cart.AddItemResp{}, nil
 }
+
+// pickSku finds the sku of p; products with a single sku may omit the id.
+func pickSku(p *product.Product, skuId uint32) (*product.Sku, error) {
+	if skuId == 0 {
+		if len(p.Skus) == 1 {
+			return p.Skus[0], nil
+		}
+		return nil, kerrors.NewBizStatusError(40000, "sku is required")
+	}
+	for _, sku := range p.Skus {
+		if sku.Id == skuId {
+			return sku, nil
+		}
+	}
+	return nil, kerrors.NewBizStatusError(40004, "sku not exist")
+}
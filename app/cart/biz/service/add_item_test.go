@@ -16,7 +16,26 @@ package service
 
 import (
 	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 )
 
 func TestAddItem_Run(t *testing.T) {
 }
+
+func TestPickSku(t *testing.T) {
+	single := &product.Product{Skus: []*product.Sku{{Id: 1}}}
+	if sku, err := pickSku(single, 0); err != nil || sku.Id != 1 {
+		t.Errorf("pickSku(single, 0) = %v, %v", sku, err)
+	}
+	variants := &product.Product{Skus: []*product.Sku{{Id: 3}, {Id: 4}}}
+	if _, err := pickSku(variants, 0); err == nil {
+		t.Error("expected sku to be required for variants")
+	}
+	if sku, err := pickSku(variants, 4); err != nil || sku.Id != 4 {
+		t.Errorf("pickSku(variants, 4) = %v, %v", sku, err)
+	}
+	if _, err := pickSku(variants, 5); err == nil {
+		t.Error("expected unknown sku to be rejected")
+	}
+}
@@ -40,7 +40,7 @@ func (s *GetCartService) Run(req *cart.GetCartReq) (resp *cart.GetCartResp, err
 	}
 	var items []*cart.CartItem
 	for _, v := range carts {
-		items = append(items, &cart.CartItem{ProductId: v.ProductId, Quantity: int32(v.Qty)})
+		items = append(items, &cart.CartItem{ProductId: v.ProductId, SkuId: v.SkuId, Quantity: int32(v.Qty)})
 	}
 
 	return &cart.GetCartResp{Cart: &cart.Cart{UserId: req.GetUserId(), Items: items}}, nil
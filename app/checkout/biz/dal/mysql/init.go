@@ -15,9 +15,12 @@
 package mysql
 
 import (
+	"os"
+
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
 )
 
@@ -36,4 +39,10 @@ func Init() {
 	if err != nil {
 		panic(err)
 	}
+	if os.Getenv("GO_ENV") != "online" {
+		DB.AutoMigrate( //nolint:errcheck
+			&model.Review{},
+			&model.CheckoutJob{},
+		)
+	}
 }
@@ -0,0 +1,77 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CheckoutJob is a checkout that is still running, or that left its last
+// steps to the recovery. It records the order the checkout placed, so that a
+// checkout interrupted by a crash or a restart can be finished or undone by
+// another instance; stock and coupon redemptions are reserved under the
+// checkout id and released by it. UpdatedAt is the heartbeat of the instance
+// running it.
+type CheckoutJob struct {
+	Base
+	CheckoutId string `gorm:"uniqueIndex;size:64"`
+	UserId     uint32
+	// OrderId is chosen before the order is placed, it may not exist.
+	OrderId string `gorm:"size:256"`
+	// Held is set when the order is held for a manual review.
+	Held bool
+	// Notification is the order confirmation, published when a paid order
+	// is completed by the recovery, empty once it was published.
+	Notification []byte
+}
+
+func (j CheckoutJob) TableName() string {
+	return "checkout_job"
+}
+
+func CreateCheckoutJob(db *gorm.DB, ctx context.Context, j *CheckoutJob) error {
+	return db.WithContext(ctx).Create(j).Error
+}
+
+// UpdateCheckoutJob updates the fields of a job and its heartbeat.
+func UpdateCheckoutJob(db *gorm.DB, ctx context.Context, checkoutId string, fields map[string]any) error {
+	return db.WithContext(ctx).Model(&CheckoutJob{}).Where("checkout_id = ?", checkoutId).Updates(fields).Error
+}
+
+// TouchCheckoutJob refreshes the heartbeat of a job.
+func TouchCheckoutJob(db *gorm.DB, ctx context.Context, checkoutId string) error {
+	return db.WithContext(ctx).Model(&CheckoutJob{}).Where("checkout_id = ?", checkoutId).Update("updated_at", time.Now()).Error
+}
+
+func DeleteCheckoutJob(db *gorm.DB, ctx context.Context, checkoutId string) error {
+	return db.WithContext(ctx).Where("checkout_id = ?", checkoutId).Delete(&CheckoutJob{}).Error
+}
+
+// GetStaleCheckoutJobs returns jobs whose heartbeat stopped before the given
+// time, oldest first.
+func GetStaleCheckoutJobs(db *gorm.DB, ctx context.Context, before time.Time, limit int) (jobs []CheckoutJob, err error) {
+	err = db.WithContext(ctx).Where("updated_at < ?", before).Order("updated_at").Limit(limit).Find(&jobs).Error
+	return
+}
+
+// ClaimCheckoutJob refreshes the heartbeat of a stale job and reports whether
+// it was still stale, so only one instance recovers it.
+func ClaimCheckoutJob(db *gorm.DB, ctx context.Context, id int, before time.Time) (bool, error) {
+	res := db.WithContext(ctx).Model(&CheckoutJob{}).Where("id = ? AND updated_at < ?", id, before).Update("updated_at", time.Now())
+	return res.RowsAffected > 0, res.Error
+}
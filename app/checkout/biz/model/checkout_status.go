@@ -0,0 +1,82 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Checkout states.
+const (
+	CheckoutProcessing = "processing"
+	CheckoutSucceeded  = "succeeded"
+	CheckoutFailed     = "failed"
+	CheckoutReview     = "review" // the order is placed but held for a manual review
+)
+
+// Checkout steps, in the order they run.
+const (
+	StepPricing    = "pricing"
+	StepReserving  = "reserving"
+	StepOrdering   = "ordering"
+	StepPaying     = "paying"
+	StepConfirming = "confirming"
+)
+
+// checkoutStatusTTL is how long the status of a checkout can be looked up.
+const checkoutStatusTTL = 24 * time.Hour
+
+// CheckoutStatus is the progress of an asynchronous checkout.
+type CheckoutStatus struct {
+	Id            string    `json:"id"`
+	UserId        uint32    `json:"user_id"`
+	State         string    `json:"state"`
+	Step          string    `json:"step"`
+	Error         string    `json:"error,omitempty"`
+	OrderId       string    `json:"order_id,omitempty"`
+	TransactionId string    `json:"transaction_id,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func checkoutStatusKey(id string) string {
+	return "checkout:status:" + id
+}
+
+// SaveCheckoutStatus stores the status and stamps its UpdatedAt.
+func SaveCheckoutStatus(rdb *redis.Client, ctx context.Context, st *CheckoutStatus) error {
+	st.UpdatedAt = time.Now()
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	return rdb.Set(ctx, checkoutStatusKey(st.Id), data, checkoutStatusTTL).Err()
+}
+
+// GetCheckoutStatus returns redis.Nil when there is no checkout with the id.
+func GetCheckoutStatus(rdb *redis.Client, ctx context.Context, id string) (*CheckoutStatus, error) {
+	data, err := rdb.Get(ctx, checkoutStatusKey(id)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	st := &CheckoutStatus{}
+	if err = json.Unmarshal(data, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
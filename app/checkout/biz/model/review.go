@@ -0,0 +1,75 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Review states.
+const (
+	ReviewPending  = "pending"
+	ReviewApproved = "approved"
+	ReviewRejected = "rejected"
+)
+
+// Review is an order that checkout held for a manual review because of its
+// risk score, with what it takes to finish or undo the checkout.
+type Review struct {
+	Base
+	OrderId string `gorm:"uniqueIndex;size:256"`
+	UserId  uint32
+	Score   int
+	// Reasons names the risk rules that scored, comma separated.
+	Reasons string
+	State   string `gorm:"size:16"`
+	// Stock and RedemptionIds hold, as JSON, the stock and coupon
+	// redemptions the checkout reserved, released when the order is rejected.
+	Stock         string `gorm:"type:text"`
+	RedemptionIds string `gorm:"type:text"`
+	// Notification is the order confirmation, published once the order is
+	// approved.
+	Notification []byte
+	Reviewer     string
+	Note         string `gorm:"type:text"`
+}
+
+func (r Review) TableName() string {
+	return "order_review"
+}
+
+func CreateReview(db *gorm.DB, ctx context.Context, r *Review) error {
+	return db.WithContext(ctx).Create(r).Error
+}
+
+func GetReview(db *gorm.DB, ctx context.Context, orderId string) (r Review, err error) {
+	err = db.WithContext(ctx).Where(&Review{OrderId: orderId}).First(&r).Error
+	return
+}
+
+func DeleteReview(db *gorm.DB, ctx context.Context, orderId string) error {
+	return db.WithContext(ctx).Where(&Review{OrderId: orderId}).Delete(&Review{}).Error
+}
+
+// UpdateReviewState moves a review from one state to another, recording who
+// reviewed it, and reports whether it was still in the from state.
+func UpdateReviewState(db *gorm.DB, ctx context.Context, orderId, from, to, reviewer, note string) (bool, error) {
+	res := db.WithContext(ctx).Model(&Review{}).
+		Where("order_id = ? AND state = ?", orderId, from).
+		Updates(map[string]any{"state": to, "reviewer": reviewer, "note": note})
+	return res.RowsAffected > 0, res.Error
+}
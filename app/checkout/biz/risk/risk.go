@@ -0,0 +1,179 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package risk scores orders for fraud before their payment is captured. The
+// scores of the rules of an Engine add up, and the total decides whether an
+// order is approved, held for a manual review or rejected.
+package risk
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gopkg.in/yaml.v2"
+)
+
+// Decisions.
+const (
+	Approve = "approve"
+	Review  = "review"
+	Reject  = "reject"
+)
+
+// Address is the part of an address the rules compare.
+type Address struct {
+	Country string
+	ZipCode string
+}
+
+// Order is what the rules know of an order.
+type Order struct {
+	UserId uint32
+	Email  string
+	// CardNumber only keys the velocity of the card, it is never stored.
+	CardNumber string
+	IP         string
+	// Total is in currency.Base, so thresholds hold for every currency.
+	Total    float64
+	Shipping Address
+	// Billing is nil when the card is billed to the shipping address.
+	Billing *Address
+}
+
+// Rule scores one risk signal of an order, 0 when the order does not show it.
+type Rule interface {
+	Name() string
+	Score(ctx context.Context, o *Order) (int, error)
+}
+
+// Result is the assessment of an order.
+type Result struct {
+	Score int
+	// Reasons names the rules that scored.
+	Reasons  []string
+	Decision string
+}
+
+// Engine assesses orders with its rules. From ReviewScore on an order is
+// held for review and from RejectScore on it is rejected; 0 disables either.
+type Engine struct {
+	Rules       []Rule
+	ReviewScore int
+	RejectScore int
+}
+
+// Assess scores the order with every rule. A rule that fails is logged and
+// skipped, a broken rule should not stop every sale.
+func (e *Engine) Assess(ctx context.Context, o *Order) Result {
+	res := Result{Decision: Approve}
+	if e == nil {
+		return res
+	}
+	for _, r := range e.Rules {
+		score, err := r.Score(ctx, o)
+		if err != nil {
+			klog.CtxWarnf(ctx, "risk rule %s failed: %v", r.Name(), err)
+			continue
+		}
+		if score != 0 {
+			res.Score += score
+			res.Reasons = append(res.Reasons, r.Name())
+		}
+	}
+	switch {
+	case e.RejectScore > 0 && res.Score >= e.RejectScore:
+		res.Decision = Reject
+	case e.ReviewScore > 0 && res.Score >= e.ReviewScore:
+		res.Decision = Review
+	}
+	return res
+}
+
+// Config is the risk configuration of the store.
+type Config struct {
+	ReviewScore int          `yaml:"review_score"`
+	RejectScore int          `yaml:"reject_score"`
+	Rules       []RuleConfig `yaml:"rules"`
+}
+
+// Builder makes a rule of a type from its configuration. Velocity rules count
+// orders with counter.
+type Builder func(c RuleConfig, counter Counter) (Rule, error)
+
+var builders = map[string]Builder{
+	"velocity": newVelocityRule,
+	"mismatch": newMismatchRule,
+	"amount":   newAmountRule,
+	"denylist": newDenylistRule,
+}
+
+// Register adds a rule type, or replaces the builder of one.
+func Register(typ string, b Builder) {
+	builders[typ] = b
+}
+
+// Build makes the engine of a configuration.
+func Build(c *Config, counter Counter) (*Engine, error) {
+	e := &Engine{ReviewScore: c.ReviewScore, RejectScore: c.RejectScore}
+	for i, rc := range c.Rules {
+		b, ok := builders[rc.Type]
+		if !ok {
+			return nil, fmt.Errorf("rule %d has unknown type %q", i, rc.Type)
+		}
+		r, err := b(rc, counter)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d (%s): %w", i, rc.Type, err)
+		}
+		e.Rules = append(e.Rules, r)
+	}
+	return e, nil
+}
+
+// Default is the engine of the store, built by Init.
+var Default = &Engine{}
+
+// Init builds Default from the rules file of the configuration. Without a
+// rules file every order is approved.
+func Init(counter Counter) {
+	path := conf.GetConf().Risk.RulesFile
+	if path == "" {
+		klog.Warn("no risk rules file configured, orders are not assessed")
+		return
+	}
+	e, err := Load(path, counter)
+	if err != nil {
+		panic(err)
+	}
+	Default = e
+}
+
+// Load reads the configuration from a yaml file and builds its engine.
+func Load(path string, counter Counter) (*Engine, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	if err = yaml.Unmarshal(content, c); err != nil {
+		return nil, err
+	}
+	e, err := Build(c, counter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return e, nil
+}
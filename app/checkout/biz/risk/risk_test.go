@@ -0,0 +1,122 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package risk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// memCounter counts in a single window that never ends.
+type memCounter map[string]int64
+
+func (c memCounter) Incr(_ context.Context, key string, _ time.Duration) (int64, error) {
+	c[key]++
+	return c[key], nil
+}
+
+func TestAssess(t *testing.T) {
+	e, err := Build(&Config{
+		ReviewScore: 50,
+		RejectScore: 100,
+		Rules: []RuleConfig{
+			{Type: "mismatch", Score: 25},
+			{Type: "amount", Threshold: 1000, Score: 30},
+			{Type: "denylist", Emails: []string{"@mailinator.com"}, Score: 100},
+		},
+	}, memCounter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	shipping := Address{Country: "China", ZipCode: "100000"}
+
+	res := e.Assess(ctx, &Order{Email: "a@example.com", Total: 99, Shipping: shipping})
+	if res.Decision != Approve || res.Score != 0 || res.Reasons != nil {
+		t.Errorf("Assess = %+v, want approve", res)
+	}
+	res = e.Assess(ctx, &Order{Email: "a@example.com", Total: 2000, Shipping: shipping, Billing: &Address{Country: "US", ZipCode: "10001"}})
+	want := Result{Score: 55, Reasons: []string{"billing_mismatch", "amount_over_1000"}, Decision: Review}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("Assess = %+v, want %+v", res, want)
+	}
+	res = e.Assess(ctx, &Order{Email: " A@Mailinator.com", Total: 10, Shipping: shipping})
+	if res.Decision != Reject || res.Score != 100 {
+		t.Errorf("Assess = %+v, want reject", res)
+	}
+}
+
+func TestVelocity(t *testing.T) {
+	counter := memCounter{}
+	r, err := newVelocityRule(RuleConfig{Key: "card", Window: time.Hour, Limit: 2, Score: 40}, counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	o := &Order{CardNumber: "4111111111111111"}
+	for i, want := range []int{0, 0, 40, 40} {
+		if got, _ := r.Score(ctx, o); got != want {
+			t.Errorf("order %d scored %d, want %d", i+1, got, want)
+		}
+	}
+	for key := range counter {
+		if key == "card:"+o.CardNumber {
+			t.Error("the card number is counted in the clear")
+		}
+	}
+	if got, _ := r.Score(ctx, &Order{}); got != 0 {
+		t.Errorf("order without card scored %d", got)
+	}
+
+	if _, err := newVelocityRule(RuleConfig{Key: "email", Window: time.Hour, Limit: 2}, counter); err == nil {
+		t.Error("newVelocityRule accepted key email")
+	}
+}
+
+func TestMismatch(t *testing.T) {
+	r := &mismatchRule{score: 25}
+	ctx := context.Background()
+	shipping := Address{Country: "China", ZipCode: "100000"}
+	for _, tc := range []struct {
+		billing *Address
+		want    int
+	}{
+		{nil, 0},
+		{&Address{Country: "china "}, 0},
+		{&Address{Country: "China", ZipCode: "100000"}, 0},
+		{&Address{Country: "China", ZipCode: "200000"}, 25},
+		{&Address{Country: "US"}, 25},
+	} {
+		if got, _ := r.Score(ctx, &Order{Shipping: shipping, Billing: tc.billing}); got != tc.want {
+			t.Errorf("billing %+v scored %d, want %d", tc.billing, got, tc.want)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	e, err := Load("../../conf/risk.yaml", memCounter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.ReviewScore != 50 || e.RejectScore != 100 || len(e.Rules) == 0 {
+		t.Errorf("Load = %+v", e)
+	}
+
+	if _, err := Build(&Config{Rules: []RuleConfig{{Type: "geoip"}}}, nil); err == nil {
+		t.Error("Build accepted an unknown rule type")
+	}
+}
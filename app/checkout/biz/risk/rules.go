@@ -0,0 +1,209 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package risk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RuleConfig configures a rule. Type picks the rule, Score is what it adds
+// when the order shows its signal; the other fields belong to single types.
+type RuleConfig struct {
+	Type  string `yaml:"type"`
+	Score int    `yaml:"score"`
+	// Key is what a velocity rule counts orders of: user, card or ip. More
+	// than Limit orders within Window score.
+	Key    string        `yaml:"key"`
+	Window time.Duration `yaml:"window"`
+	Limit  int64         `yaml:"limit"`
+	// Threshold is the order total, in currency.Base, above which an amount
+	// rule scores.
+	Threshold float64 `yaml:"threshold"`
+	// Emails denied by a denylist rule; "@example.com" denies a domain.
+	Emails []string `yaml:"emails"`
+}
+
+// Counter counts events per key in fixed windows.
+type Counter interface {
+	// Incr counts an event of key and returns the events of the current
+	// window, this one included.
+	Incr(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+// RedisCounter keeps the counts in redis, so they are shared by every
+// checkout instance.
+type RedisCounter struct {
+	Client *redis.Client
+}
+
+func (c RedisCounter) Incr(ctx context.Context, key string, window time.Duration) (int64, error) {
+	k := fmt.Sprintf("risk:velocity:%s:%d", key, time.Now().UnixNano()/int64(window))
+	pipe := c.Client.TxPipeline()
+	n := pipe.Incr(ctx, k)
+	pipe.Expire(ctx, k, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return n.Val(), nil
+}
+
+// velocityRule scores users, cards or ips that order too often.
+type velocityRule struct {
+	key     string
+	window  time.Duration
+	limit   int64
+	score   int
+	counter Counter
+}
+
+func newVelocityRule(c RuleConfig, counter Counter) (Rule, error) {
+	switch c.Key {
+	case "user", "card", "ip":
+	default:
+		return nil, fmt.Errorf("key must be user, card or ip, not %q", c.Key)
+	}
+	if c.Window <= 0 || c.Limit <= 0 {
+		return nil, errors.New("window and limit must be positive")
+	}
+	if counter == nil {
+		return nil, errors.New("no counter")
+	}
+	return &velocityRule{key: c.Key, window: c.Window, limit: c.Limit, score: c.Score, counter: counter}, nil
+}
+
+func (r *velocityRule) Name() string {
+	return "velocity_" + r.key
+}
+
+func (r *velocityRule) Score(ctx context.Context, o *Order) (int, error) {
+	var value string
+	switch r.key {
+	case "user":
+		if o.UserId != 0 {
+			value = strconv.FormatUint(uint64(o.UserId), 10)
+		}
+	case "card":
+		if o.CardNumber != "" {
+			sum := sha256.Sum256([]byte(o.CardNumber))
+			value = hex.EncodeToString(sum[:])
+		}
+	case "ip":
+		value = o.IP
+	}
+	if value == "" {
+		return 0, nil
+	}
+	n, err := r.counter.Incr(ctx, r.key+":"+value, r.window)
+	if err != nil || n <= r.limit {
+		return 0, err
+	}
+	return r.score, nil
+}
+
+// mismatchRule scores cards billed to another country or zip code than the
+// order ships to.
+type mismatchRule struct {
+	score int
+}
+
+func newMismatchRule(c RuleConfig, _ Counter) (Rule, error) {
+	return &mismatchRule{score: c.Score}, nil
+}
+
+func (r *mismatchRule) Name() string {
+	return "billing_mismatch"
+}
+
+func (r *mismatchRule) Score(_ context.Context, o *Order) (int, error) {
+	b, s := o.Billing, o.Shipping
+	if b == nil {
+		return 0, nil
+	}
+	if differ(b.Country, s.Country) || differ(b.ZipCode, s.ZipCode) {
+		return r.score, nil
+	}
+	return 0, nil
+}
+
+// differ reports whether two values are set and not equal.
+func differ(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	return a != "" && b != "" && !strings.EqualFold(a, b)
+}
+
+// amountRule scores orders above the usual order value.
+type amountRule struct {
+	threshold float64
+	score     int
+}
+
+func newAmountRule(c RuleConfig, _ Counter) (Rule, error) {
+	if c.Threshold <= 0 {
+		return nil, errors.New("threshold must be positive")
+	}
+	return &amountRule{threshold: c.Threshold, score: c.Score}, nil
+}
+
+func (r *amountRule) Name() string {
+	return "amount_over_" + strconv.FormatFloat(r.threshold, 'f', -1, 64)
+}
+
+func (r *amountRule) Score(_ context.Context, o *Order) (int, error) {
+	if o.Total > r.threshold {
+		return r.score, nil
+	}
+	return 0, nil
+}
+
+// denylistRule scores orders from denied emails or email domains.
+type denylistRule struct {
+	emails map[string]bool
+	score  int
+}
+
+func newDenylistRule(c RuleConfig, _ Counter) (Rule, error) {
+	r := &denylistRule{emails: make(map[string]bool), score: c.Score}
+	for _, e := range c.Emails {
+		r.emails[strings.ToLower(strings.TrimSpace(e))] = true
+	}
+	return r, nil
+}
+
+func (r *denylistRule) Name() string {
+	return "denylist"
+}
+
+func (r *denylistRule) Score(_ context.Context, o *Order) (int, error) {
+	email := strings.ToLower(strings.TrimSpace(o.Email))
+	if email == "" {
+		return 0, nil
+	}
+	if r.emails[email] {
+		return r.score, nil
+	}
+	if i := strings.LastIndex(email, "@"); i >= 0 && r.emails[email[i:]] {
+		return r.score, nil
+	}
+	return 0, nil
+}
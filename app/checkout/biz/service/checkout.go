@@ -16,10 +16,21 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/risk"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/shipping"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/metrics"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
@@ -28,16 +39,30 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/nats-io/nats.go"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 )
 
+var (
+	errCartEmpty = errors.New("cart is empty")
+	// errPaymentUnknown 表示支付超时后无法确认是否已扣款，结账交由恢复流程按支付结果完成或撤销
+	errPaymentUnknown = errors.New("payment result is unknown")
+)
+
 // CheckoutService 负责处理订单结账流程
 type CheckoutService struct {
 	ctx context.Context
+	// checkoutId 是后台执行的结账的ID，结账进度据此记录在结账任务中，
+	// 也是预留库存和核销优惠的幂等键
+	checkoutId string
+	// keepJob 表示结账结束后仍保留结账任务，由恢复流程完成剩余的步骤
+	keepJob bool
 }
 
 // NewCheckoutService 用于创建一个CheckoutService实例
@@ -46,78 +71,234 @@ func NewCheckoutService(ctx context.Context) *CheckoutService {
 }
 
 /*
-Run 方法用于执行结账流程，主要包括以下步骤：
-1. 获取购物车内容。
-2. 根据购物车计算总金额及创建订单项。
-3. 创建订单。
-4. 清空购物车。
-5. 发起支付请求。
-6. 发送确认邮件。
-7. 修改订单状态为已支付。
+Run 方法用于受理结账请求：校验请求并记录结账状态后立即返回结账ID，
+结账流程在后台执行，进度和结果通过 GetCheckoutStatus 查询。
 */
 func (s *CheckoutService) Run(req *checkout.CheckoutReq) (resp *checkout.CheckoutResp, err error) {
+	// 礼品卡和钱包余额不足的部分由信用卡支付，未使用储值时必须提供信用卡
+	if req.CreditCard == nil && req.GiftCardCode == "" && !req.UseWallet {
+		return nil, kerrors.NewBizStatusError(40000, "credit card is required")
+	}
+	// 停机过程中不再受理新的结账
+	if !startCheckout() {
+		return nil, kerrors.NewBizStatusError(50003, "checkout is unavailable, please try again later")
+	}
+	defer func() {
+		if err != nil {
+			running.wg.Done()
+		}
+	}()
+	st := &model.CheckoutStatus{
+		Id:     newId(),
+		UserId: req.UserId,
+		State:  model.CheckoutProcessing,
+		Step:   model.StepPricing,
+	}
+	// 先记录结账任务，结账中断时据此完成或撤销结账
+	if err = model.CreateCheckoutJob(mysql.DB, s.ctx, &model.CheckoutJob{CheckoutId: st.Id, UserId: req.UserId}); err != nil {
+		return nil, err
+	}
+	if err = model.SaveCheckoutStatus(redis.RedisClient, s.ctx, st); err != nil {
+		if delErr := model.DeleteCheckoutJob(mysql.DB, s.ctx, st.Id); delErr != nil {
+			klog.CtxErrorf(s.ctx, "delete job of checkout %s failed: %v", st.Id, delErr)
+		}
+		return nil, err
+	}
+	// 后台结账不随请求结束而取消，但保留请求上下文中的链路信息
+	worker := &CheckoutService{ctx: context.WithoutCancel(s.ctx), checkoutId: st.Id}
+	go worker.runAsync(req, st)
+	return &checkout.CheckoutResp{CheckoutId: st.Id}, nil
+}
+
+/*
+runAsync 执行结账流程，记录每一步的进度、耗时和最终结果，结束后删除结账任务。
+panic、支付结果未知或已支付但未能修改订单状态时保留结账任务，心跳停止后由恢复流程根据支付结果完成或撤销结账。
+*/
+func (s *CheckoutService) runAsync(req *checkout.CheckoutReq, st *model.CheckoutStatus) {
+	defer running.wg.Done()
+	stop := s.heartbeat()
+	defer stop()
+	begin := time.Now()
+	step, start := st.Step, begin
+	defer func() {
+		if r := recover(); r != nil {
+			klog.CtxErrorf(s.ctx, "checkout %s panicked: %v", st.Id, r)
+			metrics.ObserveStep(step, start, fmt.Errorf("panic: %v", r))
+		}
+	}()
+	result, held, err := s.checkout(req, func(next string) {
+		metrics.ObserveStep(step, start, nil)
+		step, start = next, time.Now()
+		st.Step = next
+		s.saveStatus(st)
+	})
+	metrics.ObserveStep(step, start, err)
+	metrics.ObserveStep("total", begin, err)
+	switch {
+	case errors.Is(err, errPaymentUnknown):
+		// 结账状态保持处理中，直到恢复流程查到支付结果
+		klog.CtxErrorf(s.ctx, "checkout %s is left to the recovery: %v", st.Id, err)
+		return
+	case err != nil:
+		klog.CtxErrorf(s.ctx, "checkout %s failed: %v", st.Id, err)
+		st.State, st.Error = model.CheckoutFailed, checkoutError(err)
+	case held:
+		st.State, st.OrderId, st.TransactionId = model.CheckoutReview, result.OrderId, result.TransactionId
+	default:
+		st.State, st.OrderId, st.TransactionId = model.CheckoutSucceeded, result.OrderId, result.TransactionId
+	}
+	s.saveStatus(st)
+	if s.keepJob {
+		return
+	}
+	if err = model.DeleteCheckoutJob(mysql.DB, s.ctx, st.Id); err != nil {
+		klog.CtxErrorf(s.ctx, "delete job of checkout %s failed: %v", st.Id, err)
+	}
+}
+
+// saveStatus 保存结账状态，保存失败只记录日志，不影响结账本身
+func (s *CheckoutService) saveStatus(st *model.CheckoutStatus) {
+	if err := model.SaveCheckoutStatus(redis.RedisClient, s.ctx, st); err != nil {
+		klog.CtxErrorf(s.ctx, "save status of checkout %s failed: %v", st.Id, err)
+	}
+}
+
+/*
+checkout 方法执行结账流程，每进入一步都会通过 progress 上报，主要包括以下步骤：
+1. 获取购物车内容并定价。
+2. 预留库存，并按预留时的价格和促销、优惠券的优惠重新定价，再计算运费和税费，然后进行风控评估。
+3. 创建订单。
+4. 发起支付请求。
+5. 并发清空购物车、发送确认邮件、修改订单状态为已支付。
+风控评估为待审核的订单只预授权支付，held 为 true，确认邮件和已支付状态在 ReviewOrder 审核通过后处理。
+*/
+func (s *CheckoutService) checkout(req *checkout.CheckoutReq, progress func(step string)) (resp *checkout.CheckoutResp, held bool, err error) {
 	// -------------------------------
-	// STEP 1: 获取购物车内容
+	// STEP 1: 获取购物车内容并定价
 	// -------------------------------
-	// 使用CartClient的GetCart方法获取用户购物车信息
-	cartResult, err := rpc.CartClient.GetCart(s.ctx, &cart.GetCartReq{UserId: req.UserId})
+	// 读取购物车并按 SKU 单价定价，与 Quote 使用同一条定价流水线
+	pc, err := loadPricing(s.ctx, req.UserId)
 	if err != nil {
-		// 记录错误日志并返回格式化后的错误信息
-		klog.Error(err)
-		err = fmt.Errorf("GetCart.err:%v", err)
 		return
 	}
 	// 检查购物车是否为空
-	if cartResult == nil || cartResult.Cart == nil || len(cartResult.Cart.Items) == 0 {
-		err = errors.New("cart is empty")
+	if len(pc.lines) == 0 {
+		err = errCartEmpty
 		return
 	}
 
 	// -------------------------------
-	// STEP 2: 根据购物车计算总金额及创建订单项
+	// STEP 2: 预留库存并计算优惠、运费和税费
 	// -------------------------------
-	var (
-		oi    []*order.OrderItem // 存放订单项的切片
-		total float32            // 总计金额
-	)
-	// 遍历购物车中的每个商品项
-	for _, cartItem := range cartResult.Cart.Items {
-		// 逐个查询每件商品的详细信息
-		productResp, resultErr := rpc.ProductClient.GetProduct(s.ctx, &product.GetProductReq{Id: cartItem.ProductId})
-		if resultErr != nil {
-			// 如果查询出错，则记录错误并返回
-			klog.Error(resultErr)
-			err = resultErr
+	// 库存和优惠都以结账ID为幂等键预留和核销，调用超时也可以按结账ID归还，归还后迟到的请求会被拒绝。
+	// 支付成功前的任何失败都要归还预留的库存
+	progress(model.StepReserving)
+	stock := pc.stockLines()
+	charged := false
+	defer func() {
+		if !undo(err, charged) {
 			return
 		}
-		// 如果商品不存在则忽略这个购物车项
-		if productResp.Product == nil {
-			continue
+		if _, releaseErr := rpc.ProductClient.ReleaseStock(s.ctx, &product.ReleaseStockReq{ReservationId: s.checkoutId}); releaseErr != nil {
+			klog.CtxErrorf(s.ctx, "release stock failed: %v", releaseErr)
 		}
-		p := productResp.Product
-		// 计算当前购物车项的花费：商品单价 * 数量
-		cost := p.Price * float32(cartItem.Quantity)
-		// 累加到总金额中
-		total += cost
-		// 添加订单项到订单项列表中
-		oi = append(oi, &order.OrderItem{
-			Item: &cart.CartItem{
-				ProductId: cartItem.ProductId,
-				Quantity:  cartItem.Quantity,
-			},
-			Cost: cost,
-		})
+	}()
+	// 预留库存，库存不足时直接结束结账
+	reserved, err := rpc.ProductClient.ReserveStock(s.ctx, &product.ReserveStockReq{Lines: stock, ReservationId: s.checkoutId})
+	if err != nil {
+		err = fmt.Errorf("ReserveStock.err:%w", err)
+		return
 	}
+	// 以预留库存时数据库中的 SKU 价格为准，商品缓存中的价格可能早于刚生效的调价
+	pc.reprice(reserved.Prices)
+
+	// 支付成功前的任何失败都要释放已核销的优惠，归还使用次数
+	defer func() {
+		if !undo(err, charged) {
+			return
+		}
+		if _, releaseErr := rpc.PromotionClient.ReleaseRedemptions(s.ctx, &promotion.ReleaseRedemptionsReq{RedemptionKey: s.checkoutId}); releaseErr != nil {
+			klog.CtxErrorf(s.ctx, "release redemptions failed: %v", releaseErr)
+		}
+	}()
+	// 计算并核销自动促销和优惠券，优惠按订单行摊入订单项金额
+	discount, err := rpc.PromotionClient.ApplyCoupon(s.ctx, &promotion.ApplyCouponReq{
+		UserId:        req.UserId,
+		CouponCode:    req.CouponCode,
+		Lines:         pc.promotionLines(),
+		RedemptionKey: s.checkoutId,
+	})
+	if err != nil {
+		err = fmt.Errorf("ApplyCoupon.err:%w", err)
+		return
+	}
+	pc.applyDiscount(discount.Promotions, discount.LineDiscounts)
+	// 按所选配送方式计算运费，再按收货地址计算税费
+	if err = pc.applyShipping(shipping.Default, req.ShippingMethod, req.Address); err != nil {
+		return
+	}
+	pc.applyTax(tax.Default, req.Address)
+	// 换算为用户选择的结算货币
+	code, rate, err := exchangeRate(s.ctx, req.Currency)
+	if err != nil {
+		return
+	}
+	pc.convert(code, rate)
+
+	// 用户确认过报价时，实际金额与报价不一致则拒绝结账，由用户重新确认订单
+	if err = pc.checkQuote(req.QuoteId); err != nil {
+		klog.CtxWarnf(s.ctx, "checkout of user %d rejected: %v", req.UserId, err)
+		err = kerrors.NewBizStatusError(40009, "the order total has changed, please review your order again")
+		return
+	}
+
+	// 风控评估：高风险订单直接拒绝，可疑订单挂起等待人工审核
+	assessment := assessRisk(s.ctx, req, pc)
+	if assessment.Decision == risk.Reject {
+		klog.CtxWarnf(s.ctx, "checkout of user %d rejected by risk rules %v, score %d", req.UserId, assessment.Reasons, assessment.Score)
+		err = kerrors.NewBizStatusError(40003, "we are unable to accept this order, please contact customer service")
+		return
+	}
+	held = assessment.Decision == risk.Review
 
 	// -------------------------------
 	// STEP 3: 创建订单
 	// -------------------------------
-	// 构造订单请求，其中包含用户ID、货币类型以及订单项信息
+	progress(model.StepOrdering)
+	// 订单ID由结账生成并先记录在结账任务中，下单超时重试时订单服务返回同一订单，
+	// 结账中断时由恢复流程按支付结果完成或取消订单
+	orderId := newId()
+	if err = s.saveJob(map[string]any{"order_id": orderId, "held": held}); err != nil {
+		return
+	}
+	// 支付成功前的任何失败都要取消订单，与恢复流程撤销中断的结账时一致；订单未创建时无需取消
+	defer func() {
+		if !undo(err, charged) {
+			return
+		}
+		if _, cancelErr := rpc.OrderClient.CancelOrder(s.ctx, &order.CancelOrderReq{UserId: req.UserId, OrderId: orderId}); cancelErr != nil && !isNotFound(cancelErr) {
+			klog.CtxErrorf(s.ctx, "cancel order %s failed: %v", orderId, cancelErr)
+		}
+	}()
+	// 构造订单请求，其中包含用户ID、订单ID、货币类型、订单项以及使用的优惠
 	orderReq := &order.PlaceOrderReq{
+		OrderId:      orderId,
 		UserId:       req.UserId,
-		UserCurrency: "USD",
-		OrderItems:   oi,
+		UserCurrency: pc.currency,
+		// 锁定结账时的汇率，订单金额均为结算货币
+		ExchangeRate: pc.rate,
+		OrderItems:   pc.orderItems(),
 		Email:        req.Email,
+		Promotions:   pc.orderPromotions(),
+		Discount:     pc.discount,
+		Taxes:        pc.orderTaxes(),
+		Tax:          pc.tax,
+		TaxInclusive: pc.taxInclusive,
+		// 配送方式及运费
+		ShippingMethod: pc.shippingBy.Method.Id,
+		ShippingCost:   pc.shipping,
+		// 待审核的订单先挂起
+		Hold: held,
 	}
 	// 如果请求中包含地址信息，则进行地址转换和设置
 	if req.Address != nil {
@@ -135,91 +316,107 @@ func (s *CheckoutService) Run(req *checkout.CheckoutReq) (resp *checkout.Checkou
 	orderResult, err := rpc.OrderClient.PlaceOrder(s.ctx, orderReq)
 	if err != nil {
 		// 如果创建订单失败，则返回错误信息
-		err = fmt.Errorf("PlaceOrder.err:%v", err)
+		err = fmt.Errorf("PlaceOrder.err:%w", err)
 		return
 	}
 	// 记录订单返回结果的日志
 	klog.Info("orderResult", orderResult)
 
 	// -------------------------------
-	// STEP 4: 清空购物车
+	// STEP 4: 发起支付请求
 	// -------------------------------
-	// 使用CartClient的EmptyCart方法清空用户购物车
-	emptyResult, err := rpc.CartClient.EmptyCart(s.ctx, &cart.EmptyCartReq{UserId: req.UserId})
-	if err != nil {
-		err = fmt.Errorf("EmptyCart.err:%v", err)
+	progress(model.StepPaying)
+	// 记录订单确认通知，结账中断时由恢复流程在完成订单后发送
+	if err = s.saveJob(map[string]any{"notification": orderConfirmation(req, pc, orderId)}); err != nil {
 		return
 	}
-	// 记录清空购物车后的返回结果
-	klog.Info(emptyResult)
-
-	// -------------------------------
-	// STEP 5: 发起支付请求
-	// -------------------------------
-	// 如果订单创建成功，则提取订单ID信息
-	var orderId string
-	if orderResult != nil || orderResult.Order != nil {
-		orderId = orderResult.Order.OrderId
+	// 记录待审核订单，审核时据此完成或撤销结账；支付失败时删除该记录
+	if held {
+		if err = holdForReview(s.ctx, req, pc, orderId, assessment, stock, discount.RedemptionIds); err != nil {
+			return
+		}
+		defer func() {
+			if !undo(err, charged) {
+				return
+			}
+			if delErr := model.DeleteReview(mysql.DB, s.ctx, orderId); delErr != nil {
+				klog.CtxErrorf(s.ctx, "delete review of order %s failed: %v", orderId, delErr)
+			}
+		}()
 	}
-	// 构造支付请求，其中包含了用户信息、订单ID、支付金额以及信用卡信息
+	// 构造支付请求，其中包含了用户信息、订单ID、支付金额、礼品卡、钱包以及信用卡信息；待审核的订单只预授权
 	payReq := &payment.ChargeReq{
-		UserId:  req.UserId,
-		OrderId: orderId,
-		Amount:  total,
-		CreditCard: &payment.CreditCardInfo{
+		UserId:        req.UserId,
+		OrderId:       orderId,
+		Amount:        pc.total,
+		Currency:      pc.currency,
+		AuthorizeOnly: held,
+		GiftCardCode:  req.GiftCardCode,
+		UseWallet:     req.UseWallet,
+	}
+	if req.CreditCard != nil {
+		payReq.CreditCard = &payment.CreditCardInfo{
 			CreditCardNumber:          req.CreditCard.CreditCardNumber,
 			CreditCardExpirationYear:  req.CreditCard.CreditCardExpirationYear,
 			CreditCardExpirationMonth: req.CreditCard.CreditCardExpirationMonth,
 			CreditCardCvv:             req.CreditCard.CreditCardCvv,
-		},
+		}
 	}
-	// 调用PaymentClient的Charge方法发起支付
+	// 调用PaymentClient的Charge方法发起支付；支付不能重复发起，超时后按订单查询支付结果
 	paymentResult, err := rpc.PaymentClient.Charge(s.ctx, payReq)
+	if kerrors.IsTimeoutError(err) {
+		paymentResult, err = s.lookupCharge(orderId, err)
+	}
 	if err != nil {
-		err = fmt.Errorf("Charge.err:%v", err)
+		err = fmt.Errorf("Charge.err:%w", err)
 		return
 	}
-
-	// -------------------------------
-	// STEP 6: 发送确认邮件
-	// -------------------------------
-	// 构造Email请求数据，用于通知客户订单已创建成功
-	data, _ := proto.Marshal(&email.EmailReq{
-		From:        "from@example.com",
-		To:          req.Email,
-		ContentType: "text/plain",
-		Subject:     "You just created an order in CloudWeGo shop",
-		Content:     "You just created an order in CloudWeGo shop",
-	})
-	// 构造NATS消息，将邮件请求数据放入消息体中
-	msg := &nats.Msg{
-		Subject: "email",
-		Data:    data,
-		Header:  make(nats.Header),
-	}
-	// 使用OpenTelemetry的Propagator将上下文注入到消息Header中，便于链路追踪
-	otel.GetTextMapPropagator().Inject(s.ctx, propagation.HeaderCarrier(msg.Header))
-	// 发布消息到NATS队列
-	_ = mq.Nc.PublishMsg(msg)
+	charged = true
 	// 记录支付结果的日志
 	klog.Info(paymentResult)
 
 	// -------------------------------
-	// STEP 7: 修改订单状态为已支付
+	// STEP 5: 清空购物车、发送订单确认通知并修改订单状态为已支付
 	// -------------------------------
-	// 调用OrderClient修改订单状态为已支付
-	klog.Info(orderResult)
-	_, err = rpc.OrderClient.MarkOrderPaid(s.ctx, &order.MarkOrderPaidReq{
-		UserId:  req.UserId,
-		OrderId: orderId,
+	// 三者互不依赖，并发执行；支付已完成，结账不再失败，修改订单状态失败时由恢复流程重试。
+	// 待审核的订单只清空购物车，确认通知和已支付状态等审核通过后处理
+	progress(model.StepConfirming)
+	var g errgroup.Group
+	g.SetLimit(rpc.Concurrency)
+	g.Go(func() error {
+		// 使用CartClient的EmptyCart方法清空用户购物车
+		if _, cartErr := rpc.CartClient.EmptyCart(s.ctx, &cart.EmptyCartReq{UserId: req.UserId}); cartErr != nil {
+			klog.CtxErrorf(s.ctx, "empty cart of user %d failed: %v", req.UserId, cartErr)
+		}
+		return nil
 	})
-	if err != nil {
-		klog.Error(err)
-		return
+	if !held {
+		g.Go(func() error {
+			publishNotification(s.ctx, orderConfirmation(req, pc, orderId), orderId)
+			return nil
+		})
+		g.Go(func() error {
+			// 调用OrderClient修改订单状态为已支付
+			if _, markErr := rpc.OrderClient.MarkOrderPaid(s.ctx, &order.MarkOrderPaidReq{
+				UserId:  req.UserId,
+				OrderId: orderId,
+			}); markErr != nil {
+				return fmt.Errorf("MarkOrderPaid.err:%w", markErr)
+			}
+			return nil
+		})
+	}
+	if markErr := g.Wait(); markErr != nil {
+		klog.CtxErrorf(s.ctx, "checkout of user %d: %v", req.UserId, markErr)
+		// 保留结账任务，确认通知已发送，恢复流程只修改订单状态
+		if saveErr := s.saveJob(map[string]any{"notification": nil}); saveErr != nil {
+			klog.CtxErrorf(s.ctx, "checkout of user %d: %v", req.UserId, saveErr)
+		}
+		s.keepJob = true
 	}
 
 	// -------------------------------
-	// STEP 8: 返回响应结果
+	// STEP 6: 返回响应结果
 	// -------------------------------
 	// 构造checkout响应，包含订单ID和支付交易ID
 	resp = &checkout.CheckoutResp{
@@ -228,3 +425,104 @@ func (s *CheckoutService) Run(req *checkout.CheckoutReq) (resp *checkout.Checkou
 	}
 	return
 }
+
+// orderConfirmation 构造订单确认通知，邮件服务会按用户的通知偏好（邮件、短信、Webhook）分发，邮件内容由模板渲染
+func orderConfirmation(req *checkout.CheckoutReq, pc *pricing, orderId string) []byte {
+	data, _ := proto.Marshal(&email.NotifyReq{
+		UserId: int32(req.UserId),
+		Topic:  "order",
+		Intent: &email.NotifyReq_Email{Email: &email.EmailReq{
+			To:     req.Email,
+			Locale: req.Locale,
+			Template: &email.EmailReq_OrderConfirmation{OrderConfirmation: &email.OrderConfirmation{
+				OrderId:  orderId,
+				Lines:    pc.emailLines(),
+				Total:    pc.total,
+				Currency: pc.currency,
+			}},
+		}},
+	})
+	return data
+}
+
+// publishNotification 发布订单确认通知，订单已支付，通知发送失败不影响结账结果，仅记录错误
+func publishNotification(ctx context.Context, data []byte, orderId string) {
+	// 构造NATS消息，将通知请求数据放入消息体中
+	msg := &nats.Msg{
+		Subject: mq.NotifySubject,
+		Data:    data,
+		Header:  make(nats.Header),
+	}
+	// 以订单号作为通知ID，便于通过邮件服务查询该订单确认通知的投递状态
+	msg.Header.Set(mq.HeaderEmailId, "order-confirmation-"+orderId)
+	// 使用OpenTelemetry的Propagator将上下文注入到消息Header中，便于链路追踪
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(msg.Header))
+	// 发布消息到JetStream持久化队列
+	if _, pubErr := mq.Js.PublishMsg(ctx, msg); pubErr != nil {
+		klog.CtxErrorf(ctx, "publish order confirmation notification failed: %v", pubErr)
+	}
+}
+
+// lookupCharge 在支付超时后按订单查询支付结果，查到未撤销的支付即已扣款；
+// 查不到时支付可能仍在处理，返回 errPaymentUnknown，不撤销结账
+func (s *CheckoutService) lookupCharge(orderId string, chargeErr error) (*payment.ChargeResp, error) {
+	p, err := rpc.PaymentClient.GetPayment(s.ctx, &payment.GetPaymentReq{OrderId: orderId})
+	if err != nil || p.Status == paymentVoided {
+		klog.CtxWarnf(s.ctx, "charge of order %s timed out: %v, payment lookup: %v", orderId, chargeErr, err)
+		return nil, errPaymentUnknown
+	}
+	return &payment.ChargeResp{TransactionId: p.TransactionId}, nil
+}
+
+// undo 报告结账失败时是否撤销已完成的步骤：支付成功后不撤销，支付结果未知时交由恢复流程处理
+func undo(err error, charged bool) bool {
+	return err != nil && !charged && !errors.Is(err, errPaymentUnknown)
+}
+
+// newId 生成随机ID，用作结账ID和订单ID
+func newId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// checkoutError 返回展示给用户的失败原因，业务错误展示其信息，其余错误不暴露内部细节
+func checkoutError(err error) string {
+	if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+		return bizErr.BizMessage()
+	}
+	if errors.Is(err, errCartEmpty) {
+		return err.Error()
+	}
+	return "checkout failed, please try again later"
+}
+
+// findSku 返回购物车项对应的 SKU，未指定 SKU 时只有单一 SKU 的商品可以匹配
+func findSku(p *product.Product, skuId uint32) *product.Sku {
+	if skuId == 0 && len(p.Skus) == 1 {
+		return p.Skus[0]
+	}
+	for _, sku := range p.Skus {
+		if sku.Id == skuId {
+			return sku
+		}
+	}
+	return nil
+}
+
+// skuName 在商品名后附上规格，例如 "T-Shirt (Print: Front, Size: M)"
+func skuName(p *product.Product, sku *product.Sku) string {
+	if len(sku.Options) == 0 {
+		return p.Name
+	}
+	return p.Name + " (" + skuOptions(sku) + ")"
+}
+
+// skuOptions 返回 SKU 的规格，例如 "Print: Front, Size: M"，没有规格时为空
+func skuOptions(sku *product.Sku) string {
+	var options []string
+	for _, o := range sku.Options {
+		options = append(options, o.Name+": "+o.Value)
+	}
+	return strings.Join(options, ", ")
+}
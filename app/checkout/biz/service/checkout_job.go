@@ -0,0 +1,234 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	// jobHeartbeat 是结账任务刷新心跳的间隔
+	jobHeartbeat = 30 * time.Second
+	// jobStaleAfter 是心跳停止多久后视为结账已中断，须远大于任何一次下游调用的耗时，
+	// 以免恢复时仍有调用在执行
+	jobStaleAfter = 3 * time.Minute
+	// recoverInterval 是检查中断结账的间隔，recoverBatch 是每次最多恢复的结账数
+	recoverInterval = time.Minute
+	recoverBatch    = 100
+	// paymentVoided 是已撤销的预授权支付的状态
+	paymentVoided = "voided"
+)
+
+// running 跟踪本实例正在执行的结账，停机时等待它们完成
+var running struct {
+	sync.Mutex
+	wg       sync.WaitGroup
+	draining bool
+}
+
+// startCheckout 登记一个正在执行的结账，开始停机后不再受理新的结账
+func startCheckout() bool {
+	running.Lock()
+	defer running.Unlock()
+	if running.draining {
+		return false
+	}
+	running.wg.Add(1)
+	return true
+}
+
+// Drain 停止受理新的结账并等待正在执行的结账完成，超时返回 false，
+// 未完成的结账心跳停止后由其他实例恢复
+func Drain(timeout time.Duration) bool {
+	running.Lock()
+	running.draining = true
+	running.Unlock()
+	done := make(chan struct{})
+	go func() {
+		running.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// saveJob 更新结账任务中记录的进度，保存失败时结账失败，以免中断后无法恢复
+func (s *CheckoutService) saveJob(fields map[string]any) error {
+	if s.checkoutId == "" {
+		return nil
+	}
+	if err := model.UpdateCheckoutJob(mysql.DB, s.ctx, s.checkoutId, fields); err != nil {
+		return fmt.Errorf("save checkout job: %w", err)
+	}
+	return nil
+}
+
+// heartbeat 定期刷新结账任务的心跳，直到调用返回的 stop
+func (s *CheckoutService) heartbeat() (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(jobHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := model.TouchCheckoutJob(mysql.DB, s.ctx, s.checkoutId); err != nil {
+					klog.CtxErrorf(s.ctx, "heartbeat of checkout %s failed: %v", s.checkoutId, err)
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// StartRecovery 定期恢复心跳已停止的结账，即执行它们的实例崩溃或重启时中断的结账
+func StartRecovery() {
+	go func() {
+		for range time.Tick(recoverInterval) {
+			recoverCheckouts(context.Background())
+		}
+	}()
+}
+
+// recoverCheckouts 认领并恢复中断的结账，恢复失败的结账在心跳再次过期后重试
+func recoverCheckouts(ctx context.Context) {
+	before := time.Now().Add(-jobStaleAfter)
+	jobs, err := model.GetStaleCheckoutJobs(mysql.DB, ctx, before, recoverBatch)
+	if err != nil {
+		klog.CtxErrorf(ctx, "load interrupted checkouts failed: %v", err)
+		return
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		// 先认领结账，避免多个实例同时恢复
+		ok, err := model.ClaimCheckoutJob(mysql.DB, ctx, job.ID, before)
+		if err != nil {
+			klog.CtxErrorf(ctx, "claim checkout %s failed: %v", job.CheckoutId, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if err = recoverCheckout(ctx, job); err != nil {
+			klog.CtxErrorf(ctx, "recover checkout %s failed: %v", job.CheckoutId, err)
+		}
+	}
+}
+
+/*
+recoverCheckout 完成或撤销一个中断的结账：
+- 订单已支付：完成结账的最后一步；待审核的订单只预授权了支付，交由人工审核。
+- 订单未支付：删除审核记录并取消订单，再按结账ID归还预留的库存和核销的优惠。
+每一步都可以重复执行，恢复失败时下次重新执行。
+*/
+func recoverCheckout(ctx context.Context, job *model.CheckoutJob) error {
+	st, err := model.GetCheckoutStatus(redis.RedisClient, ctx, job.CheckoutId)
+	if err != nil {
+		st = &model.CheckoutStatus{Id: job.CheckoutId, UserId: job.UserId}
+	}
+	st.State, st.Error = model.CheckoutFailed, "checkout was interrupted, please try again"
+
+	if job.OrderId != "" {
+		p, err := rpc.PaymentClient.GetPayment(ctx, &payment.GetPaymentReq{OrderId: job.OrderId})
+		if err != nil && !isNotFound(err) {
+			return fmt.Errorf("GetPayment.err:%w", err)
+		}
+		switch {
+		case err == nil && p.Status == paymentVoided:
+			// 预授权已在审核拒绝时撤销，订单、库存和优惠也已一并处理
+			return finishRecovery(ctx, job, st)
+		case err == nil:
+			if err = completeOrder(ctx, job); err != nil {
+				return err
+			}
+			st.State, st.Error = model.CheckoutSucceeded, ""
+			if job.Held {
+				st.State = model.CheckoutReview
+			}
+			st.OrderId, st.TransactionId = job.OrderId, p.TransactionId
+			return finishRecovery(ctx, job, st)
+		}
+		// 没有支付记录，撤销订单；订单可能未创建
+		if job.Held {
+			if err = model.DeleteReview(mysql.DB, ctx, job.OrderId); err != nil {
+				return err
+			}
+		}
+		if _, err = rpc.OrderClient.CancelOrder(ctx, &order.CancelOrderReq{UserId: job.UserId, OrderId: job.OrderId}); err != nil && !isNotFound(err) {
+			return fmt.Errorf("CancelOrder.err:%w", err)
+		}
+	}
+
+	// 按结账ID归还，没有预留或已归还时什么也不做
+	if _, err = rpc.ProductClient.ReleaseStock(ctx, &product.ReleaseStockReq{ReservationId: job.CheckoutId}); err != nil {
+		return fmt.Errorf("ReleaseStock.err:%w", err)
+	}
+	if _, err = rpc.PromotionClient.ReleaseRedemptions(ctx, &promotion.ReleaseRedemptionsReq{RedemptionKey: job.CheckoutId}); err != nil {
+		return fmt.Errorf("ReleaseRedemptions.err:%w", err)
+	}
+	return finishRecovery(ctx, job, st)
+}
+
+// completeOrder 完成已支付的订单：清空购物车；不需要审核的订单修改为已支付并发送确认通知
+func completeOrder(ctx context.Context, job *model.CheckoutJob) error {
+	if !job.Held {
+		if _, err := rpc.OrderClient.MarkOrderPaid(ctx, &order.MarkOrderPaidReq{UserId: job.UserId, OrderId: job.OrderId}); err != nil {
+			return fmt.Errorf("MarkOrderPaid.err:%w", err)
+		}
+		if len(job.Notification) > 0 {
+			publishNotification(ctx, job.Notification, job.OrderId)
+		}
+	}
+	if _, err := rpc.CartClient.EmptyCart(ctx, &cart.EmptyCartReq{UserId: job.UserId}); err != nil {
+		klog.CtxErrorf(ctx, "empty cart of user %d failed: %v", job.UserId, err)
+	}
+	return nil
+}
+
+// isNotFound 报告下游调用是否因记录不存在（40004）而失败
+func isNotFound(err error) bool {
+	bizErr, ok := kerrors.FromBizStatusError(err)
+	return ok && bizErr.BizStatusCode() == 40004
+}
+
+// finishRecovery 保存结账的最终状态并删除结账任务
+func finishRecovery(ctx context.Context, job *model.CheckoutJob, st *model.CheckoutStatus) error {
+	klog.CtxWarnf(ctx, "checkout %s was interrupted and recovered as %s", job.CheckoutId, st.State)
+	if err := model.SaveCheckoutStatus(redis.RedisClient, ctx, st); err != nil {
+		klog.CtxErrorf(ctx, "save status of checkout %s failed: %v", job.CheckoutId, err)
+	}
+	return model.DeleteCheckoutJob(mysql.DB, ctx, job.CheckoutId)
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDrain(t *testing.T) {
+	defer func() { running.draining = false }()
+
+	if !startCheckout() {
+		t.Fatal("startCheckout() = false before draining")
+	}
+	if Drain(10 * time.Millisecond) {
+		t.Error("Drain() = true while a checkout is running")
+	}
+	if startCheckout() {
+		t.Error("startCheckout() = true while draining")
+	}
+	running.wg.Done()
+	if !Drain(time.Second) {
+		t.Error("Drain() = false after the checkout finished")
+	}
+}
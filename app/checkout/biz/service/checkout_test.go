@@ -15,8 +15,94 @@
 package service
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 func TestCheckout_Run(t *testing.T) {
 }
+
+func TestFindSku(t *testing.T) {
+	tshirt := &product.Product{Name: "T-Shirt", Skus: []*product.Sku{
+		{Id: 3, Options: []*product.SkuOption{{Name: "Print", Value: "Front"}, {Name: "Size", Value: "M"}}},
+		{Id: 4},
+	}}
+	if sku := findSku(tshirt, 0); sku != nil {
+		t.Errorf("findSku(variants, 0) = %v, want nil", sku)
+	}
+	sku := findSku(tshirt, 3)
+	if sku == nil {
+		t.Fatal("findSku(variants, 3) = nil")
+	}
+	if got, want := skuName(tshirt, sku), "T-Shirt (Print: Front, Size: M)"; got != want {
+		t.Errorf("skuName = %q, want %q", got, want)
+	}
+	if got := skuName(tshirt, tshirt.Skus[1]); got != "T-Shirt" {
+		t.Errorf("skuName = %q, want T-Shirt", got)
+	}
+}
+
+func TestCheckoutError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want string
+	}{
+		{fmt.Errorf("ReserveStock.err:%w", kerrors.NewBizStatusError(40009, "insufficient stock")), "insufficient stock"},
+		{errCartEmpty, "cart is empty"},
+		{errors.New("dial tcp: connection refused"), "checkout failed, please try again later"},
+	}
+	for _, c := range cases {
+		if got := checkoutError(c.err); got != c.want {
+			t.Errorf("checkoutError(%v) = %q, want %q", c.err, got, c.want)
+		}
+	}
+}
+
+func TestRiskOrder(t *testing.T) {
+	req := &checkout.CheckoutReq{
+		UserId:         1,
+		Email:          "a@example.com",
+		ClientIp:       "10.0.0.1",
+		Address:        &checkout.Address{Country: "China", ZipCode: "100000"},
+		BillingAddress: &checkout.Address{},
+		CreditCard:     &payment.CreditCardInfo{CreditCardNumber: "4111111111111111"},
+	}
+	// 3300 JPY at 110 JPY per USD
+	o := riskOrder(req, &pricing{total: 3300, rate: 110})
+	if o.Total != 30 {
+		t.Errorf("Total = %v, want 30 USD", o.Total)
+	}
+	if o.Billing != nil {
+		t.Errorf("Billing = %+v, want nil for an empty billing address", o.Billing)
+	}
+	if o.Shipping.Country != "China" || o.CardNumber != req.CreditCard.CreditCardNumber || o.IP != "10.0.0.1" {
+		t.Errorf("riskOrder = %+v", o)
+	}
+}
+
+func TestUndo(t *testing.T) {
+	failed := fmt.Errorf("PlaceOrder.err:%w", kerrors.NewBizStatusError(40000, "bad request"))
+	cases := []struct {
+		err     error
+		charged bool
+		want    bool
+	}{
+		{nil, false, false},
+		{failed, false, true},
+		// 已扣款后失败的结账不撤销
+		{failed, true, false},
+		// 支付结果未知时交由恢复流程处理
+		{fmt.Errorf("Charge.err:%w", errPaymentUnknown), false, false},
+	}
+	for _, c := range cases {
+		if got := undo(c.err, c.charged); got != c.want {
+			t.Errorf("undo(%v, %v) = %v, want %v", c.err, c.charged, got, c.want)
+		}
+	}
+}
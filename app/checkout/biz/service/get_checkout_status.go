@@ -0,0 +1,61 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// GetCheckoutStatusService 查询异步结账的进度
+type GetCheckoutStatusService struct {
+	ctx context.Context
+} // NewGetCheckoutStatusService new GetCheckoutStatusService
+func NewGetCheckoutStatusService(ctx context.Context) *GetCheckoutStatusService {
+	return &GetCheckoutStatusService{ctx: ctx}
+}
+
+// Run 返回结账的状态，只能查询用户自己的结账
+func (s *GetCheckoutStatusService) Run(req *checkout.GetCheckoutStatusReq) (resp *checkout.GetCheckoutStatusResp, err error) {
+	if req.CheckoutId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "checkout id is required")
+	}
+	st, err := model.GetCheckoutStatus(redis.RedisClient, s.ctx, req.CheckoutId)
+	if errors.Is(err, goredis.Nil) || err == nil && st.UserId != req.UserId {
+		return nil, kerrors.NewBizStatusError(40004, "checkout not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &checkout.GetCheckoutStatusResp{Status: toCheckoutStatus(st)}, nil
+}
+
+func toCheckoutStatus(st *model.CheckoutStatus) *checkout.CheckoutStatus {
+	return &checkout.CheckoutStatus{
+		CheckoutId:    st.Id,
+		State:         st.State,
+		Step:          st.Step,
+		Error:         st.Error,
+		OrderId:       st.OrderId,
+		TransactionId: st.TransactionId,
+		UpdatedAt:     st.UpdatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/shipping"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+)
+
+// ListShippingOptionsService 返回可配送购物车的配送方式及运费
+type ListShippingOptionsService struct {
+	ctx context.Context
+} // NewListShippingOptionsService new ListShippingOptionsService
+func NewListShippingOptionsService(ctx context.Context) *ListShippingOptionsService {
+	return &ListShippingOptionsService{ctx: ctx}
+}
+
+// Run 按购物车的重量和优惠后的金额计算各配送方式的运费
+func (s *ListShippingOptionsService) Run(req *checkout.ListShippingOptionsReq) (resp *checkout.ListShippingOptionsResp, err error) {
+	pc, err := previewPricing(s.ctx, req.UserId, req.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+	code, rate, err := exchangeRate(s.ctx, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	// 运费按基础货币计算，再换算为结算货币展示
+	resp = &checkout.ListShippingOptionsResp{}
+	for _, o := range shipping.Options(shipping.Default, pc.shippingOrder(req.Address)) {
+		o.Price = currency.Round(o.Price*rate, code)
+		resp.Options = append(resp.Options, toShippingOption(o))
+	}
+	return resp, nil
+}
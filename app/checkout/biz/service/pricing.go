@@ -0,0 +1,424 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/shipping"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"golang.org/x/sync/errgroup"
+)
+
+// 结账定价流水线，Quote 与 Checkout 共用，保证预览的金额与实际扣款一致
+
+// pricedLine 是定价后的一个订单行
+type pricedLine struct {
+	product   *product.Product
+	sku       *product.Sku
+	quantity  int32
+	unitPrice float32
+	discount  float32
+	tax       float32
+}
+
+// cost 是订单行扣除优惠后的金额
+func (l *pricedLine) cost() float32 {
+	return l.unitPrice*float32(l.quantity) - l.discount
+}
+
+// pricing 是购物车的定价结果
+type pricing struct {
+	lines        []*pricedLine
+	promotions   []*promotion.AppliedPromotion
+	shippingBy   shipping.Option // 配送方式，商店未配置配送方式时为空
+	taxes        []tax.Component // 税费明细
+	taxInclusive bool            // 价格是否已含税
+	subtotal     float32         // 优惠前的商品金额
+	discount     float32         // 优惠总额
+	shipping     float32         // 运费
+	tax          float32         // 税费
+	total        float32         // 应付总额
+	currency     string          // 结算货币
+	rate         float64         // 由基础货币换算为结算货币的汇率
+}
+
+// loadPricing 读取用户购物车及其商品，按商品目录中的 SKU 单价定价。
+// 已下架的商品不参与结账，购物车为空时返回没有订单行的定价。
+func loadPricing(ctx context.Context, userId uint32) (*pricing, error) {
+	cartResult, err := rpc.CartClient.GetCart(ctx, &cart.GetCartReq{UserId: userId})
+	if err != nil {
+		klog.Error(err)
+		return nil, fmt.Errorf("GetCart.err:%v", err)
+	}
+	p := &pricing{currency: currency.Base, rate: 1}
+	if cartResult == nil || cartResult.Cart == nil || len(cartResult.Cart.Items) == 0 {
+		return p, nil
+	}
+	// 分批并发查询购物车中所有商品的详细信息
+	productIds := make([]uint32, 0, len(cartResult.Cart.Items))
+	for _, cartItem := range cartResult.Cart.Items {
+		productIds = append(productIds, cartItem.ProductId)
+	}
+	products, err := fetchProducts(ctx, productIds)
+	if err != nil {
+		klog.Error(err)
+		return nil, err
+	}
+	var missingIds []uint32
+	for _, id := range productIds {
+		if _, ok := products[id]; !ok {
+			missingIds = append(missingIds, id)
+		}
+	}
+	if len(missingIds) > 0 {
+		klog.CtxWarnf(ctx, "checkout of user %d skips missing products %v", userId, missingIds)
+	}
+	for _, cartItem := range cartResult.Cart.Items {
+		prod, ok := products[cartItem.ProductId]
+		if !ok {
+			continue
+		}
+		// 价格以购物车项对应的 SKU 为准
+		sku := findSku(prod, cartItem.SkuId)
+		if sku == nil {
+			return nil, fmt.Errorf("sku %d of product %d not found", cartItem.SkuId, cartItem.ProductId)
+		}
+		p.lines = append(p.lines, &pricedLine{product: prod, sku: sku, quantity: cartItem.Quantity, unitPrice: sku.Price})
+	}
+	p.sum()
+	return p, nil
+}
+
+// fetchProducts 按 rpc.ProductBatchSize 分批并发查询商品，单个慢查询只拖慢它所在的批次
+func fetchProducts(ctx context.Context, ids []uint32) (map[uint32]*product.Product, error) {
+	batches := productBatches(ids, rpc.ProductBatchSize)
+	results := make([]*product.BatchGetProductsResp, len(batches))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(rpc.Concurrency)
+	for i, batch := range batches {
+		i, batch := i, batch
+		g.Go(func() error {
+			resp, err := rpc.ProductClient.BatchGetProducts(gctx, &product.BatchGetProductsReq{Ids: batch})
+			if err != nil {
+				return fmt.Errorf("BatchGetProducts.err:%w", err)
+			}
+			results[i] = resp
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	products := make(map[uint32]*product.Product, len(ids))
+	for _, resp := range results {
+		for _, prod := range resp.Products {
+			products[prod.Id] = prod
+		}
+	}
+	return products, nil
+}
+
+// productBatches 去重后把商品ID按 size 个一批切分
+func productBatches(ids []uint32, size int) [][]uint32 {
+	seen := make(map[uint32]bool, len(ids))
+	var batches [][]uint32
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if n := len(batches); n == 0 || len(batches[n-1]) == size {
+			batches = append(batches, make([]uint32, 0, size))
+		}
+		batches[len(batches)-1] = append(batches[len(batches)-1], id)
+	}
+	return batches
+}
+
+// reprice 以 prices 中的单价（预留库存时数据库中的价格）替换商品目录中的单价
+func (p *pricing) reprice(prices map[uint32]float32) {
+	for _, l := range p.lines {
+		if price, ok := prices[l.sku.Id]; ok {
+			l.unitPrice = price
+		}
+	}
+	p.sum()
+}
+
+// promotionLines 返回用于计算优惠的订单行
+func (p *pricing) promotionLines() []*promotion.Line {
+	lines := make([]*promotion.Line, 0, len(p.lines))
+	for _, l := range p.lines {
+		lines = append(lines, &promotion.Line{
+			ProductId:  l.product.Id,
+			SkuId:      l.sku.Id,
+			Categories: l.product.Categories,
+			UnitPrice:  l.unitPrice,
+			Quantity:   l.quantity,
+		})
+	}
+	return lines
+}
+
+// applyDiscount 把促销服务计算出的优惠摊入各订单行，lineDiscounts 与订单行一一对应
+func (p *pricing) applyDiscount(promotions []*promotion.AppliedPromotion, lineDiscounts []float32) {
+	p.promotions = promotions
+	for i, l := range p.lines {
+		if i < len(lineDiscounts) {
+			l.discount = lineDiscounts[i]
+		}
+	}
+	p.sum()
+}
+
+// shippingOrder 返回计算运费所用的订单：收货国家、总重量和扣除优惠后的商品金额
+func (p *pricing) shippingOrder(address *checkout.Address) shipping.Order {
+	o := shipping.Order{Value: float64(p.subtotal - p.discount)}
+	if address != nil {
+		o.Country = address.Country
+	}
+	for _, l := range p.lines {
+		o.Weight += int(l.sku.Weight) * int(l.quantity)
+	}
+	return o
+}
+
+// applyShipping 按配送方式计算运费，需在计算优惠之后、计算税费之前调用
+func (p *pricing) applyShipping(cfg *shipping.Config, method string, address *checkout.Address) error {
+	option, err := shipping.Quote(cfg, method, p.shippingOrder(address))
+	if err != nil {
+		return kerrors.NewBizStatusError(40001, err.Error())
+	}
+	p.shippingBy = option
+	p.shipping = float32(option.Price)
+	p.sum()
+	return nil
+}
+
+// applyTax 按收货地址计算各订单行扣除优惠后金额的税费，需在计算优惠之后调用
+func (p *pricing) applyTax(rules *tax.Rules, address *checkout.Address) {
+	var country, state string
+	if address != nil {
+		country, state = address.Country, address.State
+	}
+	lines := make([]tax.Line, 0, len(p.lines))
+	for _, l := range p.lines {
+		lines = append(lines, tax.Line{Categories: l.product.Categories, Amount: float64(l.cost())})
+	}
+	res := tax.Calculate(rules, country, state, lines)
+	for i, l := range p.lines {
+		l.tax = float32(res.LineTaxes[i])
+	}
+	p.taxes = res.Components
+	p.taxInclusive = res.Inclusive
+	p.tax = float32(res.Total)
+	p.sum()
+}
+
+// exchangeRate 返回结算货币及其汇率，未指定时使用基础货币
+func exchangeRate(ctx context.Context, code string) (string, float64, error) {
+	if code == "" {
+		return currency.Base, 1, nil
+	}
+	if !currency.Supported(code) {
+		return "", 0, kerrors.NewBizStatusError(40001, "unsupported currency "+code)
+	}
+	rate, err := currency.Default.Rate(ctx, code)
+	if err != nil {
+		return "", 0, fmt.Errorf("exchange rate of %s: %w", code, err)
+	}
+	return code, rate, nil
+}
+
+// convert 按汇率把定价结果由基础货币换算为结算货币，需在计算运费和税费之后调用
+func (p *pricing) convert(code string, rate float64) {
+	p.currency, p.rate = code, rate
+	for _, l := range p.lines {
+		l.unitPrice = currency.Convert(l.unitPrice, rate, code)
+		l.discount = currency.Convert(l.discount, rate, code)
+		l.tax = currency.Convert(l.tax, rate, code)
+	}
+	for _, promo := range p.promotions {
+		promo.Discount = currency.Convert(promo.Discount, rate, code)
+	}
+	p.shipping = currency.Convert(p.shipping, rate, code)
+	p.shippingBy.Price = float64(p.shipping)
+	for i := range p.taxes {
+		p.taxes[i].Amount = currency.Round(p.taxes[i].Amount*rate, code)
+	}
+	p.tax = currency.Convert(p.tax, rate, code)
+	p.sum()
+}
+
+// sum 重新计算小计、优惠和应付总额，含税价格的税费已包含在商品金额中
+func (p *pricing) sum() {
+	p.subtotal, p.discount = 0, 0
+	for _, l := range p.lines {
+		p.subtotal += l.unitPrice * float32(l.quantity)
+		p.discount += l.discount
+	}
+	p.total = p.subtotal - p.discount + p.shipping
+	if !p.taxInclusive {
+		p.total += p.tax
+	}
+}
+
+// quoteId 是定价结果的指纹，订单行、单价或任一金额变化时随之变化
+func (p *pricing) quoteId() string {
+	h := sha256.New()
+	for _, l := range p.lines {
+		fmt.Fprintf(h, "%d:%d:%.2f:%.2f:%.2f;", l.sku.Id, l.quantity, l.unitPrice, l.discount, l.tax)
+	}
+	fmt.Fprintf(h, "%s:%.2f:%.2f:%.2f:%.2f:%.2f:%t;", p.shippingBy.Method.Id, p.subtotal, p.discount, p.shipping, p.tax, p.total, p.taxInclusive)
+	// 汇率变化时报价随之变化，结账时锁定用户确认过的汇率
+	fmt.Fprintf(h, "%s:%g", p.currency, p.rate)
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// errQuoteChanged 表示结账时的定价与用户确认的报价不一致
+var errQuoteChanged = errors.New("quote has changed")
+
+// checkQuote 在给出报价指纹时校验定价未发生变化
+func (p *pricing) checkQuote(quoteId string) error {
+	if quoteId != "" && quoteId != p.quoteId() {
+		return errQuoteChanged
+	}
+	return nil
+}
+
+func (p *pricing) stockLines() []*product.StockLine {
+	stock := make([]*product.StockLine, 0, len(p.lines))
+	for _, l := range p.lines {
+		stock = append(stock, &product.StockLine{SkuId: l.sku.Id, Quantity: l.quantity})
+	}
+	return stock
+}
+
+// orderItems 构造订单项，并附上商品名称、图片、规格和单价的快照，历史订单只依赖快照展示
+func (p *pricing) orderItems() []*order.OrderItem {
+	oi := make([]*order.OrderItem, 0, len(p.lines))
+	for _, l := range p.lines {
+		picture := l.sku.Picture
+		if picture == "" {
+			picture = l.product.Picture
+		}
+		oi = append(oi, &order.OrderItem{
+			Item:        &cart.CartItem{ProductId: l.product.Id, SkuId: l.sku.Id, Quantity: l.quantity},
+			Cost:        l.cost(),
+			ProductName: l.product.Name,
+			Picture:     picture,
+			SkuCode:     l.sku.Code,
+			Variant:     skuOptions(l.sku),
+			UnitPrice:   l.unitPrice,
+			Currency:    p.currency,
+		})
+	}
+	return oi
+}
+
+func (p *pricing) orderPromotions() []*order.OrderPromotion {
+	var promotions []*order.OrderPromotion
+	for _, promo := range p.promotions {
+		promotions = append(promotions, &order.OrderPromotion{
+			PromotionId: promo.PromotionId,
+			Name:        promo.Name,
+			Code:        promo.Code,
+			Discount:    promo.Discount,
+		})
+	}
+	return promotions
+}
+
+func (p *pricing) orderTaxes() []*order.OrderTax {
+	var taxes []*order.OrderTax
+	for _, t := range p.taxes {
+		taxes = append(taxes, &order.OrderTax{Name: t.Name, Rate: float32(t.Rate), Amount: float32(t.Amount)})
+	}
+	return taxes
+}
+
+// emailLines 返回确认邮件中展示的订单行
+func (p *pricing) emailLines() []*email.OrderLine {
+	lines := make([]*email.OrderLine, 0, len(p.lines))
+	for _, l := range p.lines {
+		lines = append(lines, &email.OrderLine{ProductName: skuName(l.product, l.sku), Quantity: l.quantity, Cost: l.cost()})
+	}
+	return lines
+}
+
+func (p *pricing) toQuote() *checkout.Quote {
+	q := &checkout.Quote{
+		QuoteId:      p.quoteId(),
+		Subtotal:     p.subtotal,
+		Discount:     p.discount,
+		Shipping:     p.shipping,
+		Tax:          p.tax,
+		Total:        p.total,
+		Currency:     p.currency,
+		ExchangeRate: p.rate,
+		TaxInclusive: p.taxInclusive,
+	}
+	for _, l := range p.lines {
+		picture := l.sku.Picture
+		if picture == "" {
+			picture = l.product.Picture
+		}
+		q.Lines = append(q.Lines, &checkout.QuoteLine{
+			ProductId: l.product.Id,
+			SkuId:     l.sku.Id,
+			Name:      skuName(l.product, l.sku),
+			Picture:   picture,
+			UnitPrice: l.unitPrice,
+			Quantity:  l.quantity,
+			Discount:  l.discount,
+			Cost:      l.cost(),
+			Tax:       l.tax,
+		})
+	}
+	if p.shippingBy.Method.Id != "" {
+		q.ShippingOption = toShippingOption(p.shippingBy)
+	}
+	for _, t := range p.taxes {
+		q.Taxes = append(q.Taxes, &checkout.QuoteTax{Name: t.Name, Rate: float32(t.Rate), Amount: float32(t.Amount)})
+	}
+	for _, promo := range p.promotions {
+		q.Promotions = append(q.Promotions, &checkout.QuotePromotion{Name: promo.Name, Code: promo.Code, Discount: promo.Discount})
+	}
+	return q
+}
+
+func toShippingOption(o shipping.Option) *checkout.ShippingOption {
+	return &checkout.ShippingOption{
+		Method: o.Method.Id,
+		Name:   o.Method.Name,
+		Days:   o.Method.Days,
+		Price:  float32(o.Price),
+	}
+}
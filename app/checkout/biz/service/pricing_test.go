@@ -0,0 +1,160 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/shipping"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+)
+
+func testPricing() *pricing {
+	p := &pricing{lines: []*pricedLine{
+		{product: &product.Product{Id: 1, Name: "T-Shirt"}, sku: &product.Sku{Id: 1, Price: 10}, quantity: 2, unitPrice: 10},
+		{product: &product.Product{Id: 2, Name: "Mug"}, sku: &product.Sku{Id: 2, Price: 5}, quantity: 1, unitPrice: 5},
+	}}
+	p.sum()
+	return p
+}
+
+func TestPricingReprice(t *testing.T) {
+	p := testPricing()
+	p.reprice(map[uint32]float32{1: 8})
+	items := p.orderItems()
+	if p.total != 21 || items[0].Cost != 16 || p.emailLines()[0].Cost != 16 || items[1].Cost != 5 {
+		t.Errorf("total %v, costs %v %v", p.total, items[0].Cost, items[1].Cost)
+	}
+}
+
+func TestPricingOrderItems(t *testing.T) {
+	p := testPricing()
+	p.lines[0].product.Picture = "/static/tshirt.jpg"
+	p.lines[0].sku = &product.Sku{Id: 3, Code: "TS-M", Picture: "/static/tshirt-m.jpg", Options: []*product.SkuOption{{Name: "Size", Value: "M"}}}
+	p.lines[1].product.Picture = "/static/mug.jpg"
+	p.currency = "EUR"
+	items := p.orderItems()
+	first := items[0]
+	if first.ProductName != "T-Shirt" || first.Picture != "/static/tshirt-m.jpg" || first.SkuCode != "TS-M" ||
+		first.Variant != "Size: M" || first.UnitPrice != 10 || first.Currency != "EUR" || first.Item.SkuId != 3 {
+		t.Errorf("orderItems()[0] = %v", first)
+	}
+	// a sku without a picture of its own shows the product
+	if items[1].Picture != "/static/mug.jpg" || items[1].Variant != "" {
+		t.Errorf("orderItems()[1] = %v", items[1])
+	}
+}
+
+func TestPricingApplyDiscount(t *testing.T) {
+	p := testPricing()
+	p.applyDiscount([]*promotion.AppliedPromotion{{Name: "Summer", Discount: 4}}, []float32{4, 0})
+	items := p.orderItems()
+	if p.subtotal != 25 || p.discount != 4 || p.total != 21 || items[0].Cost != 16 || items[1].Cost != 5 {
+		t.Errorf("subtotal %v, discount %v, total %v, costs %v %v", p.subtotal, p.discount, p.total, items[0].Cost, items[1].Cost)
+	}
+	q := p.toQuote()
+	if q.Total != 21 || len(q.Lines) != 2 || q.Lines[0].Discount != 4 || len(q.Promotions) != 1 {
+		t.Errorf("toQuote = %v", q)
+	}
+}
+
+func TestPricingCheckQuote(t *testing.T) {
+	p := testPricing()
+	quoteId := p.quoteId()
+	if err := p.checkQuote(quoteId); err != nil {
+		t.Errorf("checkQuote(same) = %v", err)
+	}
+	if err := p.checkQuote(""); err != nil {
+		t.Errorf("checkQuote(\"\") = %v", err)
+	}
+	p.reprice(map[uint32]float32{2: 6})
+	if err := p.checkQuote(quoteId); err != errQuoteChanged {
+		t.Errorf("checkQuote after reprice = %v, want errQuoteChanged", err)
+	}
+}
+
+func TestPricingApplyTax(t *testing.T) {
+	rules := &tax.Rules{Regions: []tax.Region{{Country: "China", Name: "VAT", Rate: 10}}}
+	p := testPricing()
+	p.applyDiscount(nil, []float32{5, 0})
+	p.applyTax(rules, &checkout.Address{Country: "china"})
+	if p.tax != 2 || p.total != 22 || p.lines[0].tax != 1.5 || len(p.orderTaxes()) != 1 {
+		t.Errorf("tax %v, total %v, line tax %v", p.tax, p.total, p.lines[0].tax)
+	}
+
+	rules.Inclusive = true
+	p.applyTax(rules, &checkout.Address{Country: "China"})
+	if p.total != 20 || !p.toQuote().TaxInclusive {
+		t.Errorf("inclusive total %v, want 20", p.total)
+	}
+	p.applyTax(rules, nil)
+	if p.tax != 0 || p.taxes != nil {
+		t.Errorf("tax without address %v", p.tax)
+	}
+}
+
+func TestPricingApplyShipping(t *testing.T) {
+	cfg := &shipping.Config{Methods: []shipping.Method{
+		{Id: "standard", FreeOver: 30, Rates: []shipping.Rate{{MaxWeight: 1000, Price: 5}, {Price: 9}}},
+	}}
+	p := testPricing()
+	p.lines[0].sku.Weight = 400
+	if err := p.applyShipping(cfg, "", &checkout.Address{Country: "China"}); err != nil {
+		t.Fatal(err)
+	}
+	// 2 * 400g is within the first rate
+	if p.shipping != 5 || p.total != 30 || p.toQuote().ShippingOption.Method != "standard" {
+		t.Errorf("shipping %v, total %v", p.shipping, p.total)
+	}
+	p.lines[1].quantity = 2
+	p.sum()
+	if err := p.applyShipping(cfg, "standard", nil); err != nil || p.shipping != 0 {
+		t.Errorf("shipping over free_over = %v, %v", p.shipping, err)
+	}
+	if err := p.applyShipping(cfg, "express", nil); err == nil {
+		t.Error("applyShipping accepted an unknown method")
+	}
+}
+
+func TestProductBatches(t *testing.T) {
+	got := productBatches([]uint32{1, 2, 2, 3, 4, 1, 5}, 2)
+	want := [][]uint32{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("productBatches = %v, want %v", got, want)
+	}
+	if got := productBatches(nil, 2); len(got) != 0 {
+		t.Errorf("productBatches(nil) = %v, want none", got)
+	}
+}
+
+func TestPricingConvert(t *testing.T) {
+	p := testPricing()
+	p.applyDiscount(nil, []float32{5, 0})
+	p.applyTax(&tax.Rules{Regions: []tax.Region{{Country: "China", Name: "VAT", Rate: 10}}}, &checkout.Address{Country: "China"})
+	usd := p.quoteId()
+	p.convert("JPY", 150)
+	// (25 - 5 discount + 2 tax) * 150, rounded to whole yen
+	if p.total != 3300 || p.lines[0].unitPrice != 1500 || p.lines[0].discount != 750 || p.tax != 300 {
+		t.Errorf("total %v, unit price %v, discount %v, tax %v", p.total, p.lines[0].unitPrice, p.lines[0].discount, p.tax)
+	}
+	q := p.toQuote()
+	if q.Currency != "JPY" || q.ExchangeRate != 150 || q.QuoteId == usd {
+		t.Errorf("quote in %s at %v, id %s", q.Currency, q.ExchangeRate, q.QuoteId)
+	}
+}
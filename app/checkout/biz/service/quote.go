@@ -0,0 +1,72 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/shipping"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+)
+
+// QuoteService 在下单前按结账流水线预览订单金额
+type QuoteService struct {
+	ctx context.Context
+} // NewQuoteService new QuoteService
+func NewQuoteService(ctx context.Context) *QuoteService {
+	return &QuoteService{ctx: ctx}
+}
+
+// Run 返回购物车的报价；与结账不同，报价使用商品目录中的价格，不预留库存也不核销优惠券
+func (s *QuoteService) Run(req *checkout.QuoteReq) (resp *checkout.QuoteResp, err error) {
+	pc, err := previewPricing(s.ctx, req.UserId, req.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(pc.lines) > 0 {
+		if err = pc.applyShipping(shipping.Default, req.ShippingMethod, req.Address); err != nil {
+			return nil, err
+		}
+		pc.applyTax(tax.Default, req.Address)
+	}
+	code, rate, err := exchangeRate(s.ctx, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	pc.convert(code, rate)
+	return &checkout.QuoteResp{Quote: pc.toQuote()}, nil
+}
+
+// previewPricing 按商品目录中的价格和预览的优惠为购物车定价
+func previewPricing(ctx context.Context, userId uint32, couponCode string) (*pricing, error) {
+	pc, err := loadPricing(ctx, userId)
+	if err != nil || len(pc.lines) == 0 {
+		return pc, err
+	}
+	// 优惠券不可用时直接返回促销服务的业务错误，便于前端提示原因
+	discount, err := rpc.PromotionClient.PreviewDiscount(ctx, &promotion.PreviewDiscountReq{
+		UserId:     userId,
+		CouponCode: couponCode,
+		Lines:      pc.promotionLines(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	pc.applyDiscount(discount.Promotions, discount.LineDiscounts)
+	return pc, nil
+}
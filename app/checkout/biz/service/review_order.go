@@ -0,0 +1,129 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+// ReviewOrderService 处理风控挂起订单的人工审核
+type ReviewOrderService struct {
+	ctx context.Context
+} // NewReviewOrderService new ReviewOrderService
+func NewReviewOrderService(ctx context.Context) *ReviewOrderService {
+	return &ReviewOrderService{ctx: ctx}
+}
+
+/*
+Run 审核一个风控挂起的订单：
+- 通过：扣款预授权的支付，修改订单状态为已支付，并发送订单确认通知。
+- 拒绝：撤销预授权的支付，取消订单，并归还预留的库存和核销的优惠。
+*/
+func (s *ReviewOrderService) Run(req *checkout.ReviewOrderReq) (resp *checkout.ReviewOrderResp, err error) {
+	if req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "order_id is required")
+	}
+	r, err := model.GetReview(mysql.DB, s.ctx, req.OrderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "order is not held for review")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if r.State != model.ReviewPending {
+		return nil, kerrors.NewBizStatusError(40009, "order is already "+r.State)
+	}
+
+	// 先认领审核，避免同一订单被并发审核
+	state := model.ReviewRejected
+	if req.Approve {
+		state = model.ReviewApproved
+	}
+	ok, err := model.UpdateReviewState(mysql.DB, s.ctx, r.OrderId, model.ReviewPending, state, req.Reviewer, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, kerrors.NewBizStatusError(40009, "order is already reviewed")
+	}
+
+	if req.Approve {
+		err = s.approve(&r)
+	} else {
+		err = s.reject(&r)
+	}
+	if err != nil {
+		// 恢复为待审核，以便重新审核；扣款、撤销支付和订单状态的修改都可以重复执行
+		if _, resetErr := model.UpdateReviewState(mysql.DB, s.ctx, r.OrderId, state, model.ReviewPending, r.Reviewer, r.Note); resetErr != nil {
+			klog.CtxErrorf(s.ctx, "reset review of order %s failed: %v", r.OrderId, resetErr)
+		}
+		return nil, err
+	}
+	klog.CtxInfof(s.ctx, "order %s %s by %s", r.OrderId, state, req.Reviewer)
+	return &checkout.ReviewOrderResp{State: state}, nil
+}
+
+// approve 扣款并完成订单，确认通知在最后发送，避免重新审核时重复通知
+func (s *ReviewOrderService) approve(r *model.Review) error {
+	if _, err := rpc.PaymentClient.Capture(s.ctx, &payment.CaptureReq{OrderId: r.OrderId}); err != nil {
+		return fmt.Errorf("Capture.err:%w", err)
+	}
+	if _, err := rpc.OrderClient.MarkOrderPaid(s.ctx, &order.MarkOrderPaidReq{UserId: r.UserId, OrderId: r.OrderId}); err != nil {
+		return fmt.Errorf("MarkOrderPaid.err:%w", err)
+	}
+	publishNotification(s.ctx, r.Notification, r.OrderId)
+	return nil
+}
+
+// reject 撤销支付并取消订单；之后归还库存和优惠，这两步不能重复执行，失败时只记录错误
+func (s *ReviewOrderService) reject(r *model.Review) error {
+	if _, err := rpc.PaymentClient.Void(s.ctx, &payment.VoidReq{OrderId: r.OrderId}); err != nil {
+		return fmt.Errorf("Void.err:%w", err)
+	}
+	if _, err := rpc.OrderClient.CancelOrder(s.ctx, &order.CancelOrderReq{UserId: r.UserId, OrderId: r.OrderId}); err != nil {
+		return fmt.Errorf("CancelOrder.err:%w", err)
+	}
+
+	var stock []*product.StockLine
+	if err := json.Unmarshal([]byte(r.Stock), &stock); err != nil {
+		klog.CtxErrorf(s.ctx, "stock of review %s is invalid: %v", r.OrderId, err)
+	} else if _, err = rpc.ProductClient.ReleaseStock(s.ctx, &product.ReleaseStockReq{Lines: stock}); err != nil {
+		klog.CtxErrorf(s.ctx, "release stock of order %s failed: %v", r.OrderId, err)
+	}
+	var redemptionIds []uint32
+	if err := json.Unmarshal([]byte(r.RedemptionIds), &redemptionIds); err != nil {
+		klog.CtxErrorf(s.ctx, "redemptions of review %s are invalid: %v", r.OrderId, err)
+	} else if len(redemptionIds) > 0 {
+		if _, err = rpc.PromotionClient.ReleaseRedemptions(s.ctx, &promotion.ReleaseRedemptionsReq{RedemptionIds: redemptionIds}); err != nil {
+			klog.CtxErrorf(s.ctx, "release redemptions of order %s failed: %v", r.OrderId, err)
+		}
+	}
+	return nil
+}
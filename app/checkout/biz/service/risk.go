@@ -0,0 +1,81 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/risk"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/metrics"
+	checkout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// assessRisk 在支付前对订单进行风控评分，并记录评估结果
+func assessRisk(ctx context.Context, req *checkout.CheckoutReq, pc *pricing) risk.Result {
+	res := risk.Default.Assess(ctx, riskOrder(req, pc))
+	metrics.RiskDecisions.WithLabelValues(res.Decision).Inc()
+	if res.Decision != risk.Approve {
+		klog.CtxInfof(ctx, "risk assessment of user %d: %s, score %d, rules %v", req.UserId, res.Decision, res.Score, res.Reasons)
+	}
+	return res
+}
+
+// riskOrder 构造风控规则评估的订单信息，金额换算回基础货币，以便各货币使用同一套阈值
+func riskOrder(req *checkout.CheckoutReq, pc *pricing) *risk.Order {
+	o := &risk.Order{
+		UserId: req.UserId,
+		Email:  req.Email,
+		IP:     req.ClientIp,
+		Total:  float64(pc.total) / pc.rate,
+	}
+	if req.CreditCard != nil {
+		o.CardNumber = req.CreditCard.CreditCardNumber
+	}
+	if req.Address != nil {
+		o.Shipping = risk.Address{Country: req.Address.Country, ZipCode: req.Address.ZipCode}
+	}
+	if b := req.BillingAddress; b != nil && (b.Country != "" || b.ZipCode != "") {
+		o.Billing = &risk.Address{Country: b.Country, ZipCode: b.ZipCode}
+	}
+	return o
+}
+
+// holdForReview 保存待审核订单，连同审核后完成或撤销结账所需的预留库存、优惠核销记录和订单确认通知
+func holdForReview(ctx context.Context, req *checkout.CheckoutReq, pc *pricing, orderId string, assessment risk.Result, stock []*product.StockLine, redemptionIds []uint32) error {
+	stockJSON, err := json.Marshal(stock)
+	if err != nil {
+		return err
+	}
+	redemptionsJSON, err := json.Marshal(redemptionIds)
+	if err != nil {
+		return err
+	}
+	return model.CreateReview(mysql.DB, ctx, &model.Review{
+		OrderId:       orderId,
+		UserId:        req.UserId,
+		Score:         assessment.Score,
+		Reasons:       strings.Join(assessment.Reasons, ","),
+		State:         model.ReviewPending,
+		Stock:         string(stockJSON),
+		RedemptionIds: string(redemptionsJSON),
+		Notification:  orderConfirmation(req, pc, orderId),
+	})
+}
@@ -0,0 +1,250 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shipping prices the shipping methods of an order from rate tables
+// by zone, weight and order value.
+package shipping
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	ErrUnknownMethod = errors.New("unknown shipping method")
+	ErrUnavailable   = errors.New("shipping method is not available for the address")
+)
+
+// Config is the shipping configuration of the store.
+type Config struct {
+	Zones   []Zone   `yaml:"zones"`
+	Methods []Method `yaml:"methods"`
+}
+
+// Zone groups the countries that share rates. A zone without countries
+// takes every country no other zone lists.
+type Zone struct {
+	Name      string   `yaml:"name"`
+	Countries []string `yaml:"countries"`
+}
+
+// Method is a way to ship an order, e.g. standard, express or pickup.
+type Method struct {
+	Id   string `yaml:"id"`
+	Name string `yaml:"name"`
+	// Days is the delivery estimate shown to the shopper, e.g. "3-5".
+	Days string `yaml:"days"`
+	// FreeOver makes the method free from this order value on, 0 for never.
+	FreeOver float64 `yaml:"free_over"`
+	Rates    []Rate  `yaml:"rates"`
+}
+
+// Rate is the price of a method in a zone for orders up to a weight and
+// from an order value. Of the rates that match an order the one with the
+// lowest weight limit wins, then the one with the highest value.
+type Rate struct {
+	// Zone is the name of a zone, empty for every zone.
+	Zone string `yaml:"zone"`
+	// MaxWeight in grams, 0 for no limit.
+	MaxWeight int     `yaml:"max_weight"`
+	MinValue  float64 `yaml:"min_value"`
+	Price     float64 `yaml:"price"`
+}
+
+// Order is what shipping is priced on.
+type Order struct {
+	Country string
+	// Weight in grams.
+	Weight int
+	// Value of the goods after discounts.
+	Value float64
+}
+
+// Option is a method that can ship an order, with its price.
+type Option struct {
+	Method Method
+	Price  float64
+}
+
+// Default holds the configuration of the store, loaded by Init.
+var Default = &Config{}
+
+// Init loads Default from the shipping file of the configuration. Without a
+// shipping file orders ship for free.
+func Init() {
+	path := conf.GetConf().Shipping.MethodsFile
+	if path == "" {
+		klog.Warn("no shipping methods file configured, orders ship for free")
+		return
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		panic(err)
+	}
+	Default = cfg
+}
+
+// Load reads a configuration from a yaml file.
+func Load(path string) (*Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	if err = yaml.Unmarshal(content, cfg); err != nil {
+		return nil, err
+	}
+	if err = cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks that zones and methods are unique and that rates name
+// known zones.
+func (c *Config) Validate() error {
+	zones := make(map[string]bool, len(c.Zones))
+	for _, z := range c.Zones {
+		if z.Name == "" || zones[z.Name] {
+			return fmt.Errorf("zone name %q is empty or duplicate", z.Name)
+		}
+		zones[z.Name] = true
+	}
+	methods := make(map[string]bool, len(c.Methods))
+	for _, m := range c.Methods {
+		if m.Id == "" || methods[m.Id] {
+			return fmt.Errorf("method id %q is empty or duplicate", m.Id)
+		}
+		methods[m.Id] = true
+		if m.FreeOver < 0 {
+			return fmt.Errorf("method %s has a negative free_over", m.Id)
+		}
+		for _, r := range m.Rates {
+			if r.Zone != "" && !zones[r.Zone] {
+				return fmt.Errorf("method %s has a rate of unknown zone %q", m.Id, r.Zone)
+			}
+			if r.MaxWeight < 0 || r.MinValue < 0 || r.Price < 0 {
+				return fmt.Errorf("method %s has a negative rate", m.Id)
+			}
+		}
+	}
+	return nil
+}
+
+// zone returns the zone of a country, "" when there is none.
+func (c *Config) zone(country string) string {
+	country = strings.TrimSpace(country)
+	fallback := ""
+	for _, z := range c.Zones {
+		if len(z.Countries) == 0 {
+			if fallback == "" {
+				fallback = z.Name
+			}
+			continue
+		}
+		for _, zc := range z.Countries {
+			if strings.EqualFold(zc, country) {
+				return z.Name
+			}
+		}
+	}
+	return fallback
+}
+
+// Options returns the methods that can ship the order, in the order of the
+// configuration.
+func Options(c *Config, o Order) []Option {
+	if c == nil {
+		return nil
+	}
+	zone := c.zone(o.Country)
+	var options []Option
+	for _, m := range c.Methods {
+		if option, ok := price(m, zone, o); ok {
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// Quote prices the order with a method; an empty id picks the first method
+// that can ship the order. A store without methods ships for free.
+func Quote(c *Config, id string, o Order) (Option, error) {
+	if c == nil || len(c.Methods) == 0 {
+		return Option{}, nil
+	}
+	if id == "" {
+		options := Options(c, o)
+		if len(options) == 0 {
+			return Option{}, ErrUnavailable
+		}
+		return options[0], nil
+	}
+	for _, m := range c.Methods {
+		if m.Id != id {
+			continue
+		}
+		option, ok := price(m, c.zone(o.Country), o)
+		if !ok {
+			return Option{}, ErrUnavailable
+		}
+		return option, nil
+	}
+	return Option{}, ErrUnknownMethod
+}
+
+func price(m Method, zone string, o Order) (Option, bool) {
+	var best *Rate
+	for i := range m.Rates {
+		r := &m.Rates[i]
+		if r.Zone != "" && r.Zone != zone || r.MaxWeight > 0 && o.Weight > r.MaxWeight || o.Value < r.MinValue {
+			continue
+		}
+		if best == nil || tighter(r, best) {
+			best = r
+		}
+	}
+	if best == nil {
+		return Option{}, false
+	}
+	option := Option{Method: m, Price: best.Price}
+	if m.FreeOver > 0 && o.Value >= m.FreeOver {
+		option.Price = 0
+	}
+	option.Price = math.Round(option.Price*100) / 100
+	return option, true
+}
+
+// tighter reports whether rate a is more specific than rate b.
+func tighter(a, b *Rate) bool {
+	aw, bw := maxWeight(a), maxWeight(b)
+	if aw != bw {
+		return aw < bw
+	}
+	return a.MinValue > b.MinValue
+}
+
+func maxWeight(r *Rate) int {
+	if r.MaxWeight == 0 {
+		return math.MaxInt
+	}
+	return r.MaxWeight
+}
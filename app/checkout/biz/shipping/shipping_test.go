@@ -0,0 +1,100 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shipping
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testConfig = Config{
+	Zones: []Zone{
+		{Name: "domestic", Countries: []string{"China"}},
+		{Name: "international"},
+	},
+	Methods: []Method{
+		{Id: "standard", FreeOver: 99, Rates: []Rate{
+			{Zone: "domestic", MaxWeight: 1000, Price: 5},
+			{Zone: "domestic", Price: 10},
+			{Zone: "international", Price: 30},
+		}},
+		{Id: "express", Rates: []Rate{
+			{Zone: "domestic", Price: 15},
+			{Zone: "domestic", MinValue: 200, Price: 8},
+		}},
+		{Id: "pickup", Rates: []Rate{{Zone: "domestic"}}},
+	},
+}
+
+func TestQuote(t *testing.T) {
+	for _, tt := range []struct {
+		id    string
+		order Order
+		price float64
+		err   error
+	}{
+		{"standard", Order{Country: "china", Weight: 800, Value: 50}, 5, nil},
+		{"standard", Order{Country: "China", Weight: 1500, Value: 50}, 10, nil},
+		{"standard", Order{Country: "China", Weight: 1500, Value: 99}, 0, nil},
+		{"standard", Order{Country: "Japan", Weight: 1500, Value: 50}, 30, nil},
+		{"express", Order{Country: "China", Value: 50}, 15, nil},
+		{"express", Order{Country: "China", Value: 250}, 8, nil},
+		{"express", Order{Country: "Japan", Value: 50}, 0, ErrUnavailable},
+		{"pickup", Order{Country: "China", Value: 50}, 0, nil},
+		{"drone", Order{Country: "China", Value: 50}, 0, ErrUnknownMethod},
+	} {
+		option, err := Quote(&testConfig, tt.id, tt.order)
+		if err != tt.err || option.Price != tt.price {
+			t.Errorf("Quote(%s, %+v) = %v, %v, want %v, %v", tt.id, tt.order, option.Price, err, tt.price, tt.err)
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	options := Options(&testConfig, Order{Country: "Japan", Value: 50})
+	if len(options) != 1 || options[0].Method.Id != "standard" {
+		t.Errorf("Options(Japan) = %+v, want standard only", options)
+	}
+	option, err := Quote(&testConfig, "", Order{Country: "China", Value: 50})
+	if err != nil || option.Method.Id != "standard" {
+		t.Errorf("Quote(default) = %+v, %v", option, err)
+	}
+	if option, err := Quote(&Config{}, "", Order{}); err != nil || option.Price != 0 {
+		t.Errorf("Quote without methods = %+v, %v", option, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shipping.yaml")
+	content := "zones:\n  - name: all\nmethods:\n  - id: standard\n    free_over: 50\n    rates:\n      - zone: all\n        max_weight: 500\n        price: 4.5\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Methods) != 1 || cfg.Methods[0].FreeOver != 50 || cfg.Methods[0].Rates[0].MaxWeight != 500 {
+		t.Errorf("Load = %+v", cfg)
+	}
+
+	if err := os.WriteFile(path, []byte("methods:\n  - id: standard\n    rates:\n      - zone: moon\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load accepted a rate of an unknown zone")
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tax computes the tax of an order from rules per country and state
+// or province. The rules are plain data, so the engine can be exercised with
+// local rule tables.
+package tax
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gopkg.in/yaml.v2"
+)
+
+// Rounding modes.
+const (
+	// RoundLine rounds the tax of every line to cents.
+	RoundLine = "line"
+	// RoundInvoice rounds only the totals of the tax breakdown.
+	RoundInvoice = "invoice"
+)
+
+// Rules is the tax configuration of the store.
+type Rules struct {
+	// Inclusive means that prices already contain the tax, which is then
+	// only broken out; otherwise the tax is added on top of the prices.
+	Inclusive bool `yaml:"inclusive"`
+	// Rounding is RoundLine or RoundInvoice, RoundLine when empty.
+	Rounding string   `yaml:"rounding"`
+	Regions  []Region `yaml:"regions"`
+}
+
+// Region is the tax of a country, or of one of its states or provinces. The
+// rules of a country and of the state of the address both apply, like GST and
+// PST in Canada.
+type Region struct {
+	Country string `yaml:"country"`
+	// State limits the rule to a state or province, empty for the whole country.
+	State string `yaml:"state"`
+	// Name shows in the tax breakdown, e.g. "VAT".
+	Name string `yaml:"name"`
+	// Rate is a percentage.
+	Rate float64 `yaml:"rate"`
+	// Categories overrides the rate for the lines of a category.
+	Categories map[string]float64 `yaml:"categories"`
+}
+
+// Line is an order line to tax.
+type Line struct {
+	Categories []string
+	// Amount is the price of the line after discounts.
+	Amount float64
+}
+
+// Component is an entry of the tax breakdown.
+type Component struct {
+	Name   string
+	Rate   float64
+	Amount float64
+}
+
+// Result is the tax of an order.
+type Result struct {
+	Inclusive bool
+	// LineTaxes holds the tax of every line, in the order of the lines,
+	// rounded to cents. With RoundInvoice they may not add up to Total.
+	LineTaxes  []float64
+	Components []Component
+	Total      float64
+}
+
+// Default holds the rules of the store, loaded by Init.
+var Default = &Rules{}
+
+// Init loads Default from the rules file of the configuration. Without a
+// rules file nothing is taxed.
+func Init() {
+	path := conf.GetConf().Tax.RulesFile
+	if path == "" {
+		klog.Warn("no tax rules file configured, orders are not taxed")
+		return
+	}
+	rules, err := Load(path)
+	if err != nil {
+		panic(err)
+	}
+	Default = rules
+}
+
+// Load reads rules from a yaml file.
+func Load(path string) (*Rules, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules := &Rules{}
+	if err = yaml.Unmarshal(content, rules); err != nil {
+		return nil, err
+	}
+	if err = rules.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// Validate checks the rounding mode and the rates.
+func (r *Rules) Validate() error {
+	if r.Rounding != "" && r.Rounding != RoundLine && r.Rounding != RoundInvoice {
+		return fmt.Errorf("rounding must be %s or %s", RoundLine, RoundInvoice)
+	}
+	for _, region := range r.Regions {
+		if region.Country == "" {
+			return fmt.Errorf("region %q has no country", region.Name)
+		}
+		if !validRate(region.Rate) {
+			return fmt.Errorf("region %q has invalid rate %v", region.Name, region.Rate)
+		}
+		for c, rate := range region.Categories {
+			if !validRate(rate) {
+				return fmt.Errorf("region %q has invalid rate %v for category %q", region.Name, rate, c)
+			}
+		}
+	}
+	return nil
+}
+
+func validRate(rate float64) bool {
+	return rate >= 0 && rate <= 100
+}
+
+// regions returns the rules that apply to an address, country rules first.
+func (r *Rules) regions(country, state string) []Region {
+	country, state = strings.TrimSpace(country), strings.TrimSpace(state)
+	var countryRules, stateRules []Region
+	for _, region := range r.Regions {
+		if !strings.EqualFold(region.Country, country) {
+			continue
+		}
+		switch {
+		case region.State == "":
+			countryRules = append(countryRules, region)
+		case strings.EqualFold(region.State, state):
+			stateRules = append(stateRules, region)
+		}
+	}
+	return append(countryRules, stateRules...)
+}
+
+// rate is the rate of the region for a line: the override of the first
+// category of the line that has one, else the rate of the region.
+func (region Region) rate(l Line) float64 {
+	for _, c := range l.Categories {
+		if rate, ok := region.Categories[c]; ok {
+			return rate
+		}
+	}
+	return region.Rate
+}
+
+// Calculate computes the tax of the lines shipped to an address. Lines are
+// taxed at the rules of the country and of the state of the address; an
+// address without rules is not taxed.
+func Calculate(r *Rules, country, state string, lines []Line) Result {
+	res := Result{LineTaxes: make([]float64, len(lines))}
+	if r == nil {
+		return res
+	}
+	res.Inclusive = r.Inclusive
+	regions := r.regions(country, state)
+	if len(regions) == 0 {
+		return res
+	}
+
+	type key struct {
+		name string
+		rate float64
+	}
+	amounts := make(map[key]float64)
+	var order []key
+	for i, l := range lines {
+		rates := make([]float64, len(regions))
+		var totalRate float64
+		for j, region := range regions {
+			rates[j] = region.rate(l)
+			totalRate += rates[j]
+		}
+		if totalRate == 0 || l.Amount <= 0 {
+			continue
+		}
+		// the taxable base: the price itself, or the price without the
+		// tax it contains
+		base := l.Amount
+		if r.Inclusive {
+			base = l.Amount * 100 / (100 + totalRate)
+		}
+		var lineTax float64
+		for j, region := range regions {
+			if rates[j] == 0 {
+				continue
+			}
+			t := base * rates[j] / 100
+			if r.Rounding != RoundInvoice {
+				t = round(t)
+			}
+			k := key{name: regionName(region), rate: rates[j]}
+			if _, ok := amounts[k]; !ok {
+				order = append(order, k)
+			}
+			amounts[k] += t
+			lineTax += t
+		}
+		res.LineTaxes[i] = round(lineTax)
+	}
+	for _, k := range order {
+		amount := round(amounts[k])
+		res.Components = append(res.Components, Component{Name: k.name, Rate: k.rate, Amount: amount})
+		res.Total += amount
+	}
+	res.Total = round(res.Total)
+	return res
+}
+
+func regionName(region Region) string {
+	if region.Name != "" {
+		return region.Name
+	}
+	if region.State != "" {
+		return region.Country + "/" + region.State
+	}
+	return region.Country
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tax
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var testRules = Rules{
+	Regions: []Region{
+		{Country: "CA", Name: "GST", Rate: 5},
+		{Country: "CA", State: "BC", Name: "PST", Rate: 7, Categories: map[string]float64{"Book": 0}},
+		{Country: "China", Name: "VAT", Rate: 13, Categories: map[string]float64{"Book": 9}},
+	},
+}
+
+func TestCalculateExclusive(t *testing.T) {
+	lines := []Line{{Amount: 10}, {Categories: []string{"Book"}, Amount: 20}}
+	got := Calculate(&testRules, "ca", " bc ", lines)
+	want := Result{
+		LineTaxes: []float64{1.2, 1},
+		Components: []Component{
+			{Name: "GST", Rate: 5, Amount: 1.5},
+			{Name: "PST", Rate: 7, Amount: 0.7},
+		},
+		Total: 2.2,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Calculate = %+v, want %+v", got, want)
+	}
+
+	// only the country rule applies outside BC
+	if got := Calculate(&testRules, "CA", "ON", lines); got.Total != 1.5 {
+		t.Errorf("Calculate(ON) total = %v, want 1.5", got.Total)
+	}
+	if got := Calculate(&testRules, "US", "CA", lines); got.Total != 0 || got.Components != nil {
+		t.Errorf("Calculate(US) = %+v, want no tax", got)
+	}
+}
+
+func TestCalculateCategoryRate(t *testing.T) {
+	got := Calculate(&testRules, "China", "", []Line{{Amount: 100}, {Categories: []string{"Book"}, Amount: 100}})
+	want := []Component{{Name: "VAT", Rate: 13, Amount: 13}, {Name: "VAT", Rate: 9, Amount: 9}}
+	if !reflect.DeepEqual(got.Components, want) || got.Total != 22 {
+		t.Errorf("Calculate = %+v", got)
+	}
+}
+
+func TestCalculateInclusive(t *testing.T) {
+	rules := testRules
+	rules.Inclusive = true
+	got := Calculate(&rules, "China", "", []Line{{Amount: 113}})
+	if !got.Inclusive || got.Total != 13 || got.LineTaxes[0] != 13 {
+		t.Errorf("Calculate = %+v, want 13 of tax included", got)
+	}
+}
+
+func TestCalculateRounding(t *testing.T) {
+	rules := Rules{Regions: []Region{{Country: "X", Rate: 5}}}
+	lines := []Line{{Amount: 0.3}, {Amount: 0.3}, {Amount: 0.3}}
+	// 0.015 a line: rounded per line 0.02 * 3, per invoice 0.045
+	if got := Calculate(&rules, "X", "", lines); got.Total != 0.06 {
+		t.Errorf("per line total = %v, want 0.06", got.Total)
+	}
+	rules.Rounding = RoundInvoice
+	if got := Calculate(&rules, "X", "", lines); got.Total != 0.05 {
+		t.Errorf("per invoice total = %v, want 0.05", got.Total)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tax.yaml")
+	content := "inclusive: true\nrounding: invoice\nregions:\n  - country: CA\n    name: GST\n    rate: 5\n    categories:\n      Book: 0\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rules.Inclusive || rules.Rounding != RoundInvoice || len(rules.Regions) != 1 || rules.Regions[0].Categories["Book"] != 0 {
+		t.Errorf("Load = %+v", rules)
+	}
+
+	if err := os.WriteFile(path, []byte("regions:\n  - country: CA\n    rate: 120\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load accepted a rate over 100")
+	}
+}
@@ -18,7 +18,9 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/kr/pretty"
 	"gopkg.in/validator.v2"
@@ -31,11 +33,16 @@ var (
 )
 
 type Config struct {
-	Env      string
-	Kitex    Kitex    `yaml:"kitex"`
-	MySQL    MySQL    `yaml:"mysql"`
-	Redis    Redis    `yaml:"redis"`
-	Registry Registry `yaml:"registry"`
+	Env        string
+	Kitex      Kitex           `yaml:"kitex"`
+	MySQL      MySQL           `yaml:"mysql"`
+	Redis      Redis           `yaml:"redis"`
+	Registry   Registry        `yaml:"registry"`
+	Tax        Tax             `yaml:"tax"`
+	Shipping   Shipping        `yaml:"shipping"`
+	Risk       Risk            `yaml:"risk"`
+	Downstream Downstream      `yaml:"downstream"`
+	Currency   currency.Config `yaml:"currency"`
 }
 
 type MySQL struct {
@@ -63,6 +70,51 @@ type Kitex struct {
 	LogMaxAge       int    `yaml:"log_max_age"`
 }
 
+// Tax points at the yaml file with the tax rules, see biz/tax.
+type Tax struct {
+	RulesFile string `yaml:"rules_file"`
+}
+
+// Shipping points at the yaml file with the shipping methods, see
+// biz/shipping.
+type Shipping struct {
+	MethodsFile string `yaml:"methods_file"`
+}
+
+// Risk points at the yaml file with the fraud rules, see biz/risk.
+type Risk struct {
+	RulesFile string `yaml:"rules_file"`
+}
+
+// Downstream configures the calls checkout makes to other services.
+// Concurrency bounds how many independent calls of a checkout run at once
+// and ProductBatchSize how many products one product lookup fetches.
+// Timeout is the default timeout of a call, Calls holds the policies of
+// single methods, keyed by the method name in the IDL.
+type Downstream struct {
+	Concurrency      int             `yaml:"concurrency"`
+	ProductBatchSize int             `yaml:"product_batch_size"`
+	Timeout          time.Duration   `yaml:"timeout"`
+	Calls            map[string]Call `yaml:"calls"`
+}
+
+// Call is the timeout and retry policy of a downstream call. Calls are not
+// retried by default, only those that are safe to repeat should be.
+type Call struct {
+	Timeout time.Duration `yaml:"timeout"`
+	Retries int           `yaml:"retries"`
+}
+
+// Call returns the policy of method, using the default timeout when the
+// method has none of its own.
+func (d Downstream) Call(method string) Call {
+	c := d.Calls[method]
+	if c.Timeout == 0 {
+		c.Timeout = d.Timeout
+	}
+	return c
+}
+
 type Registry struct {
 	RegistryAddress []string `yaml:"registry_address"`
 	Username        string   `yaml:"username"`
@@ -37,3 +37,31 @@ func (s *CheckoutServiceImpl) Checkout(ctx context.Context, req *checkout.Checko
 
 	return resp, err
 }
+
+// Quote implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) Quote(ctx context.Context, req *checkout.QuoteReq) (resp *checkout.QuoteResp, err error) {
+	resp, err = service.NewQuoteService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListShippingOptions implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) ListShippingOptions(ctx context.Context, req *checkout.ListShippingOptionsReq) (resp *checkout.ListShippingOptionsResp, err error) {
+	resp, err = service.NewListShippingOptionsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetCheckoutStatus implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) GetCheckoutStatus(ctx context.Context, req *checkout.GetCheckoutStatusReq) (resp *checkout.GetCheckoutStatusResp, err error) {
+	resp, err = service.NewGetCheckoutStatusService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ReviewOrder implements the CheckoutServiceImpl interface.
+func (s *CheckoutServiceImpl) ReviewOrder(ctx context.Context, req *checkout.ReviewOrderReq) (resp *checkout.ReviewOrderResp, err error) {
+	resp, err = service.NewReviewOrderService(ctx).Run(req)
+
+	return resp, err
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/common/mtl"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// StepLatency is the latency of each step of a checkout, labelled with
+// whether the step succeeded.
+var StepLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "checkout",
+	Name:      "step_duration_seconds",
+	Help:      "Latency of the steps of a checkout.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"step", "result"})
+
+// RiskDecisions counts the risk decisions on orders: approve, review or
+// reject.
+var RiskDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "checkout",
+	Name:      "risk_decisions_total",
+	Help:      "Risk decisions on orders.",
+}, []string{"decision"})
+
+// Init registers the checkout metrics with the registry of common/mtl, it
+// must run after mtl.InitMetric.
+func Init() {
+	mtl.Registry.MustRegister(StepLatency, RiskDecisions)
+}
+
+// ObserveStep records the latency of step since start.
+func ObserveStep(step string, start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	StepLatency.WithLabelValues(step, result).Observe(time.Since(start).Seconds())
+}
@@ -15,11 +15,25 @@
 package mq
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	EmailStream   = "EMAIL"
+	EmailSubject  = "email"
+	NotifySubject = "notify"
+	// HeaderEmailId lets the email service log deliveries under a stable id
+	HeaderEmailId = "Gomall-Email-Id"
 )
 
 var (
 	Nc  *nats.Conn
+	Js  jetstream.JetStream
 	err error
 )
 
@@ -28,4 +42,21 @@ func Init() {
 	if err != nil {
 		panic(err)
 	}
+	Js, err = jetstream.New(Nc)
+	if err != nil {
+		panic(err)
+	}
+
+	// the email service owns the stream, create it only when checkout starts first
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = Js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:      EmailStream,
+		Subjects:  []string{EmailSubject, NotifySubject},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	})
+	if err != nil && !errors.Is(err, jetstream.ErrStreamNameAlreadyInUse) {
+		panic(err)
+	}
 }
@@ -16,6 +16,7 @@ package rpc
 
 import (
 	"sync"
+	"time"
 
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
 	checkoututils "github.com/cloudwego/biz-demo/gomall/app/checkout/utils"
@@ -24,52 +25,116 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order/orderservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment/paymentservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product/productcatalogservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion/promotionservice"
 	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
 )
 
 var (
-	CartClient    cartservice.Client
-	ProductClient productcatalogservice.Client
-	PaymentClient paymentservice.Client
-	OrderClient   orderservice.Client
-	once          sync.Once
-	err           error
-	registryAddr  string
-	serviceName   string
-	commonSuite   client.Option
+	CartClient      cartservice.Client
+	ProductClient   productcatalogservice.Client
+	PaymentClient   paymentservice.Client
+	OrderClient     orderservice.Client
+	PromotionClient promotionservice.Client
+	once            sync.Once
+	err             error
+	registryAddr    string
+	serviceName     string
+	clientOpts      []client.Option
+
+	// Concurrency bounds how many independent downstream calls of one
+	// checkout run at once.
+	Concurrency = 4
+	// ProductBatchSize is the number of products fetched per lookup.
+	ProductBatchSize = 20
 )
 
 func InitClient() {
 	once.Do(func() {
 		registryAddr = conf.GetConf().Registry.RegistryAddress[0]
 		serviceName = conf.GetConf().Kitex.Service
-		commonSuite = client.WithSuite(clientsuite.CommonGrpcClientSuite{
+		commonSuite := client.WithSuite(clientsuite.CommonGrpcClientSuite{
 			CurrentServiceName: serviceName,
 			RegistryAddr:       registryAddr,
 		})
+		downstream := conf.GetConf().Downstream
+		if downstream.Concurrency > 0 {
+			Concurrency = downstream.Concurrency
+		}
+		if downstream.ProductBatchSize > 0 {
+			ProductBatchSize = downstream.ProductBatchSize
+		}
+		clientOpts = append([]client.Option{commonSuite}, callOptions(downstream)...)
 		initCartClient()
 		initProductClient()
 		initPaymentClient()
 		initOrderClient()
+		initPromotionClient()
 	})
 }
 
+// callOptions applies the per-method timeouts and retry policies of cfg.
+func callOptions(cfg conf.Downstream) []client.Option {
+	opts := []client.Option{client.WithTimeoutProvider(timeoutProvider{cfg: cfg})}
+	policies := make(map[string]retry.Policy)
+	for method, call := range cfg.Calls {
+		if call.Retries > 0 {
+			p := retry.NewFailurePolicy()
+			p.StopPolicy.MaxRetryTimes = call.Retries
+			policies[method] = retry.BuildFailurePolicy(p)
+		}
+	}
+	if len(policies) > 0 {
+		opts = append(opts, client.WithRetryMethodPolicies(policies))
+	}
+	return opts
+}
+
+// timeoutProvider sets the RPC timeout of each call to the one configured
+// for its method, keeping the default connect and read/write timeouts.
+type timeoutProvider struct {
+	cfg conf.Downstream
+}
+
+func (p timeoutProvider) Timeouts(ri rpcinfo.RPCInfo) rpcinfo.Timeouts {
+	timeout := p.cfg.Call(ri.Invocation().MethodName()).Timeout
+	if timeout <= 0 {
+		return nil
+	}
+	return rpcTimeout{Timeouts: ri.Config(), timeout: timeout}
+}
+
+type rpcTimeout struct {
+	rpcinfo.Timeouts
+	timeout time.Duration
+}
+
+func (t rpcTimeout) RPCTimeout() time.Duration {
+	return t.timeout
+}
+
 func initProductClient() {
-	ProductClient, err = productcatalogservice.NewClient("product", commonSuite)
+	ProductClient, err = productcatalogservice.NewClient("product", clientOpts...)
 	checkoututils.MustHandleError(err)
 }
 
 func initCartClient() {
-	CartClient, err = cartservice.NewClient("cart", commonSuite)
+	CartClient, err = cartservice.NewClient("cart", clientOpts...)
 	checkoututils.MustHandleError(err)
 }
 
 func initPaymentClient() {
-	PaymentClient, err = paymentservice.NewClient("payment", commonSuite)
+	PaymentClient, err = paymentservice.NewClient("payment", clientOpts...)
 	checkoututils.MustHandleError(err)
 }
 
 func initOrderClient() {
-	OrderClient, err = orderservice.NewClient("order", commonSuite)
+	OrderClient, err = orderservice.NewClient("order", clientOpts...)
+	checkoututils.MustHandleError(err)
+}
+
+func initPromotionClient() {
+	PromotionClient, err = promotionservice.NewClient("promotion", clientOpts...)
 	checkoututils.MustHandleError(err)
 }
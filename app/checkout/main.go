@@ -17,10 +17,19 @@ package main
 import (
 	"net"
 	"strings"
+	"time"
 
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/risk"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/shipping"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/biz/tax"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/metrics"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/mq"
 	"github.com/cloudwego/biz-demo/gomall/app/checkout/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
 	"github.com/cloudwego/biz-demo/gomall/common/serversuite"
 	"github.com/cloudwego/biz-demo/gomall/common/utils"
@@ -33,6 +42,9 @@ import (
 
 var serviceName = conf.GetConf().Kitex.Service
 
+// drainTimeout is how long shutdown waits for running checkouts.
+const drainTimeout = 30 * time.Second
+
 func main() {
 	_ = godotenv.Load()
 	mtl.InitLog(&lumberjack.Logger{
@@ -43,8 +55,20 @@ func main() {
 	})
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
+	metrics.Init()
+	dal.Init()
 	rpc.InitClient()
 	mq.Init()
+	tax.Init()
+	shipping.Init()
+	risk.Init(risk.RedisCounter{Client: redis.RedisClient})
+	currency.Init(conf.GetConf().Currency)
+	service.StartRecovery()
+	server.RegisterShutdownHook(func() {
+		if !service.Drain(drainTimeout) {
+			klog.Warn("checkouts still running at shutdown are left to the recovery")
+		}
+	})
 	opts := kitexInit()
 
 	svr := checkoutservice.NewServer(new(CheckoutServiceImpl), opts...)
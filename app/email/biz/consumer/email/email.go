@@ -16,12 +16,21 @@ package email
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
 	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
-	"github.com/cloudwego/biz-demo/gomall/app/email/infra/notify"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/server"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/protobuf/proto"
@@ -29,32 +38,175 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
 )
 
-func ConsumerInit() {
-	// Connect to a server
+const durableName = "email-sender"
 
-	tracer := otel.Tracer("shop-nats-consumer")
-	sub, err := mq.Nc.Subscribe("email", func(m *nats.Msg) {
-		var req email.EmailReq
-		err := proto.Unmarshal(m.Data, &req)
-		if err != nil {
-			klog.Error(err)
+var (
+	maxDeliver = 5
+	backoff    = []time.Duration{time.Second}
+)
+
+func ConsumerInit() {
+	q := conf.GetConf().Queue
+	if q.MaxDeliver > 0 {
+		maxDeliver = q.MaxDeliver
+	}
+	if len(q.BackoffSeconds) > 0 {
+		backoff = make([]time.Duration, 0, len(q.BackoffSeconds))
+		for _, s := range q.BackoffSeconds {
+			backoff = append(backoff, time.Duration(s)*time.Second)
 		}
-		noopEmail := notify.NewNoopEmail()
-		_ = noopEmail.Send(&req)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// redelivery is bounded by process itself, so that exhausted emails
+	// end up in the dead letter stream instead of being dropped silently
+	cons, err := mq.Js.CreateOrUpdateConsumer(ctx, mq.EmailStream, jetstream.ConsumerConfig{
+		Durable:        durableName,
+		FilterSubjects: []string{mq.EmailSubject, mq.NotifySubject},
+		AckPolicy:      jetstream.AckExplicitPolicy,
+		AckWait:        30 * time.Second,
+		MaxDeliver:     -1,
+	})
+	if err != nil {
+		panic(err)
+	}
 
+	tracer := otel.Tracer("shop-nats-consumer")
+	cc, err := cons.Consume(func(m jetstream.Msg) {
 		// consumer otel
-		ctx := context.Background()
-		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(m.Header))
-		_, span := tracer.Start(ctx, "shop-email-consumer")
+		ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(m.Headers()))
+		ctx, span := tracer.Start(ctx, "shop-email-consumer")
 		defer span.End()
 		// consumer otel
+
+		process(ctx, m)
 	})
 	if err != nil {
 		panic(err)
 	}
 
 	server.RegisterShutdownHook(func() {
-		sub.Unsubscribe() //nolint:errcheck
+		cc.Stop()
 		mq.Nc.Close()
 	})
 }
+
+// process delivers one email or notification and settles the message: ack on
+// success, nak with backoff on transient failures, dead letter once retries are
+// exhausted or the message can never succeed.
+func process(ctx context.Context, m jetstream.Msg) {
+	md, err := m.Metadata()
+	if err != nil {
+		klog.CtxErrorf(ctx, "read email metadata failed: %v", err)
+		_ = m.Nak()
+		return
+	}
+
+	// publishers may set a stable id so that the delivery log can be looked up
+	// by it, otherwise the stream sequence identifies the email
+	emailId := m.Headers().Get(mq.HeaderEmailId)
+	if emailId == "" {
+		emailId = fmt.Sprintf("%s-%d", mq.EmailStream, md.Sequence.Stream)
+	}
+
+	if m.Subject() == mq.NotifySubject {
+		err = dispatch(ctx, m.Data(), emailId)
+	} else {
+		err = deliver(ctx, m.Data(), emailId)
+	}
+	if errors.Is(err, errUndecodable) {
+		// nothing got far enough to record an attempt, record it here so
+		// that the message is marked dead below
+		klog.CtxErrorf(ctx, "unmarshal %s message failed: %v", m.Subject(), err)
+		recordUndecodable(ctx, emailId, err)
+	}
+	if err != nil {
+		_, permanent := kerrors.FromBizStatusError(err)
+		permanent = permanent || errors.Is(err, errUndecodable)
+		if permanent || md.NumDelivered >= uint64(maxDeliver) {
+			if deadLetter(ctx, m, md.NumDelivered, err) {
+				if err = model.MarkDead(mysql.DB, ctx, emailId, err.Error()); err != nil {
+					klog.CtxErrorf(ctx, "mark %s dead failed: %v", emailId, err)
+				}
+			}
+			return
+		}
+		klog.CtxWarnf(ctx, "deliver %s failed, attempt %d/%d: %v", emailId, md.NumDelivered, maxDeliver, err)
+		_ = m.NakWithDelay(retryDelay(backoff, md.NumDelivered))
+		return
+	}
+	_ = m.Ack()
+}
+
+// deadLetter moves m to the dead letter stream and reports whether it succeeded.
+func deadLetter(ctx context.Context, m jetstream.Msg, deliveries uint64, cause error) bool {
+	dl := &nats.Msg{
+		Subject: mq.DeadLetterSubject,
+		Data:    m.Data(),
+		Header:  make(nats.Header),
+	}
+	for k, v := range m.Headers() {
+		dl.Header[k] = v
+	}
+	dl.Header.Set(mq.HeaderError, cause.Error())
+	dl.Header.Set(mq.HeaderDeliveries, strconv.FormatUint(deliveries, 10))
+	dl.Header.Set(mq.HeaderFailedAt, strconv.FormatInt(time.Now().Unix(), 10))
+	dl.Header.Set(mq.HeaderSubject, m.Subject())
+
+	if _, err := mq.Js.PublishMsg(ctx, dl); err != nil {
+		// keep the email in the queue rather than losing it
+		klog.CtxErrorf(ctx, "publish dead letter failed: %v", err)
+		_ = m.NakWithDelay(retryDelay(backoff, deliveries))
+		return false
+	}
+	klog.CtxErrorf(ctx, "%s dead-lettered after %d attempts: %v", m.Subject(), deliveries, cause)
+	_ = m.Term()
+	return true
+}
+
+var errUndecodable = errors.New("undecodable message")
+
+// recordUndecodable logs a failed attempt for a message that could not be
+// read, its channel is unknown.
+func recordUndecodable(ctx context.Context, emailId string, cause error) {
+	if err := model.RecordAttempt(mysql.DB, ctx, &model.EmailLog{
+		EmailId: emailId,
+		Status:  model.EmailStatusFailed,
+		Error:   cause.Error(),
+	}); err != nil {
+		klog.CtxErrorf(ctx, "record undecodable %s failed: %v", emailId, err)
+	}
+}
+
+func deliver(ctx context.Context, data []byte, emailId string) error {
+	var req email.EmailReq
+	if err := proto.Unmarshal(data, &req); err != nil {
+		return fmt.Errorf("%w: %v", errUndecodable, err)
+	}
+	return service.NewSendService(ctx).Deliver(&req, emailId)
+}
+
+func dispatch(ctx context.Context, data []byte, notificationId string) error {
+	var req email.NotifyReq
+	if err := proto.Unmarshal(data, &req); err != nil {
+		return fmt.Errorf("%w: %v", errUndecodable, err)
+	}
+	_, err := service.NewNotifyService(ctx).Dispatch(&req, notificationId)
+	return err
+}
+
+// retryDelay returns the backoff for the given delivery attempt, reusing the
+// last step once the schedule is exhausted.
+func retryDelay(schedule []time.Duration, attempt uint64) time.Duration {
+	if len(schedule) == 0 {
+		return 0
+	}
+	if attempt == 0 {
+		attempt = 1
+	}
+	if attempt > uint64(len(schedule)) {
+		return schedule[len(schedule)-1]
+	}
+	return schedule[attempt-1]
+}
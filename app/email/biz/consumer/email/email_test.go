@@ -16,7 +16,27 @@ package email
 
 import (
 	"testing"
+	"time"
 )
 
 func TestEmailConsumer(t *testing.T) {
 }
+
+func TestRetryDelay(t *testing.T) {
+	schedule := []time.Duration{time.Second, 10 * time.Second, time.Minute}
+	cases := map[uint64]time.Duration{
+		0: time.Second,
+		1: time.Second,
+		2: 10 * time.Second,
+		3: time.Minute,
+		9: time.Minute,
+	}
+	for attempt, want := range cases {
+		if got := retryDelay(schedule, attempt); got != want {
+			t.Errorf("retryDelay(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+	if got := retryDelay(nil, 1); got != 0 {
+		t.Errorf("retryDelay with empty schedule = %v, want 0", got)
+	}
+}
@@ -15,6 +15,10 @@
 package mysql
 
 import (
+	"fmt"
+	"os"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/model"
 	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
 
 	"gorm.io/driver/mysql"
@@ -27,7 +31,8 @@ var (
 )
 
 func Init() {
-	DB, err = gorm.Open(mysql.Open(conf.GetConf().MySQL.DSN),
+	dsn := fmt.Sprintf(conf.GetConf().MySQL.DSN, os.Getenv("MYSQL_USER"), os.Getenv("MYSQL_PASSWORD"), os.Getenv("MYSQL_HOST"))
+	DB, err = gorm.Open(mysql.Open(dsn),
 		&gorm.Config{
 			PrepareStmt:            true,
 			SkipDefaultTransaction: true,
@@ -36,4 +41,9 @@ func Init() {
 	if err != nil {
 		panic(err)
 	}
+	if os.Getenv("GO_ENV") != "online" {
+		DB.AutoMigrate( //nolint:errcheck
+			&model.EmailLog{},
+		)
+	}
 }
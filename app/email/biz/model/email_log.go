@@ -0,0 +1,107 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EmailStatus string
+
+const (
+	EmailStatusSent   EmailStatus = "sent"
+	EmailStatusFailed EmailStatus = "failed"
+	EmailStatusDead   EmailStatus = "dead"
+)
+
+// channels a notification can be delivered on
+const (
+	ChannelEmail   = "email"
+	ChannelSMS     = "sms"
+	ChannelWebhook = "webhook"
+)
+
+// EmailLog tracks the delivery of one email, text message or webhook across
+// all of its attempts.
+type EmailLog struct {
+	Base
+	EmailId           string      `gorm:"uniqueIndex;size:128"`
+	Channel           string      `gorm:"size:16;default:email"`
+	Recipient         string      `gorm:"index;size:256"`
+	Template          string      `gorm:"size:64"`
+	Subject           string      `gorm:"size:512"`
+	OrderId           string      `gorm:"index;size:256"`
+	Status            EmailStatus `gorm:"size:16"`
+	Attempts          uint32
+	ProviderMessageId string `gorm:"size:256"`
+	Error             string `gorm:"type:text"`
+}
+
+func (e EmailLog) TableName() string {
+	return "email_log"
+}
+
+// RecordAttempt stores the outcome of an attempt, creating the log on the
+// first one and bumping the attempt counter on every later one.
+func RecordAttempt(db *gorm.DB, ctx context.Context, log *EmailLog) error {
+	log.Attempts = 1
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "email_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"status":              log.Status,
+			"attempts":            gorm.Expr("attempts + 1"),
+			"provider_message_id": log.ProviderMessageId,
+			"error":               log.Error,
+			"updated_at":          gorm.Expr("CURRENT_TIMESTAMP(3)"),
+		}),
+	}).Create(log).Error
+}
+
+// MarkDead marks the failed deliveries of an email or notification as dead.
+// The deliveries on extra channels are logged as "<emailId>-<channel>".
+func MarkDead(db *gorm.DB, ctx context.Context, emailId string, errMsg string) error {
+	return db.WithContext(ctx).Model(&EmailLog{}).
+		Where("(email_id = ? OR email_id LIKE ?) AND status = ?", emailId, emailId+"-%", EmailStatusFailed).
+		Updates(map[string]interface{}{"status": EmailStatusDead, "error": errMsg}).Error
+}
+
+type EmailLogFilter struct {
+	EmailId   string
+	Recipient string
+	OrderId   string
+	Template  string
+}
+
+func (f EmailLogFilter) scope(db *gorm.DB) *gorm.DB {
+	return db.Where(&EmailLog{EmailId: f.EmailId, Recipient: f.Recipient, OrderId: f.OrderId, Template: f.Template})
+}
+
+func ListEmailLogs(db *gorm.DB, ctx context.Context, f EmailLogFilter, offset, limit int) (logs []EmailLog, total int64, err error) {
+	q := db.WithContext(ctx).Model(&EmailLog{}).Scopes(f.scope)
+	if err = q.Count(&total).Error; err != nil {
+		return
+	}
+	err = q.Order("id desc").Offset(offset).Limit(limit).Find(&logs).Error
+	return
+}
+
+// GetLatestEmailLog returns the most recent email matching f.
+func GetLatestEmailLog(db *gorm.DB, ctx context.Context, f EmailLogFilter) (log EmailLog, err error) {
+	err = db.WithContext(ctx).Model(&EmailLog{}).Scopes(f.scope).Order("id desc").First(&log).Error
+	return
+}
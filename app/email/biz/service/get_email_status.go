@@ -0,0 +1,54 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/model"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type GetEmailStatusService struct {
+	ctx context.Context
+} // NewGetEmailStatusService new GetEmailStatusService
+func NewGetEmailStatusService(ctx context.Context) *GetEmailStatusService {
+	return &GetEmailStatusService{ctx: ctx}
+}
+
+// Run returns the latest email matching the given id, recipient or order.
+func (s *GetEmailStatusService) Run(req *email.GetEmailStatusReq) (resp *email.GetEmailStatusResp, err error) {
+	if req.EmailId == "" && req.Recipient == "" && req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "email_id, recipient or order_id is required")
+	}
+
+	l, err := model.GetLatestEmailLog(mysql.DB, s.ctx, model.EmailLogFilter{
+		EmailId:   req.EmailId,
+		Recipient: req.Recipient,
+		OrderId:   req.OrderId,
+		Template:  req.Template,
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "email not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &email.GetEmailStatusResp{Email: toEmailLog(l)}, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultDeadLetterLimit = 50
+	maxDeadLetterLimit     = 500
+)
+
+type ListDeadLettersService struct {
+	ctx context.Context
+} // NewListDeadLettersService new ListDeadLettersService
+func NewListDeadLettersService(ctx context.Context) *ListDeadLettersService {
+	return &ListDeadLettersService{ctx: ctx}
+}
+
+// Run lists dead-lettered emails and notifications, oldest first.
+func (s *ListDeadLettersService) Run(req *email.ListDeadLettersReq) (resp *email.ListDeadLettersResp, err error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultDeadLetterLimit
+	}
+	if limit > maxDeadLetterLimit {
+		limit = maxDeadLetterLimit
+	}
+
+	stream, err := mq.Js.Stream(s.ctx, mq.DeadLetterStream)
+	if err != nil {
+		return nil, err
+	}
+	info, err := stream.Info(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &email.ListDeadLettersResp{}
+	if info.State.Msgs == 0 {
+		return resp, nil
+	}
+	for seq := info.State.FirstSeq; seq <= info.State.LastSeq && len(resp.DeadLetters) < limit; seq++ {
+		msg, err := stream.GetMsg(s.ctx, seq)
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			// replayed dead letters leave gaps in the sequence
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp.DeadLetters = append(resp.DeadLetters, toDeadLetter(msg))
+	}
+	return resp, nil
+}
+
+func toDeadLetter(msg *jetstream.RawStreamMsg) *email.DeadLetter {
+	dl := &email.DeadLetter{
+		Sequence: msg.Sequence,
+		Error:    msg.Header.Get(mq.HeaderError),
+		FailedAt: msg.Time.Unix(),
+	}
+	if v, err := strconv.ParseUint(msg.Header.Get(mq.HeaderDeliveries), 10, 32); err == nil {
+		dl.Deliveries = uint32(v)
+	}
+	if v, err := strconv.ParseInt(msg.Header.Get(mq.HeaderFailedAt), 10, 64); err == nil {
+		dl.FailedAt = v
+	}
+	if msg.Header.Get(mq.HeaderSubject) == mq.NotifySubject {
+		var req email.NotifyReq
+		if proto.Unmarshal(msg.Data, &req) == nil {
+			dl.Notification = &req
+		}
+		return dl
+	}
+	var req email.EmailReq
+	if proto.Unmarshal(msg.Data, &req) == nil {
+		dl.Email = &req
+	}
+	return dl
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/model"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ListEmailsService struct {
+	ctx context.Context
+} // NewListEmailsService new ListEmailsService
+func NewListEmailsService(ctx context.Context) *ListEmailsService {
+	return &ListEmailsService{ctx: ctx}
+}
+
+// Run lists the delivery log of a recipient or an order, newest first.
+func (s *ListEmailsService) Run(req *email.ListEmailsReq) (resp *email.ListEmailsResp, err error) {
+	if req.Recipient == "" && req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "recipient or order_id is required")
+	}
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	logs, total, err := model.ListEmailLogs(mysql.DB, s.ctx, model.EmailLogFilter{
+		Recipient: req.Recipient,
+		OrderId:   req.OrderId,
+	}, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &email.ListEmailsResp{Total: total}
+	for _, l := range logs {
+		resp.Emails = append(resp.Emails, toEmailLog(l))
+	}
+	return resp, nil
+}
+
+func toEmailLog(l model.EmailLog) *email.EmailLog {
+	return &email.EmailLog{
+		EmailId:           l.EmailId,
+		Channel:           l.Channel,
+		Recipient:         l.Recipient,
+		Template:          l.Template,
+		Subject:           l.Subject,
+		OrderId:           l.OrderId,
+		Status:            string(l.Status),
+		Attempts:          l.Attempts,
+		ProviderMessageId: l.ProviderMessageId,
+		Error:             l.Error,
+		CreatedAt:         l.CreatedAt.Unix(),
+		UpdatedAt:         l.UpdatedAt.Unix(),
+	}
+}
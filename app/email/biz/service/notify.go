@@ -0,0 +1,259 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/notify"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/rpc"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"google.golang.org/protobuf/proto"
+)
+
+// delivery statuses reported in NotifyResp
+const (
+	deliverySent    = "sent"
+	deliveryFailed  = "failed"
+	deliverySkipped = "skipped"
+)
+
+type NotifyService struct {
+	ctx context.Context
+} // NewNotifyService new NotifyService
+func NewNotifyService(ctx context.Context) *NotifyService {
+	return &NotifyService{ctx: ctx}
+}
+
+// Run delivers a notification on every channel it resolves to.
+func (s *NotifyService) Run(req *email.NotifyReq) (resp *email.NotifyResp, err error) {
+	return s.Dispatch(req, NewEmailId())
+}
+
+// delivery is one channel a notification is sent on.
+type delivery struct {
+	channel string
+	id      string
+	// skip is the reason the delivery is not attempted
+	skip string
+	send func() error
+}
+
+// Dispatch delivers the notification identified by notificationId and returns
+// the first delivery error. Deliveries that already succeeded under the same id
+// are not repeated, so that a queued notification can be retried as a whole.
+func (s *NotifyService) Dispatch(req *email.NotifyReq, notificationId string) (*email.NotifyResp, error) {
+	// a notification that fails before any delivery is recorded as failed
+	// under its own id, so that it can be marked dead and looked up
+	fail := func(err error) (*email.NotifyResp, error) {
+		recordAttempt(s.ctx, &model.EmailLog{EmailId: notificationId, Channel: intentChannel(req)}, "", err)
+		return nil, err
+	}
+	if req.Intent == nil {
+		return fail(kerrors.NewBizStatusError(40000, "intent is required"))
+	}
+
+	var prefs *user.GetNotificationPreferencesResp
+	if req.UserId > 0 {
+		var err error
+		prefs, err = rpc.UserClient.GetNotificationPreferences(s.ctx, &user.GetNotificationPreferencesReq{UserId: req.UserId})
+		if err != nil {
+			return fail(err)
+		}
+	}
+
+	deliveries, err := s.plan(req, prefs, notificationId)
+	if err != nil {
+		return fail(err)
+	}
+
+	resp := &email.NotifyResp{}
+	var firstErr error
+	for _, d := range deliveries {
+		r := &email.Delivery{Channel: d.channel, DeliveryId: d.id}
+		switch {
+		case d.skip != "":
+			r.Status, r.Error = deliverySkipped, d.skip
+		case s.alreadySent(d.id):
+			r.Status = deliverySent
+		default:
+			if err = d.send(); err != nil {
+				r.Status, r.Error = deliveryFailed, err.Error()
+				if firstErr == nil {
+					firstErr = err
+				}
+			} else {
+				r.Status = deliverySent
+			}
+		}
+		resp.Deliveries = append(resp.Deliveries, r)
+	}
+	return resp, firstErr
+}
+
+// plan resolves the channels of a notification. Without preferences the
+// intent is delivered on its own channel only, with preferences templated
+// emails fan out to every channel the user enabled.
+func (s *NotifyService) plan(req *email.NotifyReq, prefs *user.GetNotificationPreferencesResp, id string) ([]delivery, error) {
+	p := prefs.GetPreferences()
+	if prefs != nil && req.Topic != "" {
+		for _, topic := range p.OptOuts {
+			if topic == req.Topic {
+				return []delivery{{channel: intentChannel(req), id: id, skip: "opted out of " + topic}}, nil
+			}
+		}
+	}
+
+	switch intent := req.Intent.(type) {
+	case *email.NotifyReq_Email:
+		e := intent.Email
+		if prefs == nil {
+			return []delivery{s.emailDelivery(id, e)}, nil
+		}
+		// the address and locale default to the user's profile
+		e = proto.Clone(e).(*email.EmailReq)
+		if e.To == "" {
+			e.To = prefs.Email
+		}
+		if e.Locale == "" {
+			e.Locale = p.Locale
+		}
+		var ds []delivery
+		if p.EmailEnabled {
+			ds = append(ds, s.emailDelivery(id, e))
+		}
+		if p.SmsEnabled && p.Phone != "" {
+			text, err := notify.RenderSMS(e)
+			if err != nil {
+				return nil, kerrors.NewBizStatusError(40001, err.Error())
+			}
+			ds = append(ds, s.smsDelivery(id+"-"+model.ChannelSMS, p.Phone, text, e))
+		}
+		if p.WebhookEnabled && p.WebhookUrl != "" {
+			event, payload, err := notify.EventPayload(e)
+			if err != nil {
+				return nil, kerrors.NewBizStatusError(40001, err.Error())
+			}
+			ds = append(ds, s.webhookDelivery(id+"-"+model.ChannelWebhook, p.WebhookUrl, event, string(payload), e))
+		}
+		if len(ds) == 0 {
+			ds = append(ds, delivery{channel: model.ChannelEmail, id: id, skip: "no channel enabled"})
+		}
+		return ds, nil
+
+	case *email.NotifyReq_Sms:
+		to := intent.Sms.To
+		if prefs != nil {
+			if !p.SmsEnabled {
+				return []delivery{{channel: model.ChannelSMS, id: id, skip: "sms disabled"}}, nil
+			}
+			if to == "" {
+				to = p.Phone
+			}
+		}
+		if to == "" {
+			return nil, kerrors.NewBizStatusError(40000, "recipient is required")
+		}
+		return []delivery{s.smsDelivery(id, to, intent.Sms.Content, nil)}, nil
+
+	case *email.NotifyReq_Webhook:
+		url := intent.Webhook.Url
+		if prefs != nil {
+			if !p.WebhookEnabled {
+				return []delivery{{channel: model.ChannelWebhook, id: id, skip: "webhook disabled"}}, nil
+			}
+			if url == "" {
+				url = p.WebhookUrl
+			}
+		}
+		if url == "" {
+			return nil, kerrors.NewBizStatusError(40000, "url is required")
+		}
+		return []delivery{s.webhookDelivery(id, url, intent.Webhook.Event, intent.Webhook.Payload, nil)}, nil
+
+	default:
+		return nil, kerrors.NewBizStatusError(40001, fmt.Sprintf("unsupported intent %T", req.Intent))
+	}
+}
+
+func intentChannel(req *email.NotifyReq) string {
+	switch req.Intent.(type) {
+	case *email.NotifyReq_Sms:
+		return model.ChannelSMS
+	case *email.NotifyReq_Webhook:
+		return model.ChannelWebhook
+	default:
+		return model.ChannelEmail
+	}
+}
+
+func (s *NotifyService) emailDelivery(id string, req *email.EmailReq) delivery {
+	return delivery{channel: model.ChannelEmail, id: id, send: func() error {
+		return NewSendService(s.ctx).Deliver(req, id)
+	}}
+}
+
+// smsDelivery sends text to a phone number. source is the email the text was
+// rendered from, if any.
+func (s *NotifyService) smsDelivery(id, to, text string, source *email.EmailReq) delivery {
+	return delivery{channel: model.ChannelSMS, id: id, send: func() error {
+		messageId, err := notify.DefaultSMSSender.SendSMS(to, text)
+		recordAttempt(s.ctx, &model.EmailLog{
+			EmailId:   id,
+			Channel:   model.ChannelSMS,
+			Recipient: to,
+			Template:  notify.TemplateName(source),
+			OrderId:   notify.OrderId(source),
+		}, messageId, err)
+		if err != nil {
+			klog.CtxErrorf(s.ctx, "send sms to %s failed: %v", to, err)
+		}
+		return err
+	}}
+}
+
+// webhookDelivery posts an event to url. source is the email the event was
+// built from, if any.
+func (s *NotifyService) webhookDelivery(id, url, event, payload string, source *email.EmailReq) delivery {
+	return delivery{channel: model.ChannelWebhook, id: id, send: func() error {
+		deliveryId, err := notify.DefaultWebhookSender.Post(url, event, []byte(payload))
+		recordAttempt(s.ctx, &model.EmailLog{
+			EmailId:   id,
+			Channel:   model.ChannelWebhook,
+			Recipient: url,
+			Template:  notify.TemplateName(source),
+			Subject:   event,
+			OrderId:   notify.OrderId(source),
+		}, deliveryId, err)
+		if err != nil {
+			klog.CtxErrorf(s.ctx, "post webhook to %s failed: %v", url, err)
+		}
+		return err
+	}}
+}
+
+func (s *NotifyService) alreadySent(id string) bool {
+	if mysql.DB == nil {
+		return false
+	}
+	l, err := model.GetLatestEmailLog(mysql.DB, s.ctx, model.EmailLogFilter{EmailId: id})
+	return err == nil && l.Status == model.EmailStatusSent
+}
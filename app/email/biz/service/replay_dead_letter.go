@@ -0,0 +1,77 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type ReplayDeadLetterService struct {
+	ctx context.Context
+} // NewReplayDeadLetterService new ReplayDeadLetterService
+func NewReplayDeadLetterService(ctx context.Context) *ReplayDeadLetterService {
+	return &ReplayDeadLetterService{ctx: ctx}
+}
+
+// Run puts a dead letter back onto the subject it was consumed from and
+// removes it from the dead letter stream.
+func (s *ReplayDeadLetterService) Run(req *email.ReplayDeadLetterReq) (resp *email.ReplayDeadLetterResp, err error) {
+	if req.Sequence == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "sequence is required")
+	}
+
+	stream, err := mq.Js.Stream(s.ctx, mq.DeadLetterStream)
+	if err != nil {
+		return nil, err
+	}
+	dl, err := stream.GetMsg(s.ctx, req.Sequence)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "dead letter not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	subject := dl.Header.Get(mq.HeaderSubject)
+	if subject == "" {
+		subject = mq.EmailSubject
+	}
+	msg := &nats.Msg{
+		Subject: subject,
+		Data:    dl.Data,
+		Header:  make(nats.Header),
+	}
+	for k, v := range dl.Header {
+		msg.Header[k] = v
+	}
+	msg.Header.Del(mq.HeaderError)
+	msg.Header.Del(mq.HeaderDeliveries)
+	msg.Header.Del(mq.HeaderFailedAt)
+	msg.Header.Del(mq.HeaderSubject)
+	if _, err = mq.Js.PublishMsg(s.ctx, msg); err != nil {
+		return nil, err
+	}
+	if err = stream.DeleteMsg(s.ctx, req.Sequence); err != nil {
+		return nil, err
+	}
+	return &email.ReplayDeadLetterResp{}, nil
+}
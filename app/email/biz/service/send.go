@@ -16,8 +16,17 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/notify"
 	email "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 type SendService struct {
@@ -27,9 +36,77 @@ func NewSendService(ctx context.Context) *SendService {
 	return &SendService{ctx: ctx}
 }
 
-// Run create note info
+// Run renders the email from its template, if any, and delivers it through
+// the configured sender.
 func (s *SendService) Run(req *email.EmailReq) (resp *email.EmailResp, err error) {
-	// Finish your business logic.
+	emailId := NewEmailId()
+	if err = s.Deliver(req, emailId); err != nil {
+		return nil, err
+	}
+	return &email.EmailResp{EmailId: emailId}, nil
+}
+
+// Deliver makes one attempt to send the email identified by emailId and
+// records the outcome in the email log. Emails that can never be sent are
+// recorded as failed too, so that they can be marked dead and looked up.
+func (s *SendService) Deliver(req *email.EmailReq, emailId string) error {
+	log := &model.EmailLog{
+		EmailId:   emailId,
+		Channel:   model.ChannelEmail,
+		Recipient: req.To,
+		Template:  notify.TemplateName(req),
+		Subject:   req.Subject,
+		OrderId:   notify.OrderId(req),
+	}
+	if req.To == "" {
+		err := kerrors.NewBizStatusError(40000, "recipient is required")
+		recordAttempt(s.ctx, log, "", err)
+		return err
+	}
+
+	msg, err := notify.Render(req)
+	if err != nil {
+		err = kerrors.NewBizStatusError(40001, err.Error())
+		recordAttempt(s.ctx, log, "", err)
+		return err
+	}
+	if msg.From == "" {
+		msg.From = conf.GetConf().Email.From
+	}
+
+	messageId, sendErr := notify.DefaultSender.Send(msg)
+	log.Recipient, log.Subject = msg.To, msg.Subject
+	recordAttempt(s.ctx, log, messageId, sendErr)
+	if sendErr != nil {
+		klog.CtxErrorf(s.ctx, "send email to %s failed: %v", msg.To, sendErr)
+		if errors.Is(sendErr, notify.ErrInvalidMessage) {
+			return kerrors.NewBizStatusError(40001, sendErr.Error())
+		}
+		return sendErr
+	}
+	return nil
+}
+
+// recordAttempt logs the outcome of one delivery attempt. Failing to log does
+// not fail the delivery.
+func recordAttempt(ctx context.Context, log *model.EmailLog, messageId string, sendErr error) {
+	if mysql.DB == nil {
+		return
+	}
+	log.Status = model.EmailStatusSent
+	log.ProviderMessageId = messageId
+	if sendErr != nil {
+		log.Status = model.EmailStatusFailed
+		log.Error = sendErr.Error()
+	}
+	if err := model.RecordAttempt(mysql.DB, ctx, log); err != nil {
+		klog.CtxErrorf(ctx, "record delivery %s attempt failed: %v", log.EmailId, err)
+	}
+}
 
-	return
+// NewEmailId returns a random id for emails that were not given one by the publisher.
+func NewEmailId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
 }
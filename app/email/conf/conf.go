@@ -36,6 +36,10 @@ type Config struct {
 	MySQL    MySQL    `yaml:"mysql"`
 	Redis    Redis    `yaml:"redis"`
 	Registry Registry `yaml:"registry"`
+	Email    Email    `yaml:"email"`
+	SMS      SMS      `yaml:"sms"`
+	Webhook  Webhook  `yaml:"webhook"`
+	Queue    Queue    `yaml:"queue"`
 }
 
 type MySQL struct {
@@ -63,6 +67,38 @@ type Kitex struct {
 	LogMaxAge       int    `yaml:"log_max_age"`
 }
 
+type Email struct {
+	// Driver selects the delivery backend: "smtp", "mailbox" or "noop".
+	Driver     string `yaml:"driver"`
+	From       string `yaml:"from"`
+	MailboxDir string `yaml:"mailbox_dir"`
+	SMTP       SMTP   `yaml:"smtp"`
+}
+
+type SMTP struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+}
+
+type SMS struct {
+	// Driver selects the delivery backend: "http", "stub" or "noop".
+	Driver string `yaml:"driver"`
+	// URL is the endpoint of the HTTP SMS provider.
+	URL string `yaml:"url"`
+	// OutboxDir is where the stub driver writes messages.
+	OutboxDir string `yaml:"outbox_dir"`
+}
+
+type Webhook struct {
+	TimeoutSeconds int `yaml:"timeout_seconds"`
+}
+
+type Queue struct {
+	// MaxDeliver is the number of attempts before an email is dead-lettered.
+	MaxDeliver     int   `yaml:"max_deliver"`
+	BackoffSeconds []int `yaml:"backoff_seconds"`
+}
+
 type Registry struct {
 	RegistryAddress []string `yaml:"registry_address"`
 	Username        string   `yaml:"username"`
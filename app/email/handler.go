@@ -30,3 +30,38 @@ func (s *EmailServiceImpl) Send(ctx context.Context, req *email.EmailReq) (resp
 
 	return resp, err
 }
+
+// ListDeadLetters implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) ListDeadLetters(ctx context.Context, req *email.ListDeadLettersReq) (resp *email.ListDeadLettersResp, err error) {
+	resp, err = service.NewListDeadLettersService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ReplayDeadLetter implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) ReplayDeadLetter(ctx context.Context, req *email.ReplayDeadLetterReq) (resp *email.ReplayDeadLetterResp, err error) {
+	resp, err = service.NewReplayDeadLetterService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListEmails implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) ListEmails(ctx context.Context, req *email.ListEmailsReq) (resp *email.ListEmailsResp, err error) {
+	resp, err = service.NewListEmailsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetEmailStatus implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) GetEmailStatus(ctx context.Context, req *email.GetEmailStatusReq) (resp *email.GetEmailStatusResp, err error) {
+	resp, err = service.NewGetEmailStatusService(ctx).Run(req)
+
+	return resp, err
+}
+
+// Notify implements the EmailServiceImpl interface.
+func (s *EmailServiceImpl) Notify(ctx context.Context, req *email.NotifyReq) (resp *email.NotifyResp, err error) {
+	resp, err = service.NewNotifyService(ctx).Run(req)
+
+	return resp, err
+}
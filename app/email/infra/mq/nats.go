@@ -15,11 +15,34 @@
 package mq
 
 import (
+	"context"
+	"time"
+
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	EmailStream  = "EMAIL"
+	EmailSubject = "email"
+	// NotifySubject carries NotifyReq messages on the same stream
+	NotifySubject = "notify"
+
+	DeadLetterStream  = "EMAIL_DLQ"
+	DeadLetterSubject = "email.dlq"
+
+	// HeaderEmailId identifies an email across retries and replays
+	HeaderEmailId = "Gomall-Email-Id"
+	// headers attached to dead letters
+	HeaderError      = "Gomall-Error"
+	HeaderDeliveries = "Gomall-Deliveries"
+	HeaderFailedAt   = "Gomall-Failed-At"
+	HeaderSubject    = "Gomall-Subject"
 )
 
 var (
 	Nc  *nats.Conn
+	Js  jetstream.JetStream
 	err error
 )
 
@@ -28,4 +51,31 @@ func Init() {
 	if err != nil {
 		panic(err)
 	}
+	Js, err = jetstream.New(Nc)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// notifications are removed from the work queue once acked or terminated
+	_, err = Js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      EmailStream,
+		Subjects:  []string{EmailSubject, NotifySubject},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	})
+	if err != nil {
+		panic(err)
+	}
+	// dead letters are kept until they are replayed or expire
+	_, err = Js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     DeadLetterStream,
+		Subjects: []string{DeadLetterSubject},
+		Storage:  jetstream.FileStorage,
+		MaxAge:   14 * 24 * time.Hour,
+	})
+	if err != nil {
+		panic(err)
+	}
 }
@@ -15,17 +15,58 @@
 package notify
 
 import (
+	"os"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
 	"github.com/kr/pretty"
 )
 
+// Sender delivers an already rendered email and returns the message id
+// assigned to it by the provider.
+type Sender interface {
+	Send(req *email.EmailReq) (messageId string, err error)
+}
+
+var DefaultSender Sender = NewNoopEmail()
+
+// Init picks the senders configured for the current environment.
+func Init() {
+	c := conf.GetConf().Email
+	switch c.Driver {
+	case "smtp":
+		DefaultSender = NewSMTPEmail(c.SMTP.Host, c.SMTP.Port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+	case "mailbox":
+		DefaultSender = NewMailboxEmail(c.MailboxDir)
+	default:
+		DefaultSender = NewNoopEmail()
+	}
+
+	sms := conf.GetConf().SMS
+	switch sms.Driver {
+	case "http":
+		DefaultSMSSender = NewHTTPSMS(sms.URL, os.Getenv("SMS_API_KEY"), 10*time.Second)
+	case "stub":
+		DefaultSMSSender = NewStubSMS(sms.OutboxDir)
+	default:
+		DefaultSMSSender = NewNoopSMS()
+	}
+
+	timeout := time.Duration(conf.GetConf().Webhook.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	DefaultWebhookSender = NewHTTPWebhook(os.Getenv("WEBHOOK_SECRET"), timeout)
+}
+
 type NoopEmail struct{}
 
-func (e *NoopEmail) Send(req *email.EmailReq) error {
+func (e *NoopEmail) Send(req *email.EmailReq) (string, error) {
 	pretty.Printf("%v", req)
-	return nil
+	return newMessageId(req.From), nil
 }
 
-func NewNoopEmail() NoopEmail {
-	return NoopEmail{}
+func NewNoopEmail() *NoopEmail {
+	return &NoopEmail{}
 }
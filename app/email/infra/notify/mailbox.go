@@ -0,0 +1,62 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+// MailboxEmail writes every email as an .eml file into a local directory,
+// so that dev environments and tests can inspect outgoing mails.
+type MailboxEmail struct {
+	dir string
+}
+
+func (e *MailboxEmail) Send(req *email.EmailReq) (string, error) {
+	messageId := newMessageId(req.From)
+	msg, err := buildMessage(req, messageId)
+	if err != nil {
+		return "", err
+	}
+	if err = os.MkdirAll(e.dir, 0o755); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), sanitizeFileName(req.To))
+	if err = os.WriteFile(filepath.Join(e.dir, name), msg, 0o644); err != nil {
+		return "", err
+	}
+	return messageId, nil
+}
+
+func NewMailboxEmail(dir string) *MailboxEmail {
+	return &MailboxEmail{dir: dir}
+}
+
+func sanitizeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_', r == '@':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
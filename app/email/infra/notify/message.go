@@ -0,0 +1,98 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+// newMessageId returns an RFC 5322 Message-ID using the sender's domain.
+func newMessageId(from string) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	domain := "localhost"
+	if i := strings.LastIndex(from, "@"); i >= 0 && i < len(from)-1 {
+		domain = strings.Trim(from[i+1:], "<> ")
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain)
+}
+
+// ErrInvalidMessage is returned for emails that can never be sent as they
+// are, e.g. a malformed address. Retrying them does not help.
+var ErrInvalidMessage = errors.New("invalid message")
+
+// parseAddress parses a single RFC 5322 address. It rejects anything else,
+// including values that smuggle extra header lines in with CR or LF.
+func parseAddress(field, value string) (*mail.Address, error) {
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s address %q: %v", ErrInvalidMessage, field, value, err)
+	}
+	return addr, nil
+}
+
+// buildMessage encodes req as a single part MIME message. Header values
+// that come from the request are parsed or checked, never written verbatim.
+func buildMessage(req *email.EmailReq, messageId string) ([]byte, error) {
+	from, err := parseAddress("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseAddress("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(req.Subject, "\r\n") {
+		return nil, fmt.Errorf("%w: subject contains a line break", ErrInvalidMessage)
+	}
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: content type %q: %v", ErrInvalidMessage, contentType, err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", req.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: %s\r\n", messageId)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: %s\r\n", mime.FormatMediaType(mediaType, map[string]string{"charset": "UTF-8"}))
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(req.Content)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+func TestBuildMessage(t *testing.T) {
+	msg, err := buildMessage(&email.EmailReq{
+		From:        "Shop <from@example.com>",
+		To:          "to@example.com",
+		Subject:     "hello",
+		ContentType: "text/html",
+		Content:     "hello world",
+	}, "<id@example.com>")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, header := range []string{"From: \"Shop\" <from@example.com>\r\n", "To: <to@example.com>\r\n", "Content-Type: text/html; charset=UTF-8\r\n"} {
+		if !strings.Contains(string(msg), header) {
+			t.Errorf("missing %q in %s", header, msg)
+		}
+	}
+}
+
+func TestBuildMessage_HeaderInjection(t *testing.T) {
+	for _, req := range []*email.EmailReq{
+		{From: "from@example.com\r\nBcc: evil@example.com", To: "to@example.com"},
+		{From: "from@example.com", To: "to@example.com\nBcc: evil@example.com"},
+		{From: "from@example.com", To: "to@example.com", Subject: "hi\r\nBcc: evil@example.com"},
+		{From: "from@example.com", To: "to@example.com", ContentType: "text/plain\r\nBcc: evil@example.com"},
+	} {
+		if _, err := buildMessage(req, "<id@example.com>"); !errors.Is(err, ErrInvalidMessage) {
+			t.Errorf("buildMessage(%+v) error = %v, want ErrInvalidMessage", req, err)
+		}
+	}
+}
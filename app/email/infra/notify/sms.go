@@ -0,0 +1,115 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kr/pretty"
+)
+
+// SMSSender delivers a text message and returns the id assigned to it by the provider.
+type SMSSender interface {
+	SendSMS(to, text string) (messageId string, err error)
+}
+
+var DefaultSMSSender SMSSender = NewNoopSMS()
+
+// HTTPSMS sends text messages through a JSON HTTP provider API.
+type HTTPSMS struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+type httpSMSRequest struct {
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+type httpSMSResponse struct {
+	Id string `json:"id"`
+}
+
+func (s *HTTPSMS) SendSMS(to, text string) (string, error) {
+	body, err := json.Marshal(httpSMSRequest{To: to, Text: text})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return "", fmt.Errorf("sms provider returned %s", resp.Status)
+	}
+	var r httpSMSResponse
+	if err = json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return "", err
+	}
+	return r.Id, nil
+}
+
+func NewHTTPSMS(url, apiKey string, timeout time.Duration) *HTTPSMS {
+	return &HTTPSMS{url: url, apiKey: apiKey, client: &http.Client{Timeout: timeout}}
+}
+
+// StubSMS stands in for the SMS provider locally by writing every message
+// as a .txt file into a directory.
+type StubSMS struct {
+	dir string
+}
+
+func (s *StubSMS) SendSMS(to, text string) (string, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", err
+	}
+	messageId := fmt.Sprintf("%d-%s", time.Now().UnixNano(), sanitizeFileName(to))
+	content := fmt.Sprintf("To: %s\n\n%s\n", to, text)
+	if err := os.WriteFile(filepath.Join(s.dir, messageId+".txt"), []byte(content), 0o644); err != nil {
+		return "", err
+	}
+	return messageId, nil
+}
+
+func NewStubSMS(dir string) *StubSMS {
+	return &StubSMS{dir: dir}
+}
+
+type NoopSMS struct{}
+
+func (s *NoopSMS) SendSMS(to, text string) (string, error) {
+	pretty.Printf("sms to %s: %s\n", to, text)
+	return fmt.Sprintf("noop-%d", time.Now().UnixNano()), nil
+}
+
+func NewNoopSMS() *NoopSMS {
+	return &NoopSMS{}
+}
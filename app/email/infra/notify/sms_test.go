@@ -0,0 +1,63 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTTPSMS_SendSMS(t *testing.T) {
+	var got httpSMSRequest
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"id":"sms-1"}`))
+	}))
+	defer srv.Close()
+
+	id, err := NewHTTPSMS(srv.URL, "key", time.Second).SendSMS("+8613800000000", "hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "sms-1" || auth != "Bearer key" {
+		t.Errorf("unexpected id %q or auth %q", id, auth)
+	}
+	if got.To != "+8613800000000" || got.Text != "hello" {
+		t.Errorf("unexpected request: %+v", got)
+	}
+}
+
+func TestStubSMS_SendSMS(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewStubSMS(dir).SendSMS("+8613800000000", "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(files))
+	}
+	content, _ := os.ReadFile(files[0])
+	if !strings.Contains(string(content), "hello") {
+		t.Errorf("unexpected message: %s", content)
+	}
+}
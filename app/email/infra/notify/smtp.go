@@ -0,0 +1,54 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"fmt"
+	"net/mail"
+	"net/smtp"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+// SMTPEmail delivers emails through an SMTP relay.
+type SMTPEmail struct {
+	addr string
+	auth smtp.Auth
+}
+
+func (e *SMTPEmail) Send(req *email.EmailReq) (string, error) {
+	messageId := newMessageId(req.From)
+	msg, err := buildMessage(req, messageId)
+	if err != nil {
+		return "", err
+	}
+	// buildMessage has validated both addresses, the envelope takes them
+	// without display names
+	from, _ := mail.ParseAddress(req.From)
+	to, _ := mail.ParseAddress(req.To)
+	if err = smtp.SendMail(e.addr, e.auth, from.Address, []string{to.Address}, msg); err != nil {
+		return "", err
+	}
+	return messageId, nil
+}
+
+// NewSMTPEmail creates an SMTPEmail. PLAIN auth is only used when a username is set.
+func NewSMTPEmail(host string, port int, username, password string) *SMTPEmail {
+	e := &SMTPEmail{addr: fmt.Sprintf("%s:%d", host, port)}
+	if username != "" {
+		e.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return e
+}
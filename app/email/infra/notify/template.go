@@ -0,0 +1,155 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/cloudwego/biz-demo/gomall/common/i18n"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var (
+	// templates and textTemplates hold one template set per supported locale,
+	// with the "t" function bound to that locale. textTemplates renders the
+	// same files without html escaping, for SMS.
+	templates     = map[string]*template.Template{}
+	textTemplates = map[string]*texttemplate.Template{}
+)
+
+func init() {
+	for _, locale := range i18n.Locales() {
+		funcs := map[string]any{
+			"money": func(v float32) string { return fmt.Sprintf("%.2f", v) },
+			"t":     i18n.Translator(locale),
+		}
+		templates[locale] = template.Must(template.New("email").Funcs(funcs).ParseFS(templateFS, "templates/*.tmpl"))
+		textTemplates[locale] = texttemplate.Must(texttemplate.New("sms").Funcs(funcs).ParseFS(templateFS, "templates/*.tmpl"))
+	}
+}
+
+// Locale returns the supported locale req should be rendered in.
+func Locale(req *email.EmailReq) string {
+	if l := i18n.Normalize(req.GetLocale()); l != "" {
+		return l
+	}
+	return i18n.DefaultLocale
+}
+
+// TemplateName returns the template used to render req, or "" for raw emails.
+func TemplateName(req *email.EmailReq) string {
+	switch req.GetTemplate().(type) {
+	case *email.EmailReq_OrderConfirmation:
+		return "order_confirmation"
+	case *email.EmailReq_ShippingNotice:
+		return "shipping_notice"
+	case *email.EmailReq_PasswordReset:
+		return "password_reset"
+	case *email.EmailReq_Welcome:
+		return "welcome"
+	default:
+		return ""
+	}
+}
+
+// OrderId returns the order an email is about, if any.
+func OrderId(req *email.EmailReq) string {
+	switch t := req.GetTemplate().(type) {
+	case *email.EmailReq_OrderConfirmation:
+		return t.OrderConfirmation.GetOrderId()
+	case *email.EmailReq_ShippingNotice:
+		return t.ShippingNotice.GetOrderId()
+	default:
+		return ""
+	}
+}
+
+func templateData(req *email.EmailReq) proto.Message {
+	switch t := req.GetTemplate().(type) {
+	case *email.EmailReq_OrderConfirmation:
+		return t.OrderConfirmation
+	case *email.EmailReq_ShippingNotice:
+		return t.ShippingNotice
+	case *email.EmailReq_PasswordReset:
+		return t.PasswordReset
+	case *email.EmailReq_Welcome:
+		return t.Welcome
+	default:
+		return nil
+	}
+}
+
+// Render returns a copy of req whose subject and content are produced by the
+// template matching its payload, in the locale of req. Raw emails are
+// returned unchanged.
+func Render(req *email.EmailReq) (*email.EmailReq, error) {
+	name := TemplateName(req)
+	if name == "" {
+		return req, nil
+	}
+	data := templateData(req)
+
+	tmpl := templates[Locale(req)]
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, name+".subject", data); err != nil {
+		return nil, err
+	}
+	if err := tmpl.ExecuteTemplate(&body, name+".body", data); err != nil {
+		return nil, err
+	}
+
+	out := proto.Clone(req).(*email.EmailReq)
+	out.Subject = strings.TrimSpace(subject.String())
+	out.Content = body.String()
+	out.ContentType = "text/html"
+	return out, nil
+}
+
+// RenderSMS returns the text message for req, falling back to the subject
+// for raw emails.
+func RenderSMS(req *email.EmailReq) (string, error) {
+	name := TemplateName(req)
+	if name == "" {
+		return req.Subject, nil
+	}
+	var text bytes.Buffer
+	if err := textTemplates[Locale(req)].ExecuteTemplate(&text, name+".sms", templateData(req)); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(text.String()), nil
+}
+
+// EventPayload returns the webhook event name and JSON body for req. Templated
+// emails send their payload, raw emails their subject and content.
+func EventPayload(req *email.EmailReq) (event string, payload []byte, err error) {
+	name := TemplateName(req)
+	if name == "" {
+		payload, err = json.Marshal(map[string]string{"subject": req.Subject, "content": req.Content})
+		return "email", payload, err
+	}
+	payload, err = protojson.Marshal(templateData(req))
+	return name, payload, err
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email"
+)
+
+func TestRender_OrderConfirmation(t *testing.T) {
+	req := &email.EmailReq{
+		To: "to@example.com",
+		Template: &email.EmailReq_OrderConfirmation{OrderConfirmation: &email.OrderConfirmation{
+			OrderId:  "order-1",
+			Currency: "USD",
+			Total:    13.2,
+			Lines: []*email.OrderLine{
+				{ProductName: "<T-Shirt>", Quantity: 2, Cost: 13.2},
+			},
+		}},
+	}
+	msg, err := Render(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Subject != "Your CloudWeGo shop order order-1" {
+		t.Errorf("unexpected subject: %q", msg.Subject)
+	}
+	if msg.ContentType != "text/html" {
+		t.Errorf("unexpected content type: %q", msg.ContentType)
+	}
+	if !strings.Contains(msg.Content, "&lt;T-Shirt&gt;") || !strings.Contains(msg.Content, "USD 13.20") {
+		t.Errorf("unexpected content: %s", msg.Content)
+	}
+	if req.Content != "" {
+		t.Errorf("Render must not modify the request")
+	}
+}
+
+func TestRender_Raw(t *testing.T) {
+	req := &email.EmailReq{To: "to@example.com", Subject: "hi", Content: "hello"}
+	msg, err := Render(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg != req {
+		t.Errorf("raw email should be returned unchanged")
+	}
+}
+
+func TestRenderSMS_NotEscaped(t *testing.T) {
+	text, err := RenderSMS(&email.EmailReq{
+		Template: &email.EmailReq_ShippingNotice{ShippingNotice: &email.ShippingNotice{
+			OrderId:     "order-1",
+			Carrier:     "A&B",
+			TrackingUrl: "https://example.com/track?id=1&c=2",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if text != "CloudWeGo shop: your order order-1 has shipped. Track it at https://example.com/track?id=1&c=2" {
+		t.Errorf("unexpected text: %q", text)
+	}
+}
+
+func TestRender_Locale(t *testing.T) {
+	msg, err := Render(&email.EmailReq{
+		To:       "to@example.com",
+		Locale:   "zh-cn",
+		Template: &email.EmailReq_Welcome{Welcome: &email.Welcome{Name: "小明"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Subject != "欢迎来到 CloudWeGo 商城" {
+		t.Errorf("unexpected subject: %q", msg.Subject)
+	}
+	if !strings.Contains(msg.Content, "小明，您好") {
+		t.Errorf("unexpected content: %s", msg.Content)
+	}
+}
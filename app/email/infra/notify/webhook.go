@@ -0,0 +1,109 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/common/utils"
+)
+
+// headers sent with every webhook
+const (
+	HeaderWebhookEvent     = "X-Gomall-Event"
+	HeaderWebhookDelivery  = "X-Gomall-Delivery"
+	HeaderWebhookSignature = "X-Gomall-Signature"
+)
+
+// WebhookSender posts a JSON event to a user supplied URL and returns the
+// delivery id sent along with it.
+type WebhookSender interface {
+	Post(url, event string, payload []byte) (deliveryId string, err error)
+}
+
+var DefaultWebhookSender WebhookSender = NewHTTPWebhook("", 5*time.Second)
+
+// HTTPWebhook signs every payload with HMAC-SHA256 so that receivers can
+// verify it was sent by the shop.
+type HTTPWebhook struct {
+	secret []byte
+	client *http.Client
+}
+
+func (w *HTTPWebhook) Post(url, event string, payload []byte) (string, error) {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	deliveryId := hex.EncodeToString(b)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookEvent, event)
+	req.Header.Set(HeaderWebhookDelivery, deliveryId)
+	req.Header.Set(HeaderWebhookSignature, "sha256="+Sign(w.secret, payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return "", fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return deliveryId, nil
+}
+
+// NewHTTPWebhook creates an HTTPWebhook that only connects to public
+// addresses. The URL is validated when the user saves it, but its host may
+// resolve elsewhere by the time the webhook is sent.
+func NewHTTPWebhook(secret string, timeout time.Duration) *HTTPWebhook {
+	return newHTTPWebhook(secret, timeout, utils.PublicDialControl)
+}
+
+// newHTTPWebhook creates an HTTPWebhook whose connections are vetted by
+// control. Redirects are not followed, since they could point anywhere.
+func newHTTPWebhook(secret string, timeout time.Duration, control func(network, address string, c syscall.RawConn) error) *HTTPWebhook {
+	dialer := &net.Dialer{Timeout: timeout, Control: control}
+	return &HTTPWebhook{secret: []byte(secret), client: &http.Client{
+		Timeout: timeout,
+		// no proxy, the connection has to go to the address that was vetted
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of payload.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
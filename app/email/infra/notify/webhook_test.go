@@ -0,0 +1,87 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPWebhook_Post(t *testing.T) {
+	var gotSignature, gotEvent, gotDelivery string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(HeaderWebhookSignature)
+		gotEvent = r.Header.Get(HeaderWebhookEvent)
+		gotDelivery = r.Header.Get(HeaderWebhookDelivery)
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	payload := []byte(`{"orderId":"order-1"}`)
+	id, err := newHTTPWebhook("secret", time.Second, nil).Post(srv.URL, "order_confirmation", payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id == "" || id != gotDelivery {
+		t.Errorf("unexpected delivery id %q, header %q", id, gotDelivery)
+	}
+	if gotEvent != "order_confirmation" || string(gotBody) != string(payload) {
+		t.Errorf("unexpected request: %s %s", gotEvent, gotBody)
+	}
+	if gotSignature != "sha256="+Sign([]byte("secret"), payload) {
+		t.Errorf("unexpected signature: %s", gotSignature)
+	}
+}
+
+func TestHTTPWebhook_Post_Non2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer srv.Close()
+
+	if _, err := newHTTPWebhook("", time.Second, nil).Post(srv.URL, "welcome", []byte(`{}`)); err == nil {
+		t.Errorf("expected an error for a non-2xx response")
+	}
+}
+
+func TestHTTPWebhook_Post_Internal(t *testing.T) {
+	var called bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	if _, err := NewHTTPWebhook("", time.Second).Post(srv.URL, "welcome", []byte(`{}`)); err == nil || called {
+		t.Errorf("expected a loopback webhook to be refused, err %v", err)
+	}
+}
+
+func TestHTTPWebhook_Post_Redirect(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer srv.Close()
+
+	if _, err := newHTTPWebhook("", time.Second, nil).Post(srv.URL, "welcome", []byte(`{}`)); err == nil || redirected {
+		t.Errorf("expected the redirect not to be followed, err %v", err)
+	}
+}
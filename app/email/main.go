@@ -22,8 +22,11 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/cloudwego/biz-demo/gomall/app/email/biz/consumer"
+	"github.com/cloudwego/biz-demo/gomall/app/email/biz/dal"
 	"github.com/cloudwego/biz-demo/gomall/app/email/conf"
 	"github.com/cloudwego/biz-demo/gomall/app/email/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/notify"
+	"github.com/cloudwego/biz-demo/gomall/app/email/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
 	"github.com/cloudwego/biz-demo/gomall/common/utils"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/email/emailservice"
@@ -47,6 +50,9 @@ func main() {
 	})
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
+	dal.Init()
+	rpc.InitClient()
+	notify.Init()
 	mq.Init()
 	consumer.Init()
 	svr := emailservice.NewServer(new(EmailServiceImpl), opts...)
@@ -41,5 +41,5 @@ func Category(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	c.HTML(consts.StatusOK, "category", resp)
+	c.HTML(consts.StatusOK, "category", utils.WarpResponse(ctx, c, resp))
 }
@@ -16,14 +16,18 @@ package checkout
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
 	checkout "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
 	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
 	"github.com/cloudwego/hertz/pkg/app"
 	hertzUtils "github.com/cloudwego/hertz/pkg/common/utils"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	http1resp "github.com/cloudwego/hertz/pkg/protocol/http1/resp"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 // Checkout .
@@ -85,3 +89,33 @@ func CheckoutResult(ctx context.Context, c *app.RequestContext) {
 
 	c.HTML(consts.StatusOK, "result", utils.WarpResponse(ctx, c, resp))
 }
+
+// CheckoutStatus .
+// @router /checkout/status [GET]
+func CheckoutStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.CheckoutStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusBadRequest, err)
+		return
+	}
+
+	// stream status changes as server-sent events
+	c.SetContentType("text/event-stream; charset=utf-8")
+	c.Response.Header.Set("Cache-Control", "no-cache")
+	c.Response.HijackWriter(http1resp.NewChunkedBodyWriter(&c.Response, c.GetWriter()))
+	send := func(st *rpccheckout.CheckoutStatus) error {
+		data, _ := json.Marshal(hertzUtils.H{"state": st.State, "step": st.Step, "error": st.Error, "order_id": st.OrderId})
+		c.Write([]byte("data: " + string(data) + "\n\n"))
+		return c.Flush()
+	}
+	err = service.NewCheckoutStatusService(ctx, c).Run(&req, send)
+	if err != nil {
+		message := "checkout failed, please try again later"
+		if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+			message = bizErr.BizMessage()
+		}
+		_ = send(&rpccheckout.CheckoutStatus{State: "failed", Error: message})
+	}
+}
@@ -16,12 +16,14 @@ package product
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
 	product "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/product"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 // GetProduct .
@@ -61,3 +63,60 @@ func SearchProducs(ctx context.Context, c *app.RequestContext) {
 	}
 	c.HTML(consts.StatusOK, "search", utils.WarpResponse(ctx, c, resp))
 }
+
+// CreateReview .
+// @router /product/review [POST]
+func CreateReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CreateReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+		return
+	}
+
+	_, err = service.NewCreateReviewService(ctx, c).Run(&req)
+	if err != nil {
+		// show the product page again with the reason
+		resp, getErr := service.NewGetProductService(ctx, c).Run(&product.ProductReq{Id: req.ProductId})
+		if getErr != nil {
+			utils.SendErrResponse(ctx, c, consts.StatusOK, err)
+			return
+		}
+		resp["error"] = err
+		c.HTML(consts.StatusOK, "product", utils.WarpResponse(ctx, c, resp))
+		return
+	}
+	c.Redirect(consts.StatusFound, []byte(fmt.Sprintf("/product?id=%d#reviews", req.ProductId)))
+}
+
+// GetImage .
+// @router /images/*key [GET]
+func GetImage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ImageReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		utils.SendErrResponse(ctx, c, consts.StatusBadRequest, err)
+		return
+	}
+
+	// image keys are content addressed, so the key is a strong etag
+	etag := `"` + req.Key + `"`
+	if string(c.GetHeader("If-None-Match")) == etag {
+		c.Status(consts.StatusNotModified)
+		return
+	}
+	resp, err := service.NewGetImageService(ctx, c).Run(&req)
+	if err != nil {
+		code := consts.StatusInternalServerError
+		if _, ok := kerrors.FromBizStatusError(err); ok {
+			code = consts.StatusNotFound
+		}
+		utils.SendErrResponse(ctx, c, code, err)
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("ETag", etag)
+	c.Data(consts.StatusOK, resp.ContentType, resp.Data)
+}
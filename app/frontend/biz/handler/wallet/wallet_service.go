@@ -0,0 +1,88 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wallet
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/service"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/wallet"
+	"github.com/cloudwego/hertz/pkg/app"
+	hertzUtils "github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// Wallet .
+// @router /wallet [GET]
+func Wallet(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wallet.WalletReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "wallet", utils.WarpResponse(ctx, c, hertzUtils.H{"warning": err}))
+		return
+	}
+
+	resp, err := service.NewWalletService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "wallet", utils.WarpResponse(ctx, c, hertzUtils.H{"error": err}))
+		return
+	}
+
+	c.HTML(consts.StatusOK, "wallet", utils.WarpResponse(ctx, c, resp))
+}
+
+// RedeemGiftCard .
+// @router /wallet/redeem [POST]
+func RedeemGiftCard(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wallet.RedeemGiftCardReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "wallet", utils.WarpResponse(ctx, c, hertzUtils.H{"warning": err}))
+		return
+	}
+
+	_, err = service.NewRedeemGiftCardService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "wallet", utils.WarpResponse(ctx, c, hertzUtils.H{"error": err}))
+		return
+	}
+
+	c.Redirect(consts.StatusFound, []byte("/wallet"))
+}
+
+// IssueGiftCard .
+// @router /wallet/gift-card [POST]
+func IssueGiftCard(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req wallet.IssueGiftCardReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "wallet", utils.WarpResponse(ctx, c, hertzUtils.H{"warning": err}))
+		return
+	}
+
+	resp, err := service.NewIssueGiftCardService(ctx, c).Run(&req)
+	if err != nil {
+		c.HTML(consts.StatusOK, "wallet", utils.WarpResponse(ctx, c, hertzUtils.H{"error": err}))
+		return
+	}
+
+	// 跳转到钱包页并展示新礼品卡的卡号和余额
+	c.Redirect(consts.StatusFound, []byte("/wallet?code="+url.QueryEscape(resp.Code)))
+}
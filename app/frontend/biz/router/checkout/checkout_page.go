@@ -34,5 +34,6 @@ func Register(r *server.Hertz) {
 	root.GET("/checkout", append(_checkout0Mw(), checkout.Checkout)...)
 	_checkout := root.Group("/checkout", _checkoutMw()...)
 	_checkout.GET("/result", append(_checkoutresultMw(), checkout.CheckoutResult)...)
+	_checkout.GET("/status", append(_checkoutstatusMw(), checkout.CheckoutStatus)...)
 	_checkout.POST("/waiting", append(_checkoutwaitingMw(), checkout.CheckoutWaiting)...)
 }
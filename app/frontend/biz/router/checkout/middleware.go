@@ -41,6 +41,11 @@ func _checkoutresultMw() []app.HandlerFunc {
 	return nil
 }
 
+func _checkoutstatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
 func _checkoutwaitingMw() []app.HandlerFunc {
 	// your code...
 	return nil
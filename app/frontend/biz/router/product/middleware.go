@@ -17,6 +17,7 @@
 package product
 
 import (
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/middleware"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -34,3 +35,23 @@ func _searchproducsMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _productMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createreviewMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{middleware.Auth()}
+}
+
+func _imagesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getimageMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -31,6 +31,12 @@ import (
 func Register(r *server.Hertz) {
 
 	root := r.Group("/", rootMw()...)
+	{
+		_images := root.Group("/images", _imagesMw()...)
+		_images.GET("/*key", append(_getimageMw(), product.GetImage)...)
+	}
 	root.GET("/product", append(_getproductMw(), product.GetProduct)...)
+	_product := root.Group("/product", _productMw()...)
+	_product.POST("/review", append(_createreviewMw(), product.CreateReview)...)
 	root.GET("/search", append(_searchproducsMw(), product.SearchProducs)...)
 }
@@ -25,12 +25,15 @@ import (
 	home "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/home"
 	order "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/order"
 	product "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/product"
+	wallet "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router/wallet"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	wallet.Register(r)
+
 	about.Register(r)
 
 	order.Register(r)
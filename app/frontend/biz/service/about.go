@@ -20,6 +20,7 @@ import (
 	"github.com/cloudwego/hertz/pkg/common/utils"
 
 	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -34,6 +35,6 @@ func NewAboutService(Context context.Context, RequestContext *app.RequestContext
 
 func (h *AboutService) Run(req *common.Empty) (resp map[string]any, err error) {
 	return utils.H{
-		"title": "About",
+		"title": frontendutils.T(h.Context, "title.about"),
 	}, nil
 }
@@ -39,6 +39,7 @@ func (h *AddCartItemService) Run(req *cart.AddCartReq) (resp *common.Empty, err
 		UserId: frontendutils.GetUserIdFromCtx(h.Context),
 		Item: &rpccart.CartItem{
 			ProductId: req.ProductId,
+			SkuId:     req.SkuId,
 			Quantity:  req.ProductNum,
 		},
 	})
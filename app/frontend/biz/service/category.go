@@ -16,9 +16,12 @@ package service
 
 import (
 	"context"
+	"net/url"
+	"strconv"
 
 	category "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/category"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
@@ -33,10 +36,68 @@ func NewCategoryService(Context context.Context, RequestContext *app.RequestCont
 	return &CategoryService{RequestContext: RequestContext, Context: Context}
 }
 
+const categoryPageSize = 12
+
 func (h *CategoryService) Run(req *category.CategoryReq) (resp map[string]any, err error) {
-	p, _ := rpc.ProductClient.ListProducts(h.Context, &product.ListProductsReq{CategoryName: req.Category})
-	return utils.H{
-		"title": "Category",
-		"items": p.Products,
-	}, nil
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	p, err := rpc.ProductClient.ListProducts(h.Context, &product.ListProductsReq{
+		CategoryName: req.Category,
+		Page:         page,
+		PageSize:     categoryPageSize,
+		Sort:         req.Sort,
+		MinPrice:     req.MinPrice,
+		MaxPrice:     req.MaxPrice,
+		Currency:     frontendutils.GetCurrencyFromCtx(h.Context),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pages := int32((p.Total + categoryPageSize - 1) / categoryPageSize)
+	pageURL := func(n int32) string {
+		q := url.Values{}
+		q.Set("page", strconv.Itoa(int(n)))
+		if req.Sort != "" {
+			q.Set("sort", req.Sort)
+		}
+		if req.MinPrice > 0 {
+			q.Set("min_price", strconv.FormatFloat(float64(req.MinPrice), 'f', -1, 32))
+		}
+		if req.MaxPrice > 0 {
+			q.Set("max_price", strconv.FormatFloat(float64(req.MaxPrice), 'f', -1, 32))
+		}
+		return "/category/" + url.PathEscape(req.Category) + "?" + q.Encode()
+	}
+	title := frontendutils.T(h.Context, "title.category")
+	var breadcrumbs []map[string]any
+	if tree, err := rpc.ProductClient.ListCategoryTree(h.Context, &product.ListCategoryTreeReq{}); err == nil {
+		breadcrumbs = frontendutils.Breadcrumbs(h.Context, tree.Roots, req.Category)
+	}
+	if n := len(breadcrumbs); n > 0 {
+		title = breadcrumbs[n-1]["Name"].(string)
+		// the current category is not a link
+		delete(breadcrumbs[n-1], "URL")
+	}
+	resp = utils.H{
+		"title":       title,
+		"breadcrumbs": breadcrumbs,
+		"items":       p.Products,
+		"category":    req.Category,
+		"sort":        req.Sort,
+		"min_price":   req.MinPrice,
+		"max_price":   req.MaxPrice,
+		"total":       p.Total,
+		"page":        page,
+		"pages":       pages,
+	}
+	if page > 1 {
+		resp["prev_url"] = pageURL(page - 1)
+	}
+	if page < pages {
+		resp["next_url"] = pageURL(page + 1)
+	}
+	return resp, nil
 }
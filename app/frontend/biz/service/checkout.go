@@ -16,15 +16,22 @@ package service
 
 import (
 	"context"
+	"slices"
 	"strconv"
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
 	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
-	rpccart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
-	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+// 结账页面表单预填的收货地区，报价按表单中的地区计算税费
+const (
+	defaultCountry  = "china"
+	defaultProvince = "zhejiang"
 )
 
 type CheckoutService struct {
@@ -37,39 +44,80 @@ func NewCheckoutService(Context context.Context, RequestContext *app.RequestCont
 }
 
 func (h *CheckoutService) Run(req *checkout.CheckoutReq) (resp map[string]any, err error) {
-	var items []map[string]string
 	// 1. 从上下文中获取当前用户ID（这里的上下文通常包含了从认证中提取的用户信息）
 	userId := frontendutils.GetUserIdFromCtx(h.Context)
 
-	// 2. 调用购物车服务，获取用户的购物车数据
-	carts, err := rpc.CartClient.GetCart(h.Context, &rpccart.GetCartReq{UserId: userId})
+	// 2. 查询可配送购物车的配送方式
+	if req.Country == "" {
+		req.Country, req.Province = defaultCountry, defaultProvince
+	}
+	address := &rpccheckout.Address{Country: req.Country, State: req.Province}
+	code := frontendutils.GetCurrencyFromCtx(h.Context)
+	optionsResp, err := rpc.CheckoutClient.ListShippingOptions(h.Context, &rpccheckout.ListShippingOptionsReq{UserId: userId, CouponCode: req.Coupon, Address: address, Currency: code})
+	var couponError string
+	if bizErr, ok := kerrors.FromBizStatusError(err); ok && req.Coupon != "" {
+		// 优惠券不可用时提示原因，并按不使用优惠券报价
+		couponError = bizErr.BizMessage()
+		req.Coupon = ""
+		optionsResp, err = rpc.CheckoutClient.ListShippingOptions(h.Context, &rpccheckout.ListShippingOptionsReq{UserId: userId, Address: address, Currency: code})
+	}
 	if err != nil {
 		return nil, err
 	}
-	var total float32
-	// 3. 遍历购物车中的商品，获取商品信息
-	for _, v := range carts.Cart.Items {
-		productResp, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: v.ProductId})
-		if err != nil {
-			return nil, err
-		}
-		if productResp.Product == nil {
-			continue
-		}
-		p := productResp.Product
+	// 所选配送方式不能配送到该地区时改用默认的配送方式
+	if !slices.ContainsFunc(optionsResp.Options, func(o *rpccheckout.ShippingOption) bool { return o.Method == req.ShippingMethod }) {
+		req.ShippingMethod = ""
+	}
+
+	// 3. 由结账服务报价，金额与实际结账使用同一条定价流水线
+	quoteResp, err := rpc.CheckoutClient.Quote(h.Context, &rpccheckout.QuoteReq{
+		UserId:         userId,
+		CouponCode:     req.Coupon,
+		Address:        address,
+		ShippingMethod: req.ShippingMethod,
+		Currency:       code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	quote := quoteResp.Quote
+	formatAmount := func(v float32) string {
+		return frontendutils.Money(quote.Currency, v)
+	}
+
+	// 4. 转换报价中的订单行用于展示
+	var items []map[string]string
+	for _, l := range quote.Lines {
 		items = append(items, map[string]string{
-			"Name":    p.Name,
-			"Price":   strconv.FormatFloat(float64(p.Price), 'f', 2, 64),
-			"Picture": p.Picture,
-			"Qty":     strconv.Itoa(int(v.Quantity)),
+			"Name":    l.Name,
+			"Price":   formatAmount(l.UnitPrice),
+			"Picture": l.Picture,
+			"Qty":     strconv.Itoa(int(l.Quantity)),
 		})
-		total += float32(v.Quantity) * p.Price
 	}
 
-	return utils.H{
-		"title":    "Checkout",
-		"items":    items,
-		"cart_num": len(items),
-		"total":    strconv.FormatFloat(float64(total), 'f', 2, 64),
-	}, nil
+	resp = utils.H{
+		"title":            frontendutils.T(h.Context, "title.checkout"),
+		"items":            items,
+		"cart_num":         len(items),
+		"quote_id":         quote.QuoteId,
+		"currency":         quote.Currency,
+		"promotions":       quote.Promotions,
+		"coupon":           req.Coupon,
+		"coupon_error":     couponError,
+		"country":          req.Country,
+		"province":         req.Province,
+		"taxes":            quote.Taxes,
+		"tax_included":     quote.TaxInclusive,
+		"shipping_options": optionsResp.Options,
+		"subtotal":         formatAmount(quote.Subtotal),
+		"shipping":         formatAmount(quote.Shipping),
+		"tax":              formatAmount(quote.Tax),
+		"total":            formatAmount(quote.Total),
+	}
+	if o := quote.ShippingOption; o != nil {
+		resp["shipping_method"] = o.Method
+		resp["shipping_name"] = o.Name
+	}
+	return resp, nil
 }
@@ -0,0 +1,72 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/checkout"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpccheckout "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const (
+	checkoutStatusInterval = 500 * time.Millisecond
+	checkoutStatusTimeout  = 2 * time.Minute
+)
+
+type CheckoutStatusService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCheckoutStatusService(Context context.Context, RequestContext *app.RequestContext) *CheckoutStatusService {
+	return &CheckoutStatusService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run polls the checkout and calls send whenever its status changes, until the
+// checkout finishes, send fails (the browser went away) or the timeout elapses.
+func (h *CheckoutStatusService) Run(req *checkout.CheckoutStatusReq, send func(st *rpccheckout.CheckoutStatus) error) (err error) {
+	ctx, cancel := context.WithTimeout(h.Context, checkoutStatusTimeout)
+	defer cancel()
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	ticker := time.NewTicker(checkoutStatusInterval)
+	defer ticker.Stop()
+
+	var last int64
+	for {
+		resp, err := rpc.CheckoutClient.GetCheckoutStatus(ctx, &rpccheckout.GetCheckoutStatusReq{UserId: userId, CheckoutId: req.Id})
+		if err != nil {
+			return err
+		}
+		if st := resp.Status; st.UpdatedAt != last {
+			last = st.UpdatedAt
+			if err = send(st); err != nil {
+				return nil
+			}
+			if st.State != "processing" {
+				return nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -37,11 +37,16 @@ func NewCheckoutWaitingService(Context context.Context, RequestContext *app.Requ
 
 func (h *CheckoutWaitingService) Run(req *checkout.CheckoutReq) (resp map[string]any, err error) {
 	userId := frontendutils.GetUserIdFromCtx(h.Context)
-	_, err = rpc.CheckoutClient.Checkout(h.Context, &rpccheckout.CheckoutReq{
-		UserId:    userId,
-		Email:     req.Email,
-		Firstname: req.Firstname,
-		Lastname:  req.Lastname,
+	checkoutResp, err := rpc.CheckoutClient.Checkout(h.Context, &rpccheckout.CheckoutReq{
+		UserId:         userId,
+		Email:          req.Email,
+		Firstname:      req.Firstname,
+		Lastname:       req.Lastname,
+		Locale:         frontendutils.GetLocaleFromCtx(h.Context),
+		CouponCode:     req.Coupon,
+		QuoteId:        req.QuoteId,
+		ShippingMethod: req.ShippingMethod,
+		Currency:       frontendutils.GetCurrencyFromCtx(h.Context),
 		Address: &rpccheckout.Address{
 			Country:       req.Country,
 			ZipCode:       req.Zipcode,
@@ -49,19 +54,36 @@ func (h *CheckoutWaitingService) Run(req *checkout.CheckoutReq) (resp map[string
 			State:         req.Province,
 			StreetAddress: req.Street,
 		},
-		CreditCard: &rpcpayment.CreditCardInfo{
-			CreditCardNumber:          req.CardNum,
-			CreditCardExpirationYear:  req.ExpirationYear,
-			CreditCardExpirationMonth: req.ExpirationMonth,
-			CreditCardCvv:             req.Cvv,
+		// 账单地址与收货地址不同时才填写，用于风控评估
+		BillingAddress: &rpccheckout.Address{
+			Country: req.BillingCountry,
+			ZipCode: req.BillingZipcode,
 		},
+		ClientIp:     h.RequestContext.ClientIP(),
+		GiftCardCode: req.GiftCard,
+		UseWallet:    req.UseWallet,
+		CreditCard:   creditCard(req),
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// 结账在后台进行，页面通过 /checkout/status 订阅进度
 	return utils.H{
-		"title":    "waiting",
-		"redirect": "/checkout/result",
+		"title":       frontendutils.T(h.Context, "title.waiting"),
+		"checkout_id": checkoutResp.CheckoutId,
 	}, nil
 }
+
+// creditCard 在未填写卡号时返回 nil，订单全部由礼品卡或钱包支付
+func creditCard(req *checkout.CheckoutReq) *rpcpayment.CreditCardInfo {
+	if req.CardNum == "" {
+		return nil
+	}
+	return &rpcpayment.CreditCardInfo{
+		CreditCardNumber:          req.CardNum,
+		CreditCardExpirationYear:  req.ExpirationYear,
+		CreditCardExpirationMonth: req.ExpirationMonth,
+		CreditCardCvv:             req.Cvv,
+	}
+}
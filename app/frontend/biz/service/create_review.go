@@ -0,0 +1,46 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	product "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/product"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CreateReviewService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateReviewService(Context context.Context, RequestContext *app.RequestContext) *CreateReviewService {
+	return &CreateReviewService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *CreateReviewService) Run(req *product.CreateReviewReq) (resp *common.Empty, err error) {
+	_, err = rpc.ProductClient.CreateReview(h.Context, &rpcproduct.CreateReviewReq{
+		ProductId: req.ProductId,
+		UserId:    frontendutils.GetUserIdFromCtx(h.Context),
+		Rating:    req.Rating,
+		Title:     req.Title,
+		Body:      req.Body,
+	})
+	return
+}
@@ -22,7 +22,6 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
 	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	rpccart "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
-	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
 )
@@ -44,23 +43,38 @@ func (h *GetCartService) Run(req *common.Empty) (resp map[string]any, err error)
 	if err != nil {
 		return nil, err
 	}
+	ids := make([]uint32, 0, len(carts.Cart.Items))
+	for _, v := range carts.Cart.Items {
+		ids = append(ids, v.GetProductId())
+	}
+	products, err := batchGetProducts(h.Context, ids)
+	if err != nil {
+		return nil, err
+	}
 	var total float32
+	code := frontendutils.GetCurrencyFromCtx(h.Context)
 	for _, v := range carts.Cart.Items {
-		productResp, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: v.GetProductId()})
-		if err != nil {
+		p, ok := products[v.GetProductId()]
+		if !ok {
 			continue
 		}
-		if productResp.Product == nil {
-			continue
+		// 价格按展示货币换算，换算失败时商品服务以基础货币返回
+		code = p.Currency
+		price, picture := p.DisplayPrice, p.Picture
+		sku := frontendutils.FindSku(p, v.GetSkuId())
+		if sku != nil {
+			price = sku.DisplayPrice
+			if sku.Picture != "" {
+				picture = sku.Picture
+			}
 		}
-		p := productResp.Product
-		items = append(items, map[string]string{"Name": p.Name, "Description": p.Description, "Picture": p.Picture, "Price": strconv.FormatFloat(float64(p.Price), 'f', 2, 64), "Qty": strconv.Itoa(int(v.Quantity))})
-		total += float32(v.Quantity) * p.Price
+		items = append(items, map[string]string{"Name": p.Name, "Variant": frontendutils.SkuLabel(sku), "Description": p.Description, "Picture": picture, "Price": frontendutils.Money(code, price), "Qty": strconv.Itoa(int(v.Quantity))})
+		total += float32(v.Quantity) * price
 	}
 
 	return utils.H{
-		"title": "Cart",
+		"title": frontendutils.T(h.Context, "title.cart"),
 		"items": items,
-		"total": strconv.FormatFloat(float64(total), 'f', 2, 64),
+		"total": frontendutils.Money(code, total),
 	}, nil
 }
@@ -0,0 +1,38 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+
+	product "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/product"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type GetImageService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetImageService(Context context.Context, RequestContext *app.RequestContext) *GetImageService {
+	return &GetImageService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *GetImageService) Run(req *product.ImageReq) (resp *rpcproduct.GetImageResp, err error) {
+	return rpc.ProductClient.GetImage(h.Context, &rpcproduct.GetImageReq{Key: strings.TrimPrefix(req.Key, "/")})
+}
@@ -16,14 +16,20 @@ package service
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	product "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/product"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
 )
 
+// reviewPageSize is the number of reviews shown on a product page.
+const reviewPageSize = 10
+
 type GetProductService struct {
 	RequestContext *app.RequestContext
 	Context        context.Context
@@ -34,11 +40,71 @@ func NewGetProductService(Context context.Context, RequestContext *app.RequestCo
 }
 
 func (h *GetProductService) Run(req *product.ProductReq) (resp map[string]any, err error) {
-	p, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: req.GetId()})
+	p, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: req.GetId(), Currency: frontendutils.GetCurrencyFromCtx(h.Context)})
 	if err != nil {
 		return nil, err
 	}
-	return utils.H{
-		"item": p.Product,
-	}, nil
+	item := p.Product
+	// pre-select the first sku that is in stock
+	var selected *rpcproduct.Sku
+	var skus []map[string]any
+	for _, sku := range item.Skus {
+		if selected == nil && sku.Stock > 0 {
+			selected = sku
+		}
+		options := make(map[string]string)
+		for _, o := range sku.Options {
+			options[o.Name] = o.Value
+		}
+		picture := sku.Picture
+		if picture == "" {
+			picture = item.Picture
+		}
+		skus = append(skus, map[string]any{"id": sku.Id, "price": frontendutils.Money(item.Currency, sku.DisplayPrice), "picture": picture, "stock": sku.Stock, "options": options})
+	}
+	if selected == nil && len(item.Skus) > 0 {
+		selected = item.Skus[0]
+	}
+	var breadcrumbs []map[string]any
+	if len(item.Categories) > 0 {
+		if tree, err := rpc.ProductClient.ListCategoryTree(h.Context, &rpcproduct.ListCategoryTreeReq{}); err == nil {
+			breadcrumbs = frontendutils.Breadcrumbs(h.Context, tree.Roots, item.Categories[0])
+		}
+	}
+	if breadcrumbs != nil {
+		breadcrumbs = append(breadcrumbs, map[string]any{"Name": item.Name})
+	}
+	var reviews []map[string]any
+	if item.ReviewCount > 0 {
+		if list, err := rpc.ProductClient.ListReviews(h.Context, &rpcproduct.ListReviewsReq{ProductId: item.Id, PageSize: reviewPageSize}); err == nil {
+			for _, r := range list.Reviews {
+				reviews = append(reviews, map[string]any{
+					"Rating":   r.Rating,
+					"Stars":    frontendutils.Stars(float32(r.Rating)),
+					"Title":    r.Title,
+					"Body":     r.Body,
+					"Verified": r.VerifiedPurchase,
+					"Date":     time.Unix(r.CreatedAt, 0).Format("2006-01-02"),
+				})
+			}
+		}
+	}
+	resp = utils.H{
+		"item":        item,
+		"breadcrumbs": breadcrumbs,
+		"reviews":     reviews,
+		"skus":        skus,
+		"price":       frontendutils.Money(item.Currency, item.DisplayPrice),
+		"picture":     item.Picture,
+		"selected":    map[string]string{},
+	}
+	if selected != nil {
+		resp["sku"] = selected
+		resp["price"] = frontendutils.Money(item.Currency, selected.DisplayPrice)
+		resp["selected"] = skus[slices.Index(item.Skus, selected)]["options"]
+		if selected.Picture != "" {
+			resp["picture"] = selected.Picture
+		}
+	}
+	return resp, nil
 }
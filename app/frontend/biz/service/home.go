@@ -19,6 +19,7 @@ import (
 
 	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
@@ -36,13 +37,13 @@ func NewHomeService(Context context.Context, RequestContext *app.RequestContext)
 
 func (h *HomeService) Run(req *common.Empty) (res map[string]any, err error) {
 	ctx := h.Context
-	p, err := rpc.ProductClient.ListProducts(ctx, &product.ListProductsReq{})
+	p, err := rpc.ProductClient.ListProducts(ctx, &product.ListProductsReq{Currency: frontendutils.GetCurrencyFromCtx(ctx)})
 	if err != nil {
 		klog.Error(err)
 	}
 	var cartNum int
 	return utils.H{
-		"title":    "Hot sale",
+		"title":    frontendutils.T(h.Context, "title.hot_sale"),
 		"cart_num": cartNum,
 		"items":    p.Products,
 	}, nil
@@ -0,0 +1,60 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/wallet"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcpayment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// errLoginRequired 拒绝未登录用户购买礼品卡
+var errLoginRequired = errors.New("please log in to buy a gift card")
+
+type IssueGiftCardService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewIssueGiftCardService(Context context.Context, RequestContext *app.RequestContext) *IssueGiftCardService {
+	return &IssueGiftCardService{RequestContext: RequestContext, Context: Context}
+}
+
+// Run 用钱包余额购买礼品卡，币种默认为当前选择的币种
+func (h *IssueGiftCardService) Run(req *wallet.IssueGiftCardReq) (resp *rpcpayment.GiftCard, err error) {
+	// 礼品卡只能用自己的钱包购买，商店发行的礼品卡不经过前端
+	userId := frontendutils.GetUserIdFromCtx(h.Context)
+	if userId == 0 {
+		return nil, errLoginRequired
+	}
+	code := req.Currency
+	if code == "" {
+		code = frontendutils.GetCurrencyFromCtx(h.Context)
+	}
+	issueResp, err := rpc.PaymentClient.IssueGiftCard(h.Context, &rpcpayment.IssueGiftCardReq{
+		UserId:   userId,
+		Amount:   req.Amount,
+		Currency: code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issueResp.GiftCard, nil
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/types"
 	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	rpcorder "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
-	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
 )
@@ -46,38 +45,43 @@ func (h *OrderListService) Run(req *common.Empty) (resp map[string]any, err erro
 	}
 	if listOrderResp == nil || len(listOrderResp.Orders) == 0 {
 		return utils.H{
-			"title":  "Order",
+			"title":  frontendutils.T(h.Context, "title.order"),
 			"orders": orders,
 		}, nil
 	}
 
+	// 订单项只使用下单时的商品快照展示，商品之后改名、调价或删除都不影响历史订单
 	for _, v := range listOrderResp.Orders {
 		var items []types.OrderItem
 		var total float32
-		if len(v.OrderItems) > 0 {
-			for _, vv := range v.OrderItems {
-				total += vv.Cost
-				i := vv.Item
-				productResp, err := rpc.ProductClient.GetProduct(h.Context, &rpcproduct.GetProductReq{Id: i.ProductId})
-				if err != nil {
-					return nil, err
-				}
-				if productResp.Product == nil {
-					continue
-				}
-				p := productResp.Product
-				items = append(items, types.OrderItem{
-					ProductId:   i.ProductId,
-					Qty:         uint32(i.Quantity),
-					ProductName: p.Name,
-					Picture:     p.Picture,
-					Cost:        vv.Cost,
-				})
+		for _, vv := range v.OrderItems {
+			total += vv.Cost
+			i := vv.Item
+			name := vv.ProductName
+			if name == "" {
+				// 商品已删除、无法回填快照的旧订单项没有商品名称
+				name = frontendutils.T(h.Context, "order.unknown_product", i.ProductId)
 			}
+			items = append(items, types.OrderItem{
+				ProductId:   i.ProductId,
+				Qty:         uint32(i.Quantity),
+				ProductName: name,
+				Variant:     vv.Variant,
+				Picture:     vv.Picture,
+				UnitPrice:   vv.UnitPrice,
+				Cost:        vv.Cost,
+			})
+		}
+		// 运费以及不含税价格订单的税费在订单项金额之外
+		total += v.ShippingCost
+		if !v.TaxInclusive {
+			total += v.Tax
 		}
 		timeObj := time.Unix(int64(v.CreatedAt), 0)
 		orders = append(orders, &types.Order{
 			Cost:        total,
+			Currency:    v.UserCurrency,
+			OrderState:  v.OrderState,
 			Items:       items,
 			CreatedDate: timeObj.Format("2006-01-02 15:04:05"),
 			OrderId:     v.OrderId,
@@ -86,7 +90,7 @@ func (h *OrderListService) Run(req *common.Empty) (resp map[string]any, err erro
 	}
 
 	return utils.H{
-		"title":  "Order",
+		"title":  frontendutils.T(h.Context, "title.order"),
 		"orders": orders,
 	}, nil
 }
@@ -0,0 +1,44 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+// batchGetProducts loads the products with the given ids in one call and
+// indexes them by id; products that no longer exist are left out.
+func batchGetProducts(ctx context.Context, ids []uint32) (map[uint32]*rpcproduct.Product, error) {
+	products := make(map[uint32]*rpcproduct.Product, len(ids))
+	if len(ids) == 0 {
+		return products, nil
+	}
+	resp, err := rpc.ProductClient.BatchGetProducts(ctx, &rpcproduct.BatchGetProductsReq{Ids: ids, Currency: frontendutils.GetCurrencyFromCtx(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.MissingIds) > 0 {
+		hlog.CtxWarnf(ctx, "products %v not found", resp.MissingIds)
+	}
+	for _, p := range resp.Products {
+		products[p.Id] = p
+	}
+	return products, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	common "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/common"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/wallet"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcpayment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type RedeemGiftCardService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewRedeemGiftCardService(Context context.Context, RequestContext *app.RequestContext) *RedeemGiftCardService {
+	return &RedeemGiftCardService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *RedeemGiftCardService) Run(req *wallet.RedeemGiftCardReq) (resp *common.Empty, err error) {
+	_, err = rpc.PaymentClient.RedeemGiftCard(h.Context, &rpcpayment.RedeemGiftCardReq{
+		UserId: frontendutils.GetUserIdFromCtx(h.Context),
+		Code:   req.Code,
+	})
+	return
+}
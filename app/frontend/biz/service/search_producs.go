@@ -19,6 +19,7 @@ import (
 
 	product "github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/product"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
 	rpcproduct "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/utils"
@@ -34,7 +35,7 @@ func NewSearchProducsService(Context context.Context, RequestContext *app.Reques
 }
 
 func (h *SearchProducsService) Run(req *product.SearchProductsReq) (resp map[string]any, err error) {
-	p, err := rpc.ProductClient.SearchProducts(h.Context, &rpcproduct.SearchProductsReq{Query: req.Q})
+	p, err := rpc.ProductClient.SearchProducts(h.Context, &rpcproduct.SearchProductsReq{Query: req.Q, Currency: frontendutils.GetCurrencyFromCtx(h.Context)})
 	if err != nil {
 		return nil, err
 	}
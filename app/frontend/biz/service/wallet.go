@@ -0,0 +1,61 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/hertz_gen/frontend/wallet"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	rpcpayment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type WalletService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewWalletService(Context context.Context, RequestContext *app.RequestContext) *WalletService {
+	return &WalletService{RequestContext: RequestContext, Context: Context}
+}
+
+func (h *WalletService) Run(req *wallet.WalletReq) (resp map[string]any, err error) {
+	walletsResp, err := rpc.PaymentClient.GetWallets(h.Context, &rpcpayment.GetWalletsReq{UserId: frontendutils.GetUserIdFromCtx(h.Context)})
+	if err != nil {
+		return nil, err
+	}
+	resp = utils.H{
+		"title":   frontendutils.T(h.Context, "title.wallet"),
+		"wallets": walletsResp.Wallets,
+	}
+	if req.Code == "" {
+		return resp, nil
+	}
+	// 查询礼品卡余额，卡号无效时提示原因
+	cardResp, err := rpc.PaymentClient.GetGiftCard(h.Context, &rpcpayment.GetGiftCardReq{Code: req.Code})
+	if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+		resp["warning"] = bizErr.BizMessage()
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp["gift_card"] = cardResp.GiftCard
+	return resp, nil
+}
@@ -19,7 +19,9 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
 	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 
 	"github.com/cloudwego/hertz/pkg/app"
 )
@@ -45,5 +47,14 @@ func WarpResponse(ctx context.Context, c *app.RequestContext, content map[string
 	}
 	content["user_id"] = ctx.Value(frontendutils.UserIdKey)
 	content["cart_num"] = cartNum
+	content["lang"] = frontendutils.GetLocaleFromCtx(ctx)
+	if _, ok := content["currency"]; !ok {
+		content["currency"] = frontendutils.GetCurrencyFromCtx(ctx)
+	}
+	content["currencies"] = currency.Codes()
+	categoryResp, _ := rpc.ProductClient.ListCategoryTree(ctx, &product.ListCategoryTreeReq{})
+	if categoryResp != nil {
+		content["categories"] = frontendutils.CategoryMenu(ctx, categoryResp.Roots)
+	}
 	return content
 }
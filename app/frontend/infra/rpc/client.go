@@ -26,6 +26,7 @@ import (
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/cart/cartservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/checkout/checkoutservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order/orderservice"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment/paymentservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product/productcatalogservice"
 	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user/userservice"
@@ -43,6 +44,7 @@ var (
 	CheckoutClient checkoutservice.Client
 	OrderClient    orderservice.Client
 	AuthClient     authservice.Client
+	PaymentClient  paymentservice.Client
 	once           sync.Once
 	err            error
 	registryAddr   string
@@ -61,6 +63,7 @@ func InitClient() {
 		initCartClient()
 		initCheckoutClient()
 		initOrderClient()
+		initPaymentClient()
 	})
 }
 
@@ -122,3 +125,8 @@ func initAuthClient() {
 	AuthClient, err = authservice.NewClient("auth", commonSuite)
 	frontendutils.MustHandleError(err)
 }
+
+func initPaymentClient() {
+	PaymentClient, err = paymentservice.NewClient("payment", commonSuite)
+	frontendutils.MustHandleError(err)
+}
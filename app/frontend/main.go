@@ -18,13 +18,18 @@ package main
 
 import (
 	"context"
+	"html/template"
+	"net/http"
 	"os"
 
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/biz/router"
+	bizutils "github.com/cloudwego/biz-demo/gomall/app/frontend/biz/utils"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/conf"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/mtl"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
 	"github.com/cloudwego/biz-demo/gomall/app/frontend/middleware"
+	frontendutils "github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	"github.com/cloudwego/biz-demo/gomall/common/i18n"
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/middlewares/server/recovery"
 	"github.com/cloudwego/hertz/pkg/app/server"
@@ -69,6 +74,8 @@ func main() {
 	),
 		tracer,
 	)
+	// templates translate with {{ T $.lang "key" }}
+	h.SetFuncMap(template.FuncMap{"T": i18n.T, "stars": frontendutils.Stars, "money": frontendutils.Money})
 	h.LoadHTMLGlob("template/*")
 	h.Delims("{{", "}}")
 
@@ -83,21 +90,24 @@ func main() {
 	router.GeneratedRegister(h)
 
 	h.GET("sign-in", func(ctx context.Context, c *app.RequestContext) {
-		c.HTML(consts.StatusOK, "sign-in", utils.H{
-			"title": "Sign in",
+		c.HTML(consts.StatusOK, "sign-in", bizutils.WarpResponse(ctx, c, utils.H{
+			"title": frontendutils.T(ctx, "title.sign_in"),
 			"next":  c.Query("next"),
-		})
+		}))
 	})
 	h.GET("sign-up", func(ctx context.Context, c *app.RequestContext) {
-		c.HTML(consts.StatusOK, "sign-up", utils.H{
-			"title": "Sign up",
-		})
+		c.HTML(consts.StatusOK, "sign-up", bizutils.WarpResponse(ctx, c, utils.H{
+			"title": frontendutils.T(ctx, "title.sign_up"),
+		}))
 	})
 	h.GET("/redirect", func(ctx context.Context, c *app.RequestContext) {
-		c.HTML(consts.StatusOK, "about", utils.H{
-			"title": "Error",
-		})
+		c.HTML(consts.StatusOK, "about", bizutils.WarpResponse(ctx, c, utils.H{
+			"title": frontendutils.T(ctx, "title.error"),
+		}))
 	})
+	// 切换语言和货币会修改已登录用户的偏好设置，只接受 POST，避免被跨站链接触发
+	h.POST("/locale", middleware.SwitchLocale())
+	h.POST("/currency", middleware.SwitchCurrency())
 	if os.Getenv("GO_ENV") != "online" {
 		h.GET("/robots.txt", func(ctx context.Context, c *app.RequestContext) {
 			c.Data(consts.StatusOK, "text/plain", []byte(`User-agent: *
@@ -120,7 +130,8 @@ func registerMiddleware(h *server.Hertz) {
 	if err != nil {
 		panic(err)
 	}
-	store.Options(sessions.Options{MaxAge: 86400, Path: "/"})
+	// 跨站发起的 POST 请求不携带会话，无法以用户身份修改数据
+	store.Options(sessions.Options{MaxAge: 86400, Path: "/", SameSite: http.SameSiteLaxMode})
 	rs, err := redis.GetRedisStore(store)
 	if err == nil {
 		rs.SetSerializer(sessions.JSONSerializer{})
@@ -0,0 +1,103 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/hertz-contrib/sessions"
+)
+
+// Currency 协商请求的展示货币：优先使用货币切换器写入的 cookie，其次是已登录用户的偏好设置（缓存在会话中），
+// 最后是基础货币
+func Currency() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		code := string(c.Cookie(utils.CurrencyCookie))
+		if !currency.Supported(code) {
+			code = userCurrency(ctx, c)
+		}
+		if !currency.Supported(code) {
+			code = currency.Base
+		}
+		c.Next(context.WithValue(ctx, utils.CurrencyCtxKey, code))
+	}
+}
+
+// userCurrency 返回已登录用户偏好设置中的货币，与语言一样缓存在会话中
+func userCurrency(ctx context.Context, c *app.RequestContext) string {
+	userId := utils.GetUserIdFromCtx(ctx)
+	if userId == 0 {
+		return ""
+	}
+	key := sessionPreferenceKey("currency", userId)
+	if code, ok := sessions.Default(c).Get(key).(string); ok {
+		return code
+	}
+	resp, err := rpc.UserClient.GetNotificationPreferences(ctx, &rpcuser.GetNotificationPreferencesReq{UserId: int32(userId)})
+	if err != nil {
+		klog.CtxWarnf(ctx, "get preferences of user %d failed: %v", userId, err)
+		return ""
+	}
+	code := resp.GetPreferences().GetCurrency()
+	cachePreference(ctx, c, key, code)
+	return code
+}
+
+// SwitchCurrency 处理货币切换表单：写入 cookie，已登录用户同时保存到偏好设置，然后返回来源页面
+func SwitchCurrency() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		code := c.PostForm("code")
+		if !currency.Supported(code) {
+			code = currency.Base
+		}
+		c.SetCookie(utils.CurrencyCookie, code, 365*24*3600, "/", "", protocol.CookieSameSiteLaxMode, false, true)
+		saveUserCurrency(ctx, c, code)
+
+		next := "/"
+		if ref := string(c.GetHeader("Referer")); ref != "" && utils.ValidateNext(ref) {
+			next = ref
+		}
+		c.Redirect(302, []byte(next))
+	}
+}
+
+func saveUserCurrency(ctx context.Context, c *app.RequestContext, code string) {
+	userId := int32(utils.GetUserIdFromCtx(ctx))
+	if userId == 0 {
+		return
+	}
+	resp, err := rpc.UserClient.GetNotificationPreferences(ctx, &rpcuser.GetNotificationPreferencesReq{UserId: userId})
+	if err != nil {
+		klog.CtxWarnf(ctx, "get preferences of user %d failed: %v", userId, err)
+		return
+	}
+	prefs := resp.GetPreferences()
+	if prefs == nil {
+		prefs = &rpcuser.NotificationPreferences{EmailEnabled: true}
+	}
+	prefs.Currency = code
+	if _, err = rpc.UserClient.UpdateNotificationPreferences(ctx, &rpcuser.UpdateNotificationPreferencesReq{UserId: userId, Preferences: prefs}); err != nil {
+		klog.CtxWarnf(ctx, "save currency of user %d failed: %v", userId, err)
+		return
+	}
+	cachePreference(ctx, c, sessionPreferenceKey("currency", uint32(userId)), code)
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/app/frontend/utils"
+	"github.com/cloudwego/biz-demo/gomall/common/i18n"
+	rpcuser "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/hertz-contrib/sessions"
+)
+
+// Locale 协商请求的语言：优先使用语言切换器写入的 cookie，其次是已登录用户的偏好设置（缓存在会话中），
+// 最后是浏览器的 Accept-Language
+func Locale() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		locale := i18n.Normalize(string(c.Cookie(utils.LocaleCookie)))
+		if locale == "" {
+			locale = userLocale(ctx, c)
+		}
+		if locale == "" {
+			locale = i18n.MatchAcceptLanguage(string(c.GetHeader("Accept-Language")))
+		}
+		if locale == "" {
+			locale = i18n.DefaultLocale
+		}
+		c.Next(context.WithValue(ctx, utils.LocaleCtxKey, locale))
+	}
+}
+
+// userLocale 返回已登录用户偏好设置中的语言。结果缓存在会话中，每个会话只查询一次偏好设置；
+// 未设置语言也会缓存，查询失败时不缓存
+func userLocale(ctx context.Context, c *app.RequestContext) string {
+	userId := utils.GetUserIdFromCtx(ctx)
+	if userId == 0 {
+		return ""
+	}
+	key := sessionPreferenceKey("locale", userId)
+	if locale, ok := sessions.Default(c).Get(key).(string); ok {
+		return locale
+	}
+	resp, err := rpc.UserClient.GetNotificationPreferences(ctx, &rpcuser.GetNotificationPreferencesReq{UserId: int32(userId)})
+	if err != nil {
+		klog.CtxWarnf(ctx, "get preferences of user %d failed: %v", userId, err)
+		return ""
+	}
+	locale := i18n.Normalize(resp.GetPreferences().GetLocale())
+	cachePreference(ctx, c, key, locale)
+	return locale
+}
+
+// sessionPreferenceKey 返回会话中缓存用户偏好的键，键中带上用户 ID，避免切换账号后沿用上一个用户的偏好
+func sessionPreferenceKey(name string, userId uint32) string {
+	return fmt.Sprintf("%s_%d", name, userId)
+}
+
+func cachePreference(ctx context.Context, c *app.RequestContext, key, value string) {
+	session := sessions.Default(c)
+	session.Set(key, value)
+	if err := session.Save(); err != nil {
+		klog.CtxWarnf(ctx, "save %s to session failed: %v", key, err)
+	}
+}
+
+// SwitchLocale 处理语言切换表单：写入 cookie，已登录用户同时保存到偏好设置，然后返回来源页面
+func SwitchLocale() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		locale := i18n.Normalize(c.PostForm("lang"))
+		if locale == "" {
+			locale = i18n.DefaultLocale
+		}
+		c.SetCookie(utils.LocaleCookie, locale, 365*24*3600, "/", "", protocol.CookieSameSiteLaxMode, false, true)
+		saveUserLocale(ctx, c, locale)
+
+		next := "/"
+		if ref := string(c.GetHeader("Referer")); ref != "" && utils.ValidateNext(ref) {
+			next = ref
+		}
+		c.Redirect(302, []byte(next))
+	}
+}
+
+func saveUserLocale(ctx context.Context, c *app.RequestContext, locale string) {
+	userId := int32(utils.GetUserIdFromCtx(ctx))
+	if userId == 0 {
+		return
+	}
+	resp, err := rpc.UserClient.GetNotificationPreferences(ctx, &rpcuser.GetNotificationPreferencesReq{UserId: userId})
+	if err != nil {
+		klog.CtxWarnf(ctx, "get preferences of user %d failed: %v", userId, err)
+		return
+	}
+	prefs := resp.GetPreferences()
+	if prefs == nil {
+		prefs = &rpcuser.NotificationPreferences{EmailEnabled: true}
+	}
+	prefs.Locale = locale
+	if _, err = rpc.UserClient.UpdateNotificationPreferences(ctx, &rpcuser.UpdateNotificationPreferencesReq{UserId: userId, Preferences: prefs}); err != nil {
+		klog.CtxWarnf(ctx, "save locale of user %d failed: %v", userId, err)
+		return
+	}
+	cachePreference(ctx, c, sessionPreferenceKey("locale", uint32(userId)), locale)
+}
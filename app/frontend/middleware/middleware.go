@@ -18,4 +18,6 @@ import "github.com/cloudwego/hertz/pkg/app/server"
 
 func RegisterMiddleware(h *server.Hertz) {
 	h.Use(GlobalAuth())
+	h.Use(Locale())
+	h.Use(Currency())
 }
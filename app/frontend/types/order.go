@@ -30,13 +30,16 @@ type Order struct {
 	CreatedDate string
 	OrderState  string
 	Cost        float32
+	Currency    string
 	Items       []OrderItem
 }
 
 type OrderItem struct {
 	ProductId   uint32
 	ProductName string
+	Variant     string
 	Picture     string
 	Qty         uint32
+	UnitPrice   float32
 	Cost        float32
 }
@@ -0,0 +1,71 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+// CategoryName is the display name of c, translated when the catalogue has
+// a "category.name.<slug>" entry.
+func CategoryName(ctx context.Context, c *product.Category) string {
+	key := "category.name." + c.Slug
+	if name := T(ctx, key); name != key {
+		return name
+	}
+	return c.Name
+}
+
+// CategoryMenu flattens the category tree into navigation entries in
+// display order; Depth is 0 for top level categories.
+func CategoryMenu(ctx context.Context, roots []*product.Category) (menu []map[string]any) {
+	var walk func(nodes []*product.Category, depth int)
+	walk = func(nodes []*product.Category, depth int) {
+		for _, c := range nodes {
+			menu = append(menu, map[string]any{"Name": CategoryName(ctx, c), "Slug": c.Slug, "Depth": depth})
+			walk(c.Children, depth+1)
+		}
+	}
+	walk(roots, 0)
+	return menu
+}
+
+// Breadcrumbs returns the trail from the root to the first category that
+// matches key by slug or name, or nil when nothing matches.
+func Breadcrumbs(ctx context.Context, roots []*product.Category, key string) []map[string]any {
+	var path []*product.Category
+	var find func(nodes []*product.Category) bool
+	find = func(nodes []*product.Category) bool {
+		for _, c := range nodes {
+			path = append(path, c)
+			if c.Slug == strings.ToLower(key) || strings.EqualFold(c.Name, key) || find(c.Children) {
+				return true
+			}
+			path = path[:len(path)-1]
+		}
+		return false
+	}
+	if !find(roots) {
+		return nil
+	}
+	crumbs := make([]map[string]any, 0, len(path))
+	for _, c := range path {
+		crumbs = append(crumbs, map[string]any{"Name": CategoryName(ctx, c), "URL": "/category/" + c.Slug})
+	}
+	return crumbs
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func testTree() []*product.Category {
+	return []*product.Category{
+		{Name: "Apparel", Slug: "apparel", Children: []*product.Category{
+			{Name: "T-Shirt", Slug: "t-shirt"},
+		}},
+		{Name: "Sticker", Slug: "sticker"},
+	}
+}
+
+func TestCategoryMenu(t *testing.T) {
+	var got []string
+	for _, item := range CategoryMenu(context.Background(), testTree()) {
+		got = append(got, item["Slug"].(string))
+	}
+	if want := []string{"apparel", "t-shirt", "sticker"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("menu = %v, want %v", got, want)
+	}
+}
+
+func TestBreadcrumbs(t *testing.T) {
+	var got []string
+	for _, c := range Breadcrumbs(context.Background(), testTree(), "T-SHIRT") {
+		got = append(got, c["URL"].(string))
+	}
+	if want := []string{"/category/apparel", "/category/t-shirt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("breadcrumbs = %v, want %v", got, want)
+	}
+	if crumbs := Breadcrumbs(context.Background(), testTree(), "mug"); crumbs != nil {
+		t.Errorf("breadcrumbs = %v, want nil", crumbs)
+	}
+}
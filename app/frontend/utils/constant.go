@@ -19,3 +19,19 @@ const ServiceName = "frontend"
 type SessionUserIdKey string
 
 const UserIdKey = SessionUserIdKey("user_id")
+
+type LocaleKey string
+
+// LocaleCtxKey holds the locale negotiated for the request.
+const LocaleCtxKey = LocaleKey("locale")
+
+// LocaleCookie stores the locale picked with the language switcher.
+const LocaleCookie = "lang"
+
+type CurrencyKey string
+
+// CurrencyCtxKey holds the display currency negotiated for the request.
+const CurrencyCtxKey = CurrencyKey("currency")
+
+// CurrencyCookie stores the currency picked with the currency switcher.
+const CurrencyCookie = "currency"
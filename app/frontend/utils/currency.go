@@ -0,0 +1,37 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+)
+
+// GetCurrencyFromCtx returns the display currency of the request.
+func GetCurrencyFromCtx(ctx context.Context) string {
+	if code, ok := ctx.Value(CurrencyCtxKey).(string); ok && code != "" {
+		return code
+	}
+	return currency.Base
+}
+
+// Money renders an amount in code, e.g. "€9.20".
+func Money(code string, amount float32) string {
+	if code == "" {
+		code = currency.Base
+	}
+	return currency.Format(code, float64(amount))
+}
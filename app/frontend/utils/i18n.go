@@ -0,0 +1,33 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/common/i18n"
+)
+
+func GetLocaleFromCtx(ctx context.Context) string {
+	if locale, ok := ctx.Value(LocaleCtxKey).(string); ok && locale != "" {
+		return locale
+	}
+	return i18n.DefaultLocale
+}
+
+// T translates key into the locale of the request.
+func T(ctx context.Context, key string, args ...any) string {
+	return i18n.T(GetLocaleFromCtx(ctx), key, args...)
+}
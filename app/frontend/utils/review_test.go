@@ -0,0 +1,31 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "testing"
+
+func TestStars(t *testing.T) {
+	for rating, want := range map[float32]string{
+		0:   "☆☆☆☆☆",
+		2.4: "★★☆☆☆",
+		3.5: "★★★★☆",
+		5:   "★★★★★",
+		7:   "★★★★★",
+	} {
+		if got := Stars(rating); got != want {
+			t.Errorf("Stars(%v) = %s, want %s", rating, got, want)
+		}
+	}
+}
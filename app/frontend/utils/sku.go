@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+// FindSku returns the sku of p with the given id; an id of 0 matches a
+// product that has a single sku.
+func FindSku(p *product.Product, skuId uint32) *product.Sku {
+	if skuId == 0 && len(p.Skus) == 1 {
+		return p.Skus[0]
+	}
+	for _, sku := range p.Skus {
+		if sku.Id == skuId {
+			return sku
+		}
+	}
+	return nil
+}
+
+// SkuLabel describes the options of a sku, e.g. "Print: Front, Size: M".
+func SkuLabel(sku *product.Sku) string {
+	if sku == nil {
+		return ""
+	}
+	var options []string
+	for _, o := range sku.Options {
+		options = append(options, o.Name+": "+o.Value)
+	}
+	return strings.Join(options, ", ")
+}
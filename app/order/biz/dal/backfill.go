@@ -0,0 +1,104 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dal
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+// backfillBatchSize is how many items one round of the backfill loads, at
+// most as many products as one BatchGetProducts call accepts.
+const backfillBatchSize = 100
+
+// backfillSnapshots fills the product snapshot of the items of orders placed
+// before the snapshot was kept, from the current products. Items of products
+// that no longer exist are left without a snapshot, and are skipped quickly
+// on later runs since they are the only ones left.
+func backfillSnapshots(ctx context.Context) (filled int, err error) {
+	afterId := 0
+	for {
+		items, err := model.GetItemsWithoutSnapshot(mysql.DB, ctx, afterId, backfillBatchSize)
+		if err != nil || len(items) == 0 {
+			return filled, err
+		}
+		afterId = items[len(items)-1].ID
+
+		ids := make([]uint32, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ProductId)
+		}
+		resp, err := rpc.ProductClient.BatchGetProducts(ctx, &product.BatchGetProductsReq{Ids: ids})
+		if err != nil {
+			return filled, err
+		}
+		products := make(map[uint32]*product.Product, len(resp.Products))
+		for _, p := range resp.Products {
+			products[p.Id] = p
+		}
+		for i := range items {
+			p := products[items[i].ProductId]
+			if p == nil {
+				continue
+			}
+			snapshotItem(&items[i], p)
+			if err = model.UpdateItemSnapshot(mysql.DB, ctx, &items[i]); err != nil {
+				return filled, err
+			}
+			filled++
+		}
+	}
+}
+
+// snapshotItem fills the snapshot of item from its product the way checkout
+// does when the order is placed. The unit price is what the item cost, the
+// price at the time of the order is no longer known.
+func snapshotItem(item *model.OrderItem, p *product.Product) {
+	item.ProductName = p.Name
+	item.Picture = p.Picture
+	if sku := findSku(p, item.SkuId); sku != nil {
+		item.SkuCode = sku.Code
+		if sku.Picture != "" {
+			item.Picture = sku.Picture
+		}
+		var options []string
+		for _, o := range sku.Options {
+			options = append(options, o.Name+": "+o.Value)
+		}
+		item.Variant = strings.Join(options, ", ")
+	}
+	if item.Quantity > 0 {
+		item.UnitPrice = item.Cost / float32(item.Quantity)
+	}
+}
+
+// findSku returns the sku of an item, an item without a sku id matches a
+// product that has a single sku.
+func findSku(p *product.Product, skuId uint32) *product.Sku {
+	if skuId == 0 && len(p.Skus) == 1 {
+		return p.Skus[0]
+	}
+	for _, sku := range p.Skus {
+		if sku.Id == skuId {
+			return sku
+		}
+	}
+	return nil
+}
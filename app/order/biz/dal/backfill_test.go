@@ -0,0 +1,48 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dal
+
+import (
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestSnapshotItem(t *testing.T) {
+	tshirt := &product.Product{Id: 1, Name: "T-Shirt", Picture: "/static/tshirt.jpg", Skus: []*product.Sku{
+		{Id: 3, Code: "TS-F-M", Picture: "/static/tshirt-front.jpg", Options: []*product.SkuOption{{Name: "Print", Value: "Front"}, {Name: "Size", Value: "M"}}},
+		{Id: 4, Code: "TS-B-M"},
+	}}
+
+	item := &model.OrderItem{ProductId: 1, SkuId: 3, Quantity: 2, Cost: 39.8}
+	snapshotItem(item, tshirt)
+	if item.ProductName != "T-Shirt" || item.SkuCode != "TS-F-M" || item.Picture != "/static/tshirt-front.jpg" {
+		t.Errorf("snapshot = %+v", item)
+	}
+	if item.Variant != "Print: Front, Size: M" {
+		t.Errorf("Variant = %q, want %q", item.Variant, "Print: Front, Size: M")
+	}
+	if item.UnitPrice != 19.9 {
+		t.Errorf("UnitPrice = %v, want 19.9", item.UnitPrice)
+	}
+
+	// without a matching sku only the product is snapshotted
+	item = &model.OrderItem{ProductId: 1, Quantity: 1, Cost: 19.9}
+	snapshotItem(item, tshirt)
+	if item.Picture != "/static/tshirt.jpg" || item.Variant != "" || item.SkuCode != "" {
+		t.Errorf("snapshot = %+v", item)
+	}
+}
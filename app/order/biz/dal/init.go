@@ -15,11 +15,23 @@
 package dal
 
 import (
+	"context"
+
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/order/infra/rpc"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 func Init() {
 	redis.Init()
 	mysql.Init()
+	// fill the snapshots missing from old order items; when the product
+	// service is not reachable the next start tries again
+	rpc.InitClient()
+	if filled, err := backfillSnapshots(context.Background()); err != nil {
+		klog.Errorf("backfill order item snapshots: %v", err)
+	} else if filled > 0 {
+		klog.Infof("backfilled the snapshot of %d order items", filled)
+	}
 }
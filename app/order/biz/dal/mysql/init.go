@@ -45,6 +45,8 @@ func Init() {
 		DB.AutoMigrate( //nolint:errcheck
 			&model.Order{},
 			&model.OrderItem{},
+			&model.OrderPromotion{},
+			&model.OrderTax{},
 		)
 	}
 }
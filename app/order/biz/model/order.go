@@ -33,9 +33,11 @@ type Consignee struct {
 type OrderState string
 
 const (
-	OrderStatePlaced   OrderState = "placed"
-	OrderStatePaid     OrderState = "paid"
-	OrderStateCanceled OrderState = "canceled"
+	OrderStatePlaced    OrderState = "placed"
+	OrderStateReview    OrderState = "review" // on hold until its risk review
+	OrderStatePaid      OrderState = "paid"
+	OrderStateDelivered OrderState = "delivered"
+	OrderStateCanceled  OrderState = "canceled"
 )
 
 type Order struct {
@@ -43,9 +45,20 @@ type Order struct {
 	OrderId      string `gorm:"uniqueIndex;size:256"`
 	UserId       uint32
 	UserCurrency string
-	Consignee    Consignee   `gorm:"embedded"`
-	OrderItems   []OrderItem `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
-	OrderState   OrderState
+	// ExchangeRate is the units of UserCurrency per USD the amounts of the
+	// order were converted with at checkout
+	ExchangeRate float64
+	Consignee    Consignee        `gorm:"embedded"`
+	OrderItems   []OrderItem      `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
+	Promotions   []OrderPromotion `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
+	Discount     float32
+	Taxes        []OrderTax `gorm:"foreignKey:OrderIdRefer;references:OrderId"`
+	Tax          float32
+	TaxInclusive bool
+	// ShippingMethod is the id of a shipping method of checkout
+	ShippingMethod string
+	ShippingCost   float32
+	OrderState     OrderState
 }
 
 func (o Order) TableName() string {
@@ -53,7 +66,7 @@ func (o Order) TableName() string {
 }
 
 func ListOrder(db *gorm.DB, ctx context.Context, userId uint32) (orders []Order, err error) {
-	err = db.Model(&Order{}).Where(&Order{UserId: userId}).Preload("OrderItems").Find(&orders).Error
+	err = db.Model(&Order{}).Where(&Order{UserId: userId}).Preload("OrderItems").Preload("Promotions").Preload("Taxes").Find(&orders).Error
 	return
 }
 
@@ -62,6 +75,28 @@ func GetOrder(db *gorm.DB, ctx context.Context, userId uint32, orderId string) (
 	return
 }
 
+// CancelOrder cancels an order that is placed or on hold and reports whether
+// it was in one of those states.
+func CancelOrder(db *gorm.DB, ctx context.Context, userId uint32, orderId string) (bool, error) {
+	res := db.WithContext(ctx).Model(&Order{}).
+		Where(&Order{UserId: userId, OrderId: orderId}).
+		Where("order_state IN ?", []OrderState{OrderStatePlaced, OrderStateReview}).
+		Update("order_state", OrderStateCanceled)
+	return res.RowsAffected > 0, res.Error
+}
+
 func UpdateOrderState(db *gorm.DB, ctx context.Context, userId uint32, orderId string, state OrderState) error {
 	return db.Model(&Order{}).Where(&Order{UserId: userId, OrderId: orderId}).Update("order_state", state).Error
 }
+
+// HasPurchased reports whether the user has a paid or delivered order that
+// contains the product.
+func HasPurchased(db *gorm.DB, ctx context.Context, userId, productId uint32) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).Model(&Order{}).
+		Joins("JOIN order_item ON order_item.order_id_refer = `order`.order_id").
+		Where("`order`.user_id = ? AND order_item.product_id = ?", userId, productId).
+		Where("`order`.order_state IN ?", []OrderState{OrderStatePaid, OrderStateDelivered}).
+		Count(&count).Error
+	return count > 0, err
+}
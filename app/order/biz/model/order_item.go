@@ -14,14 +14,47 @@
 
 package model
 
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
 type OrderItem struct {
 	Base
 	ProductId    uint32
+	SkuId        uint32
 	OrderIdRefer string `gorm:"size:256;index"`
 	Quantity     int32
 	Cost         float32
+	// snapshot of the product when the order was placed
+	ProductName string
+	Picture     string
+	SkuCode     string
+	Variant     string
+	UnitPrice   float32
+	Currency    string `gorm:"size:3"`
 }
 
 func (oi OrderItem) TableName() string {
 	return "order_item"
 }
+
+// GetItemsWithoutSnapshot returns, in id order, up to limit items after
+// afterId that have no snapshot of their product: items of orders placed
+// before the snapshot was kept.
+func GetItemsWithoutSnapshot(db *gorm.DB, ctx context.Context, afterId, limit int) (items []OrderItem, err error) {
+	err = db.WithContext(ctx).Where("product_name = '' AND id > ?", afterId).Order("id").Limit(limit).Find(&items).Error
+	return
+}
+
+// UpdateItemSnapshot saves the product snapshot of an item.
+func UpdateItemSnapshot(db *gorm.DB, ctx context.Context, item *OrderItem) error {
+	return db.WithContext(ctx).Model(&OrderItem{}).Where("id = ?", item.ID).Updates(map[string]any{
+		"product_name": item.ProductName,
+		"picture":      item.Picture,
+		"sku_code":     item.SkuCode,
+		"variant":      item.Variant,
+		"unit_price":   item.UnitPrice,
+	}).Error
+}
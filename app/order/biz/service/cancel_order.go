@@ -0,0 +1,62 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type CancelOrderService struct {
+	ctx context.Context
+} // NewCancelOrderService new CancelOrderService
+func NewCancelOrderService(ctx context.Context) *CancelOrderService {
+	return &CancelOrderService{ctx: ctx}
+}
+
+// Run cancels an order that is placed or on hold for review. Canceling an
+// order that is already canceled succeeds again.
+func (s *CancelOrderService) Run(req *order.CancelOrderReq) (resp *order.CancelOrderResp, err error) {
+	if req.UserId == 0 || req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "user_id and order_id are required")
+	}
+	o, err := model.GetOrder(mysql.DB, s.ctx, req.UserId, req.OrderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "order not found")
+	}
+	if err != nil {
+		klog.Errorf("model.GetOrder.err:%v", err)
+		return nil, err
+	}
+	if o.OrderState == model.OrderStateCanceled {
+		return &order.CancelOrderResp{}, nil
+	}
+	ok, err := model.CancelOrder(mysql.DB, s.ctx, req.UserId, req.OrderId)
+	if err != nil {
+		klog.Errorf("model.CancelOrder.err:%v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, kerrors.NewBizStatusError(40009, "order is already "+string(o.OrderState))
+	}
+	return &order.CancelOrderResp{}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
+	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type HasPurchasedService struct {
+	ctx context.Context
+} // NewHasPurchasedService new HasPurchasedService
+func NewHasPurchasedService(ctx context.Context) *HasPurchasedService {
+	return &HasPurchasedService{ctx: ctx}
+}
+
+// Run reports whether the user has a paid or delivered order containing the
+// product.
+func (s *HasPurchasedService) Run(req *order.HasPurchasedReq) (resp *order.HasPurchasedResp, err error) {
+	if req.UserId == 0 || req.ProductId == 0 {
+		err = fmt.Errorf("user_id or product_id can not be empty")
+		return
+	}
+	purchased, err := model.HasPurchased(mysql.DB, s.ctx, req.UserId, req.ProductId)
+	if err != nil {
+		klog.Errorf("model.HasPurchased.err:%v", err)
+		return nil, err
+	}
+	return &order.HasPurchasedResp{Purchased: purchased}, nil
+}
@@ -58,8 +58,37 @@ func (s *ListOrderService) Run(req *order.ListOrderReq) (resp *order.ListOrderRe
 				Cost: v.Cost,
 				Item: &cart.CartItem{
 					ProductId: v.ProductId,
+					SkuId:     v.SkuId,
 					Quantity:  v.Quantity,
 				},
+				// 下单时的商品快照
+				ProductName: v.ProductName,
+				Picture:     v.Picture,
+				SkuCode:     v.SkuCode,
+				Variant:     v.Variant,
+				UnitPrice:   v.UnitPrice,
+				Currency:    v.Currency,
+			})
+		}
+
+		// 订单使用的优惠
+		var promotions []*order.OrderPromotion
+		for _, p := range v.Promotions {
+			promotions = append(promotions, &order.OrderPromotion{
+				PromotionId: p.PromotionId,
+				Name:        p.Name,
+				Code:        p.Code,
+				Discount:    p.Discount,
+			})
+		}
+
+		// 订单的税费明细
+		var taxes []*order.OrderTax
+		for _, t := range v.Taxes {
+			taxes = append(taxes, &order.OrderTax{
+				Name:   t.Name,
+				Rate:   t.Rate,
+				Amount: t.Amount,
 			})
 		}
 
@@ -76,7 +105,20 @@ func (s *ListOrderService) Run(req *order.ListOrderReq) (resp *order.ListOrderRe
 				StreetAddress: v.Consignee.StreetAddress,
 				ZipCode:       v.Consignee.ZipCode,
 			},
-			OrderItems: items, // 订单中所有订单项的集合
+			OrderItems: items,      // 订单中所有订单项的集合
+			Promotions: promotions, // 订单使用的优惠
+			Discount:   v.Discount, // 优惠总额
+			Taxes:      taxes,      // 税费明细
+			Tax:        v.Tax,      // 税费总额
+			// 价格是否已含税
+			TaxInclusive: v.TaxInclusive,
+			// 配送方式及运费
+			ShippingMethod: v.ShippingMethod,
+			ShippingCost:   v.ShippingCost,
+			// 下单时锁定的汇率
+			ExchangeRate: v.ExchangeRate,
+			// 订单状态
+			OrderState: string(v.OrderState),
 		}
 
 		// 将构造好的订单添加到最终返回的订单列表中
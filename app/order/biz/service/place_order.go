@@ -16,13 +16,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/order/biz/model"
 	order "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PlaceOrderService struct {
@@ -32,22 +35,41 @@ func NewPlaceOrderService(ctx context.Context) *PlaceOrderService {
 	return &PlaceOrderService{ctx: ctx}
 }
 
-// Run create note info
+// Run places an order with the items, amounts and consignee of the request.
+// When the request carries an order id the user already has an order under,
+// that order is returned and nothing else is written.
 func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (resp *order.PlaceOrderResp, err error) {
-	// Finish your business logic.
 	if len(req.OrderItems) == 0 {
 		err = fmt.Errorf("OrderItems empty")
 		return
 	}
 
-	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
-		orderId, _ := uuid.NewUUID()
+	// 风控待审核的订单先挂起，审核通过后才标记为已支付
+	state := model.OrderStatePlaced
+	if req.Hold {
+		state = model.OrderStateReview
+	}
+
+	orderId := req.OrderId
+	if orderId == "" {
+		id, _ := uuid.NewUUID()
+		orderId = id.String()
+	}
 
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
 		o := &model.Order{
-			OrderId:      orderId.String(),
-			OrderState:   model.OrderStatePlaced,
+			OrderId:      orderId,
+			OrderState:   state,
 			UserId:       req.UserId,
 			UserCurrency: req.UserCurrency,
+			// 下单时锁定的汇率
+			ExchangeRate: req.ExchangeRate,
+			Discount:     req.Discount,
+			Tax:          req.Tax,
+			TaxInclusive: req.TaxInclusive,
+			// 配送方式及运费
+			ShippingMethod: req.ShippingMethod,
+			ShippingCost:   req.ShippingCost,
 			Consignee: model.Consignee{
 				Email: req.Email,
 			},
@@ -59,25 +81,78 @@ func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (resp *order.PlaceOrde
 			o.Consignee.City = a.City
 			o.Consignee.StreetAddress = a.StreetAddress
 		}
-		if err := tx.Create(o).Error; err != nil {
-			return err
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(o)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// 重试的下单请求，返回已创建的订单
+			if _, err := model.GetOrder(tx, s.ctx, req.UserId, orderId); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return kerrors.NewBizStatusError(40009, "order id is taken")
+				}
+				return err
+			}
+			resp = &order.PlaceOrderResp{Order: &order.OrderResult{OrderId: orderId}}
+			return nil
 		}
 
+		// 订单项保存下单时的商品快照，商品之后改名、调价或删除都不影响历史订单
 		var itemList []*model.OrderItem
 		for _, v := range req.OrderItems {
+			currency := v.Currency
+			if currency == "" {
+				currency = req.UserCurrency
+			}
 			itemList = append(itemList, &model.OrderItem{
 				OrderIdRefer: o.OrderId,
 				ProductId:    v.Item.ProductId,
+				SkuId:        v.Item.SkuId,
 				Quantity:     v.Item.Quantity,
 				Cost:         v.Cost,
+				ProductName:  v.ProductName,
+				Picture:      v.Picture,
+				SkuCode:      v.SkuCode,
+				Variant:      v.Variant,
+				UnitPrice:    v.UnitPrice,
+				Currency:     currency,
 			})
 		}
 		if err := tx.Create(&itemList).Error; err != nil {
 			return err
 		}
+		if len(req.Promotions) > 0 {
+			var promotions []*model.OrderPromotion
+			for _, v := range req.Promotions {
+				promotions = append(promotions, &model.OrderPromotion{
+					OrderIdRefer: o.OrderId,
+					PromotionId:  v.PromotionId,
+					Name:         v.Name,
+					Code:         v.Code,
+					Discount:     v.Discount,
+				})
+			}
+			if err := tx.Create(&promotions).Error; err != nil {
+				return err
+			}
+		}
+		if len(req.Taxes) > 0 {
+			var taxes []*model.OrderTax
+			for _, v := range req.Taxes {
+				taxes = append(taxes, &model.OrderTax{
+					OrderIdRefer: o.OrderId,
+					Name:         v.Name,
+					Rate:         v.Rate,
+					Amount:       v.Amount,
+				})
+			}
+			if err := tx.Create(&taxes).Error; err != nil {
+				return err
+			}
+		}
 		resp = &order.PlaceOrderResp{
 			Order: &order.OrderResult{
-				OrderId: orderId.String(),
+				OrderId: orderId,
 			},
 		}
 
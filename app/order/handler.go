@@ -44,3 +44,17 @@ func (s *OrderServiceImpl) MarkOrderPaid(ctx context.Context, req *order.MarkOrd
 
 	return resp, err
 }
+
+// HasPurchased implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) HasPurchased(ctx context.Context, req *order.HasPurchasedReq) (resp *order.HasPurchasedResp, err error) {
+	resp, err = service.NewHasPurchasedService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CancelOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) CancelOrder(ctx context.Context, req *order.CancelOrderReq) (resp *order.CancelOrderResp, err error) {
+	resp, err = service.NewCancelOrderService(ctx).Run(req)
+
+	return resp, err
+}
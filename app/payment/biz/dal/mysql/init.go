@@ -43,6 +43,10 @@ func Init() {
 	if os.Getenv("GO_ENV") != "online" {
 		DB.AutoMigrate( //nolint:errcheck
 			&model.PaymentLog{},
+			&model.PaymentTender{},
+			&model.GiftCard{},
+			&model.Wallet{},
+			&model.LedgerEntry{},
 		)
 	}
 }
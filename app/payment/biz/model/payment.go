@@ -19,6 +19,15 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Payment states. A charge is captured at once unless it only authorizes
+// the amount, which is then captured or voided later.
+const (
+	PaymentAuthorized = "authorized"
+	PaymentCaptured   = "captured"
+	PaymentVoided     = "voided"
 )
 
 type PaymentLog struct {
@@ -27,6 +36,8 @@ type PaymentLog struct {
 	OrderId       string    `json:"order_id"`
 	TransactionId string    `json:"transaction_id"`
 	Amount        float32   `json:"amount"`
+	Currency      string    `json:"currency" gorm:"size:3"`
+	Status        string    `json:"status" gorm:"size:16"`
 	PayAt         time.Time `json:"pay_at"`
 }
 
@@ -37,3 +48,22 @@ func (p PaymentLog) TableName() string {
 func CreatePaymentLog(db *gorm.DB, ctx context.Context, payment *PaymentLog) error {
 	return db.WithContext(ctx).Model(&PaymentLog{}).Create(payment).Error
 }
+
+// GetPaymentLogByOrder returns the latest payment of an order.
+func GetPaymentLogByOrder(db *gorm.DB, ctx context.Context, orderId string) (payment PaymentLog, err error) {
+	err = db.WithContext(ctx).Where(&PaymentLog{OrderId: orderId}).Order("id desc").First(&payment).Error
+	return
+}
+
+// LockPaymentLog loads a payment for update, db must be a transaction.
+func LockPaymentLog(db *gorm.DB, ctx context.Context, id int) (payment PaymentLog, err error) {
+	err = db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&payment, id).Error
+	return
+}
+
+// UpdatePaymentStatus moves a payment from one state to another and reports
+// whether it was still in the from state.
+func UpdatePaymentStatus(db *gorm.DB, ctx context.Context, id int, from, to string) (bool, error) {
+	res := db.WithContext(ctx).Model(&PaymentLog{}).Where("id = ? AND status = ?", id, from).Update("status", to)
+	return res.RowsAffected > 0, res.Error
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Stored value accounts, also the types of the tenders they pay.
+const (
+	AccountGiftCard = "gift_card"
+	AccountWallet   = "wallet"
+)
+
+// Ledger entry kinds.
+const (
+	EntryIssue  = "issue"
+	EntryRedeem = "redeem"
+	EntryCharge = "charge"
+	EntryRefund = "refund"
+	EntryVoid   = "void"
+)
+
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+type GiftCard struct {
+	Base
+	Code     string `gorm:"uniqueIndex;size:32"`
+	Currency string `gorm:"size:3"`
+	// Balance is in the minor unit of Currency, e.g. cents.
+	Balance int64
+	// IssuedBy is the user who paid for the card, 0 when the store issued it.
+	IssuedBy uint32
+	// Issuer is the staff member who issued a card the store paid for.
+	Issuer string `gorm:"size:64"`
+}
+
+func (g GiftCard) TableName() string {
+	return "gift_card"
+}
+
+// Wallet is the store credit of a user in one currency.
+type Wallet struct {
+	Base
+	UserId   uint32 `gorm:"uniqueIndex:idx_wallet_user_currency"`
+	Currency string `gorm:"size:3;uniqueIndex:idx_wallet_user_currency"`
+	// Balance is in the minor unit of Currency, e.g. cents.
+	Balance int64
+}
+
+func (w Wallet) TableName() string {
+	return "wallet"
+}
+
+// LedgerEntry is a change of the balance of a gift card or a wallet. Entries
+// are only ever added, the balance of an account is the sum of its entries.
+// Amounts are in the minor unit of Currency.
+type LedgerEntry struct {
+	Base
+	AccountType string `gorm:"size:16;index:idx_ledger_account"`
+	AccountId   int    `gorm:"index:idx_ledger_account"`
+	Currency    string `gorm:"size:3"`
+	Kind        string `gorm:"size:16"`
+	// Amount is positive for credits and negative for debits.
+	Amount int64
+	// Balance is the balance of the account after the entry.
+	Balance       int64
+	OrderId       string `gorm:"size:256;index"`
+	TransactionId string `gorm:"size:64"`
+}
+
+func (e LedgerEntry) TableName() string {
+	return "ledger_entry"
+}
+
+func GetGiftCard(db *gorm.DB, ctx context.Context, code string) (card GiftCard, err error) {
+	err = db.WithContext(ctx).Where(&GiftCard{Code: code}).First(&card).Error
+	return
+}
+
+// LockGiftCard loads a gift card for update, db must be a transaction.
+func LockGiftCard(db *gorm.DB, ctx context.Context, code string) (card GiftCard, err error) {
+	err = db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(&GiftCard{Code: code}).First(&card).Error
+	return
+}
+
+func LockGiftCardById(db *gorm.DB, ctx context.Context, id int) (card GiftCard, err error) {
+	err = db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&card, id).Error
+	return
+}
+
+func CreateGiftCard(db *gorm.DB, ctx context.Context, card *GiftCard) error {
+	return db.WithContext(ctx).Create(card).Error
+}
+
+func GetWallets(db *gorm.DB, ctx context.Context, userId uint32) (wallets []Wallet, err error) {
+	err = db.WithContext(ctx).Where(&Wallet{UserId: userId}).Order("currency").Find(&wallets).Error
+	return
+}
+
+// LockWallet loads the wallet of a user in a currency for update, opening it
+// when the user has none; db must be a transaction.
+func LockWallet(db *gorm.DB, ctx context.Context, userId uint32, code string) (w Wallet, err error) {
+	db = db.WithContext(ctx)
+	if err = db.Clauses(clause.OnConflict{DoNothing: true}).Create(&Wallet{UserId: userId, Currency: code}).Error; err != nil {
+		return
+	}
+	err = db.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&Wallet{UserId: userId, Currency: code}).First(&w).Error
+	return
+}
+
+func LockWalletById(db *gorm.DB, ctx context.Context, id int) (w Wallet, err error) {
+	err = db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&w, id).Error
+	return
+}
+
+// PostGiftCard adds e.Amount to the balance of a gift card locked by the
+// transaction db and records the entry.
+func PostGiftCard(db *gorm.DB, ctx context.Context, card *GiftCard, e *LedgerEntry) error {
+	e.AccountType, e.AccountId, e.Currency = AccountGiftCard, card.ID, card.Currency
+	return post(db, ctx, &GiftCard{}, card.ID, &card.Balance, e)
+}
+
+// PostWallet adds e.Amount to the balance of a wallet locked by the
+// transaction db and records the entry.
+func PostWallet(db *gorm.DB, ctx context.Context, w *Wallet, e *LedgerEntry) error {
+	e.AccountType, e.AccountId, e.Currency = AccountWallet, w.ID, w.Currency
+	return post(db, ctx, &Wallet{}, w.ID, &w.Balance, e)
+}
+
+func post(db *gorm.DB, ctx context.Context, account any, id int, balance *int64, e *LedgerEntry) error {
+	next := *balance + e.Amount
+	if next < 0 {
+		return ErrInsufficientBalance
+	}
+	if err := db.WithContext(ctx).Model(account).Where("id = ?", id).Update("balance", next).Error; err != nil {
+		return err
+	}
+	*balance, e.Balance = next, next
+	return db.WithContext(ctx).Create(e).Error
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// TenderCard is the tender paid with the credit card, the other tenders are
+// the stored value accounts.
+const TenderCard = "card"
+
+// PaymentTender is the part of a payment paid with a gift card, a wallet or
+// the credit card.
+type PaymentTender struct {
+	Base
+	TransactionId string `gorm:"size:64;index"`
+	OrderId       string `gorm:"size:256"`
+	Type          string `gorm:"size:16"`
+	// AccountId is the gift card or wallet that paid, 0 for the card.
+	AccountId int
+	// Amount and Refunded are in the minor unit of the currency of the
+	// payment.
+	Amount   int64
+	Refunded int64
+}
+
+func (t PaymentTender) TableName() string {
+	return "payment_tender"
+}
+
+func CreateTenders(db *gorm.DB, ctx context.Context, tenders []PaymentTender) error {
+	return db.WithContext(ctx).Create(&tenders).Error
+}
+
+// GetTenders returns the tenders of a payment in the order they paid.
+func GetTenders(db *gorm.DB, ctx context.Context, transactionId string) (tenders []PaymentTender, err error) {
+	err = db.WithContext(ctx).Where(&PaymentTender{TransactionId: transactionId}).Order("id").Find(&tenders).Error
+	return
+}
+
+func UpdateTenderRefunded(db *gorm.DB, ctx context.Context, id int, refunded int64) error {
+	return db.WithContext(ctx).Model(&PaymentTender{}).Where("id = ?", id).Update("refunded", refunded).Error
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+var errSettled = errors.New("payment is settled")
+
+type CaptureService struct {
+	ctx context.Context
+} // NewCaptureService new CaptureService
+func NewCaptureService(ctx context.Context) *CaptureService {
+	return &CaptureService{ctx: ctx}
+}
+
+// Run captures the authorized payment of an order. Capturing a payment that
+// is already captured succeeds again.
+func (s *CaptureService) Run(req *payment.CaptureReq) (resp *payment.CaptureResp, err error) {
+	p, err := settlePayment(s.ctx, req.OrderId, model.PaymentCaptured)
+	if err != nil {
+		return nil, err
+	}
+	return &payment.CaptureResp{TransactionId: p.TransactionId}, nil
+}
+
+// settlePayment moves the authorized payment of an order to the captured or
+// voided state; voiding pays the gift card and wallet tenders back. Payments
+// from before authorizations were introduced have no status and count as
+// captured.
+func settlePayment(ctx context.Context, orderId, to string) (p model.PaymentLog, err error) {
+	if orderId == "" {
+		return p, kerrors.NewBizStatusError(40000, "order_id is required")
+	}
+	p, err = model.GetPaymentLogByOrder(mysql.DB, ctx, orderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return p, kerrors.NewBizStatusError(40004, "no payment for order "+orderId)
+	}
+	if err != nil {
+		return p, err
+	}
+	status := p.Status
+	if status == "" {
+		status = model.PaymentCaptured
+	}
+	if status == to {
+		return p, nil
+	}
+	if status != model.PaymentAuthorized {
+		return p, kerrors.NewBizStatusError(40009, "payment of order "+orderId+" is already "+status)
+	}
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		ok, err := model.UpdatePaymentStatus(tx, ctx, p.ID, model.PaymentAuthorized, to)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errSettled
+		}
+		if to == model.PaymentVoided {
+			_, err = refundTenders(tx, ctx, &p, 0, model.EntryVoid)
+		}
+		return err
+	})
+	if errors.Is(err, errSettled) {
+		// settled concurrently, report the state it ended up in
+		return settlePayment(ctx, orderId, to)
+	}
+	if err != nil {
+		return p, err
+	}
+	p.Status = to
+	return p, nil
+}
@@ -16,15 +16,19 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
 	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
 	"github.com/cloudwego/kitex/pkg/kerrors"
 	creditcard "github.com/durango/go-credit-card"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type ChargeService struct {
@@ -34,36 +38,114 @@ func NewChargeService(ctx context.Context) *ChargeService {
 	return &ChargeService{ctx: ctx}
 }
 
-// Run create note info
+// Run charges the amount to the gift card, then to the wallet of the user
+// and the rest to the credit card, recording each part as a tender. The
+// stored value is debited even when the charge only authorizes, Void pays
+// it back.
 func (s *ChargeService) Run(req *payment.ChargeReq) (resp *payment.ChargeResp, err error) {
-	card := creditcard.Card{
-		Number: req.CreditCard.CreditCardNumber,
-		Cvv:    strconv.Itoa(int(req.CreditCard.CreditCardCvv)),
-		Month:  strconv.Itoa(int(req.CreditCard.CreditCardExpirationMonth)),
-		Year:   strconv.Itoa(int(req.CreditCard.CreditCardExpirationYear)),
+	code := req.Currency
+	if code == "" {
+		code = currency.Base
 	}
-
-	err = card.Validate(true)
-	if err != nil {
-		return nil, kerrors.NewBizStatusError(400, err.Error())
+	if !currency.Supported(code) {
+		return nil, kerrors.NewBizStatusError(40001, "unsupported currency "+code)
+	}
+	if req.UseWallet && req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user_id is required to pay with the wallet")
 	}
 
+	status := model.PaymentCaptured
+	if req.AuthorizeOnly {
+		status = model.PaymentAuthorized
+	}
 	translationId, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
 	}
-	err = model.CreatePaymentLog(mysql.DB, s.ctx, &model.PaymentLog{
-		UserId:        req.UserId,
-		OrderId:       req.OrderId,
-		TransactionId: translationId.String(),
-		Amount:        req.Amount,
-		PayAt:         time.Now(),
+	txId := translationId.String()
+
+	var tenders []model.PaymentTender
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		remaining := toMinor(req.Amount, code)
+		entry := func(amount int64) *model.LedgerEntry {
+			return &model.LedgerEntry{Kind: model.EntryCharge, Amount: -amount, OrderId: req.OrderId, TransactionId: txId}
+		}
+		if req.GiftCardCode != "" {
+			card, err := model.LockGiftCard(tx, s.ctx, normalizeGiftCardCode(req.GiftCardCode))
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return kerrors.NewBizStatusError(40004, "gift card not found")
+			}
+			if err != nil {
+				return err
+			}
+			if card.Currency != code {
+				return kerrors.NewBizStatusError(40001, "the gift card is in "+card.Currency)
+			}
+			if take := min(card.Balance, remaining); take > 0 {
+				if err = model.PostGiftCard(tx, s.ctx, &card, entry(take)); err != nil {
+					return err
+				}
+				tenders = append(tenders, model.PaymentTender{Type: model.AccountGiftCard, AccountId: card.ID, Amount: take})
+				remaining -= take
+			}
+		}
+		if req.UseWallet && remaining > 0 {
+			w, err := model.LockWallet(tx, s.ctx, req.UserId, code)
+			if err != nil {
+				return err
+			}
+			if take := min(w.Balance, remaining); take > 0 {
+				if err = model.PostWallet(tx, s.ctx, &w, entry(take)); err != nil {
+					return err
+				}
+				tenders = append(tenders, model.PaymentTender{Type: model.AccountWallet, AccountId: w.ID, Amount: take})
+				remaining -= take
+			}
+		}
+		// the credit card pays what the stored value leaves
+		if remaining > 0 || len(tenders) == 0 {
+			if err := validateCard(req.CreditCard, remaining, code); err != nil {
+				return err
+			}
+			tenders = append(tenders, model.PaymentTender{Type: model.TenderCard, Amount: remaining})
+		}
+		for i := range tenders {
+			tenders[i].TransactionId, tenders[i].OrderId = txId, req.OrderId
+		}
+
+		if err := model.CreatePaymentLog(tx, s.ctx, &model.PaymentLog{
+			UserId:        req.UserId,
+			OrderId:       req.OrderId,
+			TransactionId: txId,
+			Amount:        req.Amount,
+			Currency:      code,
+			Status:        status,
+			PayAt:         time.Now(),
+		}); err != nil {
+			return err
+		}
+		return model.CreateTenders(tx, s.ctx, tenders)
 	})
 	if err != nil {
 		return nil, err
 	}
-	if err != nil {
-		return nil, err
+	return &payment.ChargeResp{TransactionId: txId, Tenders: toTenders(tenders, code, func(i int) int64 { return tenders[i].Amount })}, nil
+}
+
+// validateCard checks the credit card that pays amount, in minor units of
+// code.
+func validateCard(info *payment.CreditCardInfo, amount int64, code string) error {
+	if info == nil {
+		return kerrors.NewBizStatusError(40000, fmt.Sprintf("a credit card is required to pay %s", currency.Format(code, currency.FromMinor(amount, code))))
+	}
+	card := creditcard.Card{
+		Number: info.CreditCardNumber,
+		Cvv:    strconv.Itoa(int(info.CreditCardCvv)),
+		Month:  strconv.Itoa(int(info.CreditCardExpirationMonth)),
+		Year:   strconv.Itoa(int(info.CreditCardExpirationYear)),
+	}
+	if err := card.Validate(true); err != nil {
+		return kerrors.NewBizStatusError(400, err.Error())
 	}
-	return &payment.ChargeResp{TransactionId: translationId.String()}, nil
+	return nil
 }
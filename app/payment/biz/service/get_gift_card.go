@@ -0,0 +1,48 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type GetGiftCardService struct {
+	ctx context.Context
+} // NewGetGiftCardService new GetGiftCardService
+func NewGetGiftCardService(ctx context.Context) *GetGiftCardService {
+	return &GetGiftCardService{ctx: ctx}
+}
+
+// Run returns the balance of a gift card.
+func (s *GetGiftCardService) Run(req *payment.GetGiftCardReq) (resp *payment.GetGiftCardResp, err error) {
+	if req.Code == "" {
+		return nil, kerrors.NewBizStatusError(40000, "code is required")
+	}
+	card, err := model.GetGiftCard(mysql.DB, s.ctx, normalizeGiftCardCode(req.Code))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "gift card not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &payment.GetGiftCardResp{GiftCard: toGiftCard(card)}, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type GetPaymentService struct {
+	ctx context.Context
+} // NewGetPaymentService new GetPaymentService
+func NewGetPaymentService(ctx context.Context) *GetPaymentService {
+	return &GetPaymentService{ctx: ctx}
+}
+
+// Run returns the latest payment of an order. Payments from before
+// authorizations were introduced are reported as captured.
+func (s *GetPaymentService) Run(req *payment.GetPaymentReq) (resp *payment.GetPaymentResp, err error) {
+	if req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "order_id is required")
+	}
+	p, err := model.GetPaymentLogByOrder(mysql.DB, s.ctx, req.OrderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "no payment for order "+req.OrderId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	status := p.Status
+	if status == "" {
+		status = model.PaymentCaptured
+	}
+	return &payment.GetPaymentResp{
+		TransactionId: p.TransactionId,
+		Status:        status,
+		Amount:        p.Amount,
+		Currency:      p.Currency,
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type GetWalletsService struct {
+	ctx context.Context
+} // NewGetWalletsService new GetWalletsService
+func NewGetWalletsService(ctx context.Context) *GetWalletsService {
+	return &GetWalletsService{ctx: ctx}
+}
+
+// Run returns the wallets of a user, one per currency they hold credit in.
+func (s *GetWalletsService) Run(req *payment.GetWalletsReq) (resp *payment.GetWalletsResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user_id is required")
+	}
+	wallets, err := model.GetWallets(mysql.DB, s.ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	resp = &payment.GetWalletsResp{}
+	for _, w := range wallets {
+		resp.Wallets = append(resp.Wallets, toWallet(w))
+	}
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IssueGiftCardService struct {
+	ctx context.Context
+} // NewIssueGiftCardService new IssueGiftCardService
+func NewIssueGiftCardService(ctx context.Context) *IssueGiftCardService {
+	return &IssueGiftCardService{ctx: ctx}
+}
+
+// Run issues a gift card of the amount, paid by the user from their wallet
+// in the currency of the card. Cards paid by the store are issued with
+// IssueStoreGiftCard.
+func (s *IssueGiftCardService) Run(req *payment.IssueGiftCardReq) (resp *payment.IssueGiftCardResp, err error) {
+	if req.UserId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user_id is required")
+	}
+	card, err := issueGiftCard(s.ctx, model.GiftCard{IssuedBy: req.UserId}, req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &payment.IssueGiftCardResp{GiftCard: toGiftCard(card)}, nil
+}
+
+// issueGiftCard creates card with a new code and credits it with amount.
+// The wallet of card.IssuedBy pays for it, the store pays when it is 0.
+func issueGiftCard(ctx context.Context, card model.GiftCard, amount float32, code string) (model.GiftCard, error) {
+	if code == "" {
+		code = currency.Base
+	}
+	if !currency.Supported(code) {
+		return card, kerrors.NewBizStatusError(40001, "unsupported currency "+code)
+	}
+	units := toMinor(amount, code)
+	if units <= 0 {
+		return card, kerrors.NewBizStatusError(40001, "amount must be positive")
+	}
+	cardCode, err := newGiftCardCode()
+	if err != nil {
+		return card, err
+	}
+	txId := uuid.NewString()
+
+	card.Code, card.Currency = cardCode, code
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if card.IssuedBy != 0 {
+			w, err := model.LockWallet(tx, ctx, card.IssuedBy, code)
+			if err != nil {
+				return err
+			}
+			if err = model.PostWallet(tx, ctx, &w, &model.LedgerEntry{Kind: model.EntryIssue, Amount: -units, TransactionId: txId}); err != nil {
+				return balanceError(err)
+			}
+		}
+		if err := model.CreateGiftCard(tx, ctx, &card); err != nil {
+			return err
+		}
+		return model.PostGiftCard(tx, ctx, &card, &model.LedgerEntry{Kind: model.EntryIssue, Amount: units, TransactionId: txId})
+	})
+	return card, err
+}
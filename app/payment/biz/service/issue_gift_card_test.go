@@ -0,0 +1,61 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+func TestIssueGiftCard_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewIssueGiftCardService(ctx)
+	// init req and assert value
+
+	req := &payment.IssueGiftCardReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestIssueGiftCardRequiresUser(t *testing.T) {
+	_, err := NewIssueGiftCardService(context.Background()).Run(&payment.IssueGiftCardReq{Amount: 50, Currency: "USD"})
+	if bizErr, ok := kerrors.FromBizStatusError(err); !ok || bizErr.BizStatusCode() != 40000 {
+		t.Errorf("Run() without user_id err = %v, want biz error 40000", err)
+	}
+}
+
+func TestGiftCardCode(t *testing.T) {
+	code, err := newGiftCardCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != giftCardCodeLen || strings.Trim(code, giftCardAlphabet) != "" {
+		t.Errorf("newGiftCardCode() = %q", code)
+	}
+	formatted := formatGiftCardCode(code)
+	if len(formatted) != giftCardCodeLen+3 {
+		t.Errorf("formatGiftCardCode() = %q", formatted)
+	}
+	if got := normalizeGiftCardCode(strings.ToLower(formatted)); got != code {
+		t.Errorf("normalizeGiftCardCode() = %q, want %q", got, code)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type IssueStoreGiftCardService struct {
+	ctx context.Context
+} // NewIssueStoreGiftCardService new IssueStoreGiftCardService
+func NewIssueStoreGiftCardService(ctx context.Context) *IssueStoreGiftCardService {
+	return &IssueStoreGiftCardService{ctx: ctx}
+}
+
+// Run issues a gift card of the amount paid by the store, recording the
+// staff member who issued it.
+func (s *IssueStoreGiftCardService) Run(req *payment.IssueStoreGiftCardReq) (resp *payment.IssueStoreGiftCardResp, err error) {
+	if req.Issuer == "" {
+		return nil, kerrors.NewBizStatusError(40000, "issuer is required")
+	}
+	card, err := issueGiftCard(s.ctx, model.GiftCard{Issuer: req.Issuer}, req.Amount, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return &payment.IssueStoreGiftCardResp{GiftCard: toGiftCard(card)}, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RedeemGiftCardService struct {
+	ctx context.Context
+} // NewRedeemGiftCardService new RedeemGiftCardService
+func NewRedeemGiftCardService(ctx context.Context) *RedeemGiftCardService {
+	return &RedeemGiftCardService{ctx: ctx}
+}
+
+// Run moves the whole balance of a gift card into the wallet of the user in
+// the currency of the card.
+func (s *RedeemGiftCardService) Run(req *payment.RedeemGiftCardReq) (resp *payment.RedeemGiftCardResp, err error) {
+	if req.UserId == 0 || req.Code == "" {
+		return nil, kerrors.NewBizStatusError(40000, "user_id and code are required")
+	}
+	txId := uuid.NewString()
+
+	var w model.Wallet
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		card, err := model.LockGiftCard(tx, s.ctx, normalizeGiftCardCode(req.Code))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return kerrors.NewBizStatusError(40004, "gift card not found")
+		}
+		if err != nil {
+			return err
+		}
+		if card.Balance <= 0 {
+			return kerrors.NewBizStatusError(40009, "gift card has no balance left")
+		}
+		if w, err = model.LockWallet(tx, s.ctx, req.UserId, card.Currency); err != nil {
+			return err
+		}
+		amount := card.Balance
+		if err = model.PostGiftCard(tx, s.ctx, &card, &model.LedgerEntry{Kind: model.EntryRedeem, Amount: -amount, TransactionId: txId}); err != nil {
+			return err
+		}
+		return model.PostWallet(tx, s.ctx, &w, &model.LedgerEntry{Kind: model.EntryRedeem, Amount: amount, TransactionId: txId})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &payment.RedeemGiftCardResp{Wallet: toWallet(w)}, nil
+}
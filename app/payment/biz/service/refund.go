@@ -0,0 +1,147 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type RefundService struct {
+	ctx context.Context
+} // NewRefundService new RefundService
+func NewRefundService(ctx context.Context) *RefundService {
+	return &RefundService{ctx: ctx}
+}
+
+// Run refunds a captured payment to the tenders that paid it.
+func (s *RefundService) Run(req *payment.RefundReq) (resp *payment.RefundResp, err error) {
+	if req.OrderId == "" {
+		return nil, kerrors.NewBizStatusError(40000, "order_id is required")
+	}
+	if req.Amount < 0 {
+		return nil, kerrors.NewBizStatusError(40001, "amount can not be negative")
+	}
+	p, err := model.GetPaymentLogByOrder(mysql.DB, s.ctx, req.OrderId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "no payment for order "+req.OrderId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var tenders []*payment.Tender
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		// the lock keeps concurrent refunds of the payment from both passing
+		// the check of what is left
+		p, err := model.LockPaymentLog(tx, s.ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		if p.Status != "" && p.Status != model.PaymentCaptured {
+			return kerrors.NewBizStatusError(40009, "payment of order "+req.OrderId+" is "+p.Status)
+		}
+		tenders, err = refundTenders(tx, s.ctx, &p, toMinor(req.Amount, p.Currency), model.EntryRefund)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tenders) == 0 {
+		return nil, kerrors.NewBizStatusError(40009, "payment of order "+req.OrderId+" is already refunded")
+	}
+	return &payment.RefundResp{Tenders: tenders}, nil
+}
+
+// refundTenders pays amount, in minor units, of a payment back to the
+// tenders that paid it, 0 for everything not refunded yet. The gift card and
+// wallet are credited in the transaction db, which must hold the lock of the
+// payment.
+func refundTenders(db *gorm.DB, ctx context.Context, p *model.PaymentLog, amount int64, kind string) ([]*payment.Tender, error) {
+	tenders, err := model.GetTenders(db, ctx, p.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+	if len(tenders) == 0 {
+		// payments from before tenders were recorded were paid by card
+		tenders = []model.PaymentTender{{TransactionId: p.TransactionId, OrderId: p.OrderId, Type: model.TenderCard, Amount: toMinor(p.Amount, p.Currency)}}
+		if err = model.CreateTenders(db, ctx, tenders); err != nil {
+			return nil, err
+		}
+	}
+	amounts, err := allocateRefund(tenders, amount, p.Currency)
+	if err != nil {
+		return nil, err
+	}
+	for i, t := range tenders {
+		if amounts[i] == 0 {
+			continue
+		}
+		e := &model.LedgerEntry{Kind: kind, Amount: amounts[i], OrderId: p.OrderId, TransactionId: p.TransactionId}
+		switch t.Type {
+		case model.AccountGiftCard:
+			card, err := model.LockGiftCardById(db, ctx, t.AccountId)
+			if err == nil {
+				err = model.PostGiftCard(db, ctx, &card, e)
+			}
+			if err != nil {
+				return nil, err
+			}
+		case model.AccountWallet:
+			w, err := model.LockWalletById(db, ctx, t.AccountId)
+			if err == nil {
+				err = model.PostWallet(db, ctx, &w, e)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err = model.UpdateTenderRefunded(db, ctx, t.ID, t.Refunded+amounts[i]); err != nil {
+			return nil, err
+		}
+	}
+	return toTenders(tenders, p.Currency, func(i int) int64 { return amounts[i] }), nil
+}
+
+// allocateRefund splits a refund over the tenders of a payment, from the
+// last that paid to the first: the card, then the wallet, then the gift
+// card. Amounts are in minor units of code, amount 0 refunds everything not
+// refunded yet.
+func allocateRefund(tenders []model.PaymentTender, amount int64, code string) ([]int64, error) {
+	var left int64
+	for _, t := range tenders {
+		left += t.Amount - t.Refunded
+	}
+	if amount == 0 {
+		amount = left
+	}
+	if amount > left {
+		return nil, kerrors.NewBizStatusError(40001, fmt.Sprintf("only %s is left to refund", currency.Format(code, currency.FromMinor(left, code))))
+	}
+	res := make([]int64, len(tenders))
+	for i := len(tenders) - 1; i >= 0 && amount > 0; i-- {
+		res[i] = min(tenders[i].Amount-tenders[i].Refunded, amount)
+		amount -= res[i]
+	}
+	return res, nil
+}
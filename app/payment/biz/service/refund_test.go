@@ -0,0 +1,65 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+)
+
+func TestRefund_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewRefundService(ctx)
+	// init req and assert value
+
+	req := &payment.RefundReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestAllocateRefund(t *testing.T) {
+	// tenders are stored in charge order: gift card, wallet, card
+	tenders := []model.PaymentTender{
+		{Type: model.AccountGiftCard, Amount: 1000},
+		{Type: model.AccountWallet, Amount: 500},
+		{Type: model.TenderCard, Amount: 2000, Refunded: 1500},
+	}
+	got, err := allocateRefund(tenders, 1200, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{200, 500, 500}; !reflect.DeepEqual(got, want) {
+		t.Errorf("allocateRefund() = %v, want %v", got, want)
+	}
+
+	got, err = allocateRefund(tenders, 0, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{1000, 500, 500}; !reflect.DeepEqual(got, want) {
+		t.Errorf("allocateRefund() = %v, want %v", got, want)
+	}
+
+	if _, err = allocateRefund(tenders, 2001, "USD"); err == nil {
+		t.Error("allocateRefund() should reject more than is left")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+// Gift card codes leave out 0, O, 1 and I, which read alike.
+const (
+	giftCardAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	giftCardCodeLen  = 16
+)
+
+// newGiftCardCode returns a random code, stored without the dashes of
+// formatGiftCardCode.
+func newGiftCardCode() (string, error) {
+	b := make([]byte, giftCardCodeLen)
+	max := big.NewInt(int64(len(giftCardAlphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = giftCardAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// normalizeGiftCardCode accepts codes typed in lower case, with dashes or
+// spaces.
+func normalizeGiftCardCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// formatGiftCardCode groups a code by four, e.g. ABCD-EFGH-JKLM-NPQR.
+func formatGiftCardCode(code string) string {
+	var groups []string
+	for len(code) > 4 {
+		groups = append(groups, code[:4])
+		code = code[4:]
+	}
+	return strings.Join(append(groups, code), "-")
+}
+
+// toMinor converts an amount of the API to the minor unit of code the
+// balances and tenders are kept in.
+func toMinor(amount float32, code string) int64 {
+	return currency.ToMinor(float64(amount), code)
+}
+
+func fromMinor(units int64, code string) float32 {
+	return float32(currency.FromMinor(units, code))
+}
+
+// balanceError reports an overdraft of a gift card or wallet as a business
+// error.
+func balanceError(err error) error {
+	if errors.Is(err, model.ErrInsufficientBalance) {
+		return kerrors.NewBizStatusError(40009, "insufficient balance")
+	}
+	return err
+}
+
+func toGiftCard(card model.GiftCard) *payment.GiftCard {
+	return &payment.GiftCard{Code: formatGiftCardCode(card.Code), Currency: card.Currency, Balance: fromMinor(card.Balance, card.Currency)}
+}
+
+func toWallet(w model.Wallet) *payment.Wallet {
+	return &payment.Wallet{Currency: w.Currency, Balance: fromMinor(w.Balance, w.Currency)}
+}
+
+// toTenders reports an amount in minor units of code of each tender,
+// leaving out the tenders it is 0 for.
+func toTenders(tenders []model.PaymentTender, code string, amount func(i int) int64) []*payment.Tender {
+	var res []*payment.Tender
+	for i, t := range tenders {
+		if a := amount(i); a > 0 {
+			res = append(res, &payment.Tender{Type: t.Type, Amount: fromMinor(a, code)})
+		}
+	}
+	return res
+}
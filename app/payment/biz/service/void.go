@@ -0,0 +1,38 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/payment/biz/model"
+	payment "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/payment"
+)
+
+type VoidService struct {
+	ctx context.Context
+} // NewVoidService new VoidService
+func NewVoidService(ctx context.Context) *VoidService {
+	return &VoidService{ctx: ctx}
+}
+
+// Run voids the authorized payment of an order, a captured payment can not be
+// voided. Voiding a payment that is already voided succeeds again.
+func (s *VoidService) Run(req *payment.VoidReq) (resp *payment.VoidResp, err error) {
+	if _, err = settlePayment(s.ctx, req.OrderId, model.PaymentVoided); err != nil {
+		return nil, err
+	}
+	return &payment.VoidResp{}, nil
+}
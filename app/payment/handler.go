@@ -30,3 +30,66 @@ func (s *PaymentServiceImpl) Charge(ctx context.Context, req *payment.ChargeReq)
 
 	return resp, err
 }
+
+// Capture implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) Capture(ctx context.Context, req *payment.CaptureReq) (resp *payment.CaptureResp, err error) {
+	resp, err = service.NewCaptureService(ctx).Run(req)
+
+	return resp, err
+}
+
+// Void implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) Void(ctx context.Context, req *payment.VoidReq) (resp *payment.VoidResp, err error) {
+	resp, err = service.NewVoidService(ctx).Run(req)
+
+	return resp, err
+}
+
+// Refund implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) Refund(ctx context.Context, req *payment.RefundReq) (resp *payment.RefundResp, err error) {
+	resp, err = service.NewRefundService(ctx).Run(req)
+
+	return resp, err
+}
+
+// IssueGiftCard implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) IssueGiftCard(ctx context.Context, req *payment.IssueGiftCardReq) (resp *payment.IssueGiftCardResp, err error) {
+	resp, err = service.NewIssueGiftCardService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetGiftCard implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) GetGiftCard(ctx context.Context, req *payment.GetGiftCardReq) (resp *payment.GetGiftCardResp, err error) {
+	resp, err = service.NewGetGiftCardService(ctx).Run(req)
+
+	return resp, err
+}
+
+// RedeemGiftCard implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) RedeemGiftCard(ctx context.Context, req *payment.RedeemGiftCardReq) (resp *payment.RedeemGiftCardResp, err error) {
+	resp, err = service.NewRedeemGiftCardService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetWallets implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) GetWallets(ctx context.Context, req *payment.GetWalletsReq) (resp *payment.GetWalletsResp, err error) {
+	resp, err = service.NewGetWalletsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetPayment implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) GetPayment(ctx context.Context, req *payment.GetPaymentReq) (resp *payment.GetPaymentResp, err error) {
+	resp, err = service.NewGetPaymentService(ctx).Run(req)
+
+	return resp, err
+}
+
+// IssueStoreGiftCard implements the PaymentServiceImpl interface.
+func (s *PaymentServiceImpl) IssueStoreGiftCard(ctx context.Context, req *payment.IssueStoreGiftCardReq) (resp *payment.IssueStoreGiftCardResp, err error) {
+	resp, err = service.NewIssueStoreGiftCardService(ctx).Run(req)
+
+	return resp, err
+}
@@ -0,0 +1,258 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package catalog reads and writes the flat catalogue files used by bulk
+// import and export: one row per sku, repeating the product fields.
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// Columns is the csv header written by Write; Read accepts them in any order.
+var Columns = []string{"product_id", "name", "description", "picture", "categories", "sku_code", "options", "price", "stock", "sku_picture"}
+
+// required columns of a csv file
+var required = []string{"name", "sku_code", "price"}
+
+type Option struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type Row struct {
+	// Line is the number of the row among the data rows, starting at 1
+	Line int `json:"-"`
+
+	ProductId   int      `json:"product_id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Picture     string   `json:"picture,omitempty"`
+	Categories  []string `json:"categories,omitempty"`
+	SkuCode     string   `json:"sku_code"`
+	Options     []Option `json:"options,omitempty"`
+	Price       float32  `json:"price"`
+	Stock       int32    `json:"stock"`
+	SkuPicture  string   `json:"sku_picture,omitempty"`
+}
+
+type RowError struct {
+	Line    int
+	SkuCode string
+	Message string
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("row %d: %s", e.Line, e.Message)
+}
+
+func ValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatJSONL
+}
+
+// Read parses data in the given format. Rows that cannot be parsed are
+// reported as RowErrors; the error is only set when the data as a whole is
+// unreadable, e.g. a csv without a usable header.
+func Read(format string, data []byte) (rows []Row, rowErrs []RowError, err error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(data)
+	case FormatJSONL:
+		return readJSONL(data)
+	}
+	return nil, nil, fmt.Errorf("unknown format %q", format)
+}
+
+func readCSV(data []byte) (rows []Row, rowErrs []RowError, err error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range required {
+		if _, ok := index[name]; !ok {
+			return nil, nil, fmt.Errorf("missing column %q", name)
+		}
+	}
+
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, err
+			}
+			rowErrs = append(rowErrs, RowError{Line: line, Message: parseErr.Err.Error()})
+			continue
+		}
+		get := func(name string) string {
+			if i, ok := index[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		row, err := parseRecord(get)
+		row.Line = line
+		if err != nil {
+			rowErrs = append(rowErrs, RowError{Line: line, SkuCode: row.SkuCode, Message: err.Error()})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, rowErrs, nil
+}
+
+func parseRecord(get func(string) string) (row Row, err error) {
+	row = Row{
+		Name:        get("name"),
+		Description: get("description"),
+		Picture:     get("picture"),
+		SkuCode:     get("sku_code"),
+		SkuPicture:  get("sku_picture"),
+	}
+	if v := get("product_id"); v != "" {
+		if row.ProductId, err = strconv.Atoi(v); err != nil {
+			return row, fmt.Errorf("invalid product_id %q", v)
+		}
+	}
+	if v := get("price"); v != "" {
+		price, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return row, fmt.Errorf("invalid price %q", v)
+		}
+		row.Price = float32(price)
+	}
+	if v := get("stock"); v != "" {
+		stock, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return row, fmt.Errorf("invalid stock %q", v)
+		}
+		row.Stock = int32(stock)
+	}
+	for _, c := range strings.Split(get("categories"), "|") {
+		if c = strings.TrimSpace(c); c != "" {
+			row.Categories = append(row.Categories, c)
+		}
+	}
+	if row.Options, err = parseOptions(get("options")); err != nil {
+		return row, err
+	}
+	return row, nil
+}
+
+// parseOptions reads "Print=Front;Size=M".
+func parseOptions(s string) (options []Option, err error) {
+	for _, pair := range strings.Split(s, ";") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Errorf("invalid option %q, want name=value", pair)
+		}
+		options = append(options, Option{Name: name, Value: value})
+	}
+	return options, nil
+}
+
+func formatOptions(options []Option) string {
+	pairs := make([]string, len(options))
+	for i, o := range options {
+		pairs[i] = o.Name + "=" + o.Value
+	}
+	return strings.Join(pairs, ";")
+}
+
+func readJSONL(data []byte) (rows []Row, rowErrs []RowError, err error) {
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	line := 0
+	for s.Scan() {
+		text := bytes.TrimSpace(s.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		line++
+		var row Row
+		if err := json.Unmarshal(text, &row); err != nil {
+			rowErrs = append(rowErrs, RowError{Line: line, Message: "invalid json: " + err.Error()})
+			continue
+		}
+		row.Line = line
+		rows = append(rows, row)
+	}
+	return rows, rowErrs, s.Err()
+}
+
+// Write encodes rows in the given format, starting a csv with the header
+// row when header is set.
+func Write(format string, w io.Writer, rows []Row, header bool) error {
+	switch format {
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if header {
+			if err := cw.Write(Columns); err != nil {
+				return err
+			}
+		}
+		for _, r := range rows {
+			productId := ""
+			if r.ProductId != 0 {
+				productId = strconv.Itoa(r.ProductId)
+			}
+			if err := cw.Write([]string{
+				productId, r.Name, r.Description, r.Picture, strings.Join(r.Categories, "|"), r.SkuCode,
+				formatOptions(r.Options), strconv.FormatFloat(float64(r.Price), 'f', -1, 32), strconv.Itoa(int(r.Stock)), r.SkuPicture,
+			}); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case FormatJSONL:
+		enc := json.NewEncoder(w)
+		for _, r := range rows {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func testRows() []Row {
+	return []Row{
+		{Line: 1, ProductId: 3, Name: "T-Shirt", Description: "Soft, \"cotton\"", Picture: "/static/image/t-shirt.jpeg", Categories: []string{"T-Shirt", "Apparel"},
+			SkuCode: "T-SHIRT-F-M", Options: []Option{{"Print", "Front"}, {"Size", "M"}}, Price: 2.2, Stock: 50, SkuPicture: "/static/image/t-shirt-1.jpeg"},
+		{Line: 2, Name: "Notebook", SkuCode: "NOTEBOOK", Price: 9.9, Stock: 100},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []string{FormatCSV, FormatJSONL} {
+		var buf bytes.Buffer
+		if err := Write(format, &buf, testRows(), true); err != nil {
+			t.Fatalf("%s: write: %v", format, err)
+		}
+		rows, rowErrs, err := Read(format, buf.Bytes())
+		if err != nil || len(rowErrs) > 0 {
+			t.Fatalf("%s: read: %v %v", format, err, rowErrs)
+		}
+		if !reflect.DeepEqual(rows, testRows()) {
+			t.Errorf("%s: got %+v, want %+v", format, rows, testRows())
+		}
+	}
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	if _, _, err := Read(FormatCSV, []byte("name,price\nNotebook,9.9\n")); err == nil {
+		t.Error("missing sku_code column accepted")
+	}
+
+	data := "SKU_CODE,Name,Price,Stock,Options\n" +
+		"A,Notebook,9.9,1,\n" +
+		"B,Notebook,cheap,1,\n" +
+		"C,Notebook,1,1,Size\n" +
+		"D,Notebook,1,1,Size=M\n"
+	rows, rowErrs, err := Read(FormatCSV, []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[0].SkuCode != "A" || rows[1].SkuCode != "D" || rows[1].Line != 4 {
+		t.Errorf("rows = %+v", rows)
+	}
+	if len(rowErrs) != 2 || rowErrs[0].Line != 2 || rowErrs[1].Line != 3 || rowErrs[1].SkuCode != "C" {
+		t.Errorf("row errors = %+v", rowErrs)
+	}
+}
+
+func TestReadJSONLErrors(t *testing.T) {
+	data := "{\"name\":\"Notebook\",\"sku_code\":\"A\",\"price\":1}\n\n{oops}\n{\"name\":\"Pad\",\"sku_code\":\"B\",\"price\":2}\n"
+	rows, rowErrs, err := Read(FormatJSONL, []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[1].Line != 3 {
+		t.Errorf("rows = %+v", rows)
+	}
+	if len(rowErrs) != 1 || rowErrs[0].Line != 2 {
+		t.Errorf("row errors = %+v", rowErrs)
+	}
+}
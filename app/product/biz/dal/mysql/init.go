@@ -38,6 +38,8 @@ func Init() {
 		&gorm.Config{
 			PrepareStmt:            true,
 			SkipDefaultTransaction: true,
+			// unique index violations surface as gorm.ErrDuplicatedKey
+			TranslateError: true,
 		},
 	)
 	if err != nil {
@@ -48,12 +50,24 @@ func Init() {
 		DB.AutoMigrate( //nolint:errcheck
 			&model.Product{},
 			&model.Category{},
+			&model.Sku{},
+			&model.SkuOption{},
+			&model.Review{},
+			&model.ProductImage{},
+			&model.PriceChange{},
+			&model.StockReservation{},
 		)
 		if needDemoData {
-			DB.Exec("INSERT INTO `product`.`category` VALUES (1,'2023-12-06 15:05:06','2023-12-06 15:05:06','T-Shirt','T-Shirt'),(2,'2023-12-06 15:05:06','2023-12-06 15:05:06','Sticker','Sticker')")
-			DB.Exec("INSERT INTO `product`.`product` VALUES ( 1, '2023-12-06 15:26:19', '2023-12-09 22:29:10', 'Notebook', 'The cloudwego notebook is a highly efficient and feature-rich notebook designed to meet all your note-taking needs. ', '/static/image/notebook.jpeg', 9.90 ), ( 2, '2023-12-06 15:26:19', '2023-12-09 22:29:10', 'Mouse-Pad', 'The cloudwego mouse pad is a premium-grade accessory designed to enhance your computer usage experience. ', '/static/image/mouse-pad.jpeg', 8.80 ), ( 3, '2023-12-06 15:26:19', '2023-12-09 22:31:20', 'T-Shirt', 'The cloudwego t-shirt is a stylish and comfortable clothing item that allows you to showcase your fashion sense while enjoying maximum comfort.', '/static/image/t-shirt.jpeg', 6.60 ), ( 4, '2023-12-06 15:26:19', '2023-12-09 22:31:20', 'T-Shirt', 'The cloudwego t-shirt is a stylish and comfortable clothing item that allows you to showcase your fashion sense while enjoying maximum comfort.', '/static/image/t-shirt-1.jpeg', 2.20 ), ( 5, '2023-12-06 15:26:19', '2023-12-09 22:32:35', 'Sweatshirt', 'The cloudwego Sweatshirt is a cozy and fashionable garment that provides warmth and style during colder weather.', '/static/image/sweatshirt.jpeg', 1.10 ), ( 6, '2023-12-06 15:26:19', '2023-12-09 22:31:20', 'T-Shirt', 'The cloudwego t-shirt is a stylish and comfortable clothing item that allows you to showcase your fashion sense while enjoying maximum comfort.', '/static/image/t-shirt-2.jpeg', 1.80 ), ( 7, '2023-12-06 15:26:19', '2023-12-09 22:31:20', 'mascot', 'The cloudwego mascot is a charming and captivating representation of the brand, designed to bring joy and a playful spirit to any environment.', '/static/image/logo.jpg', 4.80 )")
-			DB.Exec("INSERT INTO `product`.`product_category` (product_id,category_id) VALUES ( 1, 2 ), ( 2, 2 ), ( 3, 1 ), ( 4, 1 ), ( 5, 1 ), ( 6, 1 ),( 7, 2 )")
+			DB.Exec("INSERT INTO `product`.`category` (id,created_at,updated_at,parent_id,name,slug,description,sort_order) VALUES (1,'2023-12-06 15:05:06','2023-12-06 15:05:06',0,'T-Shirt','t-shirt','T-Shirt',1),(2,'2023-12-06 15:05:06','2023-12-06 15:05:06',0,'Sticker','sticker','Sticker',2)")
+			DB.Exec("INSERT INTO `product`.`product` (id,created_at,updated_at,name,description,picture,price) VALUES ( 1, '2023-12-06 15:26:19', '2023-12-09 22:29:10', 'Notebook', 'The cloudwego notebook is a highly efficient and feature-rich notebook designed to meet all your note-taking needs. ', '/static/image/notebook.jpeg', 9.90 ), ( 2, '2023-12-06 15:26:19', '2023-12-09 22:29:10', 'Mouse-Pad', 'The cloudwego mouse pad is a premium-grade accessory designed to enhance your computer usage experience. ', '/static/image/mouse-pad.jpeg', 8.80 ), ( 3, '2023-12-06 15:26:19', '2023-12-09 22:31:20', 'T-Shirt', 'The cloudwego t-shirt is a stylish and comfortable clothing item that allows you to showcase your fashion sense while enjoying maximum comfort.', '/static/image/t-shirt.jpeg', 1.80 ), ( 5, '2023-12-06 15:26:19', '2023-12-09 22:32:35', 'Sweatshirt', 'The cloudwego Sweatshirt is a cozy and fashionable garment that provides warmth and style during colder weather.', '/static/image/sweatshirt.jpeg', 1.10 ), ( 7, '2023-12-06 15:26:19', '2023-12-09 22:31:20', 'mascot', 'The cloudwego mascot is a charming and captivating representation of the brand, designed to bring joy and a playful spirit to any environment.', '/static/image/logo.jpg', 4.80 )")
+			DB.Exec("INSERT INTO `product`.`product_category` (product_id,category_id) VALUES ( 1, 2 ), ( 2, 2 ), ( 3, 1 ), ( 5, 1 ), ( 7, 2 )")
+			DB.Exec("INSERT INTO `product`.`sku` (id,created_at,updated_at,product_id,code,price,picture,stock) VALUES ( 1, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 1, 'NOTEBOOK', 9.90, '/static/image/notebook.jpeg', 100 ), ( 2, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 2, 'MOUSE-PAD', 8.80, '/static/image/mouse-pad.jpeg', 100 ), ( 3, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-FB-S', 6.60, '/static/image/t-shirt.jpeg', 50 ), ( 4, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-FB-M', 6.60, '/static/image/t-shirt.jpeg', 50 ), ( 5, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-FB-L', 6.60, '/static/image/t-shirt.jpeg', 50 ), ( 6, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-F-S', 2.20, '/static/image/t-shirt-1.jpeg', 50 ), ( 7, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-F-M', 2.20, '/static/image/t-shirt-1.jpeg', 50 ), ( 8, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-F-L', 2.20, '/static/image/t-shirt-1.jpeg', 50 ), ( 9, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-B-S', 1.80, '/static/image/t-shirt-2.jpeg', 50 ), ( 10, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-B-M', 1.80, '/static/image/t-shirt-2.jpeg', 50 ), ( 11, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'T-SHIRT-B-L', 1.80, '/static/image/t-shirt-2.jpeg', 50 ), ( 12, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 5, 'SWEATSHIRT', 1.10, '/static/image/sweatshirt.jpeg', 100 ), ( 13, '2023-12-06 15:26:19', '2023-12-06 15:26:19', 7, 'MASCOT', 4.80, '/static/image/logo.jpg', 100 )")
+			DB.Exec("INSERT INTO `product`.`sku_option` (created_at,updated_at,sku_id,name,value) VALUES ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'Print', 'Front & back' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 3, 'Size', 'S' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 4, 'Print', 'Front & back' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 4, 'Size', 'M' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 5, 'Print', 'Front & back' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 5, 'Size', 'L' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 6, 'Print', 'Front' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 6, 'Size', 'S' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 7, 'Print', 'Front' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 7, 'Size', 'M' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 8, 'Print', 'Front' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 8, 'Size', 'L' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 9, 'Print', 'Back' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 9, 'Size', 'S' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 10, 'Print', 'Back' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 10, 'Size', 'M' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 11, 'Print', 'Back' ), ( '2023-12-06 15:26:19', '2023-12-06 15:26:19', 11, 'Size', 'L' )")
 		}
+		// categories created before slugs existed are addressed by their name
+		DB.Exec("UPDATE `product`.`category` SET slug = LOWER(REPLACE(name, ' ', '-')) WHERE slug IS NULL OR slug = ''")
+		// products created before skus existed get a single default sku
+		DB.Exec("INSERT INTO `product`.`sku` (created_at,updated_at,product_id,code,price,picture,stock) SELECT NOW(), NOW(), id, '', price, picture, 100 FROM `product`.`product` WHERE NOT EXISTS (SELECT 1 FROM `product`.`sku` WHERE sku.product_id = product.id)")
 	}
 	if err := DB.Use(tracing.NewPlugin(tracing.WithoutMetrics(), tracing.WithTracerProvider(mtl.TracerProvider))); err != nil {
 		panic(err)
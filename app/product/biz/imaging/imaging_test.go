@@ -0,0 +1,63 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestThumbnail(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 400; x++ {
+			// alternating black and white columns average to grey
+			if x%2 == 0 {
+				src.Set(x, y, color.White)
+			} else {
+				src.Set(x, y, color.Black)
+			}
+		}
+	}
+	thumb := Thumbnail(src, 100)
+	if got := thumb.Bounds().Size(); got != image.Pt(100, 50) {
+		t.Fatalf("size = %v, want (100,50)", got)
+	}
+	if c := thumb.RGBAAt(10, 10); c.R != 127 || c.A != 255 {
+		t.Errorf("pixel = %v, want grey", c)
+	}
+
+	small := Thumbnail(image.NewRGBA(image.Rect(0, 0, 30, 60)), 100)
+	if got := small.Bounds().Size(); got != image.Pt(30, 60) {
+		t.Errorf("small image resized to %v", got)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 3, 2))); err != nil {
+		t.Fatal(err)
+	}
+	img, format, err := Decode(buf.Bytes())
+	if err != nil || format != "png" || img.Bounds().Dx() != 3 {
+		t.Fatalf("Decode = %v, %q, %v", img.Bounds(), format, err)
+	}
+	if _, _, err := Decode([]byte("plain text")); err != ErrUnsupported {
+		t.Errorf("Decode of text err = %v", err)
+	}
+}
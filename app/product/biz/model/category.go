@@ -16,14 +16,18 @@ package model
 
 import (
 	"context"
+	"strings"
 
 	"gorm.io/gorm"
 )
 
 type Category struct {
 	Base
+	ParentId    int       `json:"parent_id" gorm:"index"`
 	Name        string    `json:"name"`
+	Slug        string    `json:"slug" gorm:"size:128;uniqueIndex:uni_category_slug"`
 	Description string    `json:"description"`
+	SortOrder   int       `json:"sort_order"`
 	Products    []Product `json:"product" gorm:"many2many:product_category"`
 }
 
@@ -31,7 +35,70 @@ func (c Category) TableName() string {
 	return "category"
 }
 
-func GetProductsByCategoryName(db *gorm.DB, ctx context.Context, name string) (category []Category, err error) {
-	err = db.WithContext(ctx).Model(&Category{}).Where(&Category{Name: name}).Preload("Products").Find(&category).Error
+// GetCategories returns every category, siblings in display order.
+func GetCategories(db *gorm.DB, ctx context.Context) (categories []Category, err error) {
+	err = db.WithContext(ctx).Model(&Category{}).Order("sort_order, id").Find(&categories).Error
+	return categories, err
+}
+
+func GetCategoryById(db *gorm.DB, ctx context.Context, id int) (category Category, err error) {
+	err = db.WithContext(ctx).Model(&Category{}).First(&category, id).Error
 	return category, err
 }
+
+// GetCategoryProductIds returns the ids of the products in a category.
+func GetCategoryProductIds(db *gorm.DB, ctx context.Context, categoryId int) (ids []int, err error) {
+	err = db.WithContext(ctx).Table("product_category").Where("category_id = ?", categoryId).Pluck("product_id", &ids).Error
+	return ids, err
+}
+
+// SlugTaken reports whether another category than exceptId uses slug.
+func SlugTaken(db *gorm.DB, ctx context.Context, slug string, exceptId int) (bool, error) {
+	var n int64
+	err := db.WithContext(ctx).Model(&Category{}).Where("slug = ? AND id <> ?", slug, exceptId).Count(&n).Error
+	return n > 0, err
+}
+
+// CategoryIdsWithDescendants resolves names, matched against category names
+// and slugs, to the ids of those categories and all of their descendants.
+func CategoryIdsWithDescendants(categories []Category, names []string) (ids []int) {
+	children := make(map[int][]int)
+	for _, c := range categories {
+		children[c.ParentId] = append(children[c.ParentId], c.ID)
+	}
+	seen := make(map[int]bool)
+	var walk func(id int)
+	walk = func(id int) {
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		ids = append(ids, id)
+		for _, child := range children[id] {
+			walk(child)
+		}
+	}
+	for _, c := range categories {
+		for _, name := range names {
+			if strings.EqualFold(c.Name, name) || c.Slug == strings.ToLower(name) {
+				walk(c.ID)
+			}
+		}
+	}
+	return ids
+}
+
+// IsDescendant reports whether id sits below ancestorId in the tree.
+func IsDescendant(categories []Category, ancestorId, id int) bool {
+	parents := make(map[int]int)
+	for _, c := range categories {
+		parents[c.ID] = c.ParentId
+	}
+	for steps := 0; id != 0 && steps <= len(categories); steps++ {
+		id = parents[id]
+		if id == ancestorId {
+			return true
+		}
+	}
+	return false
+}
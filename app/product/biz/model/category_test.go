@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func testCategories() []Category {
+	return []Category{
+		{Base: Base{ID: 1}, Name: "Apparel", Slug: "apparel"},
+		{Base: Base{ID: 2}, ParentId: 1, Name: "T-Shirt", Slug: "t-shirt"},
+		{Base: Base{ID: 3}, ParentId: 2, Name: "Long Sleeve", Slug: "long-sleeve"},
+		{Base: Base{ID: 4}, Name: "Sticker", Slug: "sticker"},
+	}
+}
+
+func TestCategoryIdsWithDescendants(t *testing.T) {
+	for _, c := range []struct {
+		names []string
+		want  []int
+	}{
+		{[]string{"apparel"}, []int{1, 2, 3}},
+		{[]string{"T-Shirt"}, []int{2, 3}},
+		{[]string{"long-sleeve", "Sticker"}, []int{3, 4}},
+		{[]string{"unknown"}, nil},
+	} {
+		got := CategoryIdsWithDescendants(testCategories(), c.names)
+		sort.Ints(got)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("CategoryIdsWithDescendants(%v) = %v, want %v", c.names, got, c.want)
+		}
+	}
+}
+
+func TestIsDescendant(t *testing.T) {
+	categories := testCategories()
+	if !IsDescendant(categories, 1, 3) {
+		t.Error("long-sleeve should be below apparel")
+	}
+	if IsDescendant(categories, 3, 1) || IsDescendant(categories, 4, 2) {
+		t.Error("unexpected descendant")
+	}
+}
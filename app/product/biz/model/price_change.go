@@ -0,0 +1,129 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PriceChange is an entry of the price history of a sku. Edits made through
+// UpdateProduct or an import are recorded as applied; scheduled changes stay
+// pending until the scheduler applies them at EffectiveAt.
+type PriceChange struct {
+	Base
+	ProductId int `json:"product_id" gorm:"index"`
+	SkuId     int `json:"sku_id" gorm:"index"`
+	// OldPrice is the sku price replaced by the change, set when applied
+	OldPrice    float32    `json:"old_price"`
+	Price       float32    `json:"price"`
+	EffectiveAt time.Time  `json:"effective_at" gorm:"index"`
+	AppliedAt   *time.Time `json:"applied_at"`
+	Reason      string     `json:"reason" gorm:"size:255"`
+}
+
+func (c PriceChange) TableName() string {
+	return "price_change"
+}
+
+// RecordPriceChange adds an applied entry for a price that was set directly.
+func RecordPriceChange(db *gorm.DB, ctx context.Context, productId, skuId int, oldPrice, price float32, reason string) error {
+	now := time.Now()
+	return db.WithContext(ctx).Create(&PriceChange{
+		ProductId:   productId,
+		SkuId:       skuId,
+		OldPrice:    oldPrice,
+		Price:       price,
+		EffectiveAt: now,
+		AppliedAt:   &now,
+		Reason:      reason,
+	}).Error
+}
+
+// ApplyPriceChange sets the sku price of a pending change and records the
+// replaced price in c. It reports false when the change was already applied,
+// e.g. by the scheduler of another replica, or its sku no longer exists.
+func ApplyPriceChange(db *gorm.DB, ctx context.Context, c *PriceChange, now time.Time) (applied bool, err error) {
+	var oldPrice float32
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var sku Sku
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", c.SkuId).First(&sku).Error
+		deleted := errors.Is(err, gorm.ErrRecordNotFound)
+		if err != nil && !deleted {
+			return err
+		}
+		// a change of a deleted sku is closed without effect
+		res := tx.Model(&PriceChange{}).Where("id = ? AND applied_at IS NULL", c.ID).
+			Updates(map[string]any{"applied_at": now, "old_price": sku.Price})
+		if res.Error != nil || res.RowsAffected == 0 || deleted {
+			return res.Error
+		}
+		if err := tx.Model(&sku).UpdateColumn("price", c.Price).Error; err != nil {
+			return err
+		}
+		applied, oldPrice = true, sku.Price
+		return RefreshProductPrice(tx, ctx, c.ProductId)
+	})
+	if err != nil {
+		return false, err
+	}
+	if applied {
+		c.OldPrice, c.AppliedAt = oldPrice, &now
+	}
+	return applied, nil
+}
+
+// RefreshProductPrice makes the product price the lowest price of its skus.
+func RefreshProductPrice(db *gorm.DB, ctx context.Context, productId int) error {
+	var price *float32
+	if err := db.WithContext(ctx).Model(&Sku{}).Where("product_id = ?", productId).Select("MIN(price)").Scan(&price).Error; err != nil || price == nil {
+		return err
+	}
+	return db.WithContext(ctx).Model(&Product{}).Where("id = ?", productId).UpdateColumn("price", *price).Error
+}
+
+// DuePriceChanges returns pending changes whose effective time has come,
+// oldest first.
+func DuePriceChanges(db *gorm.DB, ctx context.Context, now time.Time, limit int) (changes []PriceChange, err error) {
+	err = db.WithContext(ctx).Where("applied_at IS NULL AND effective_at <= ?", now).
+		Order("effective_at, id").Limit(limit).Find(&changes).Error
+	return
+}
+
+// NextPriceChangeAt returns the effective time of the earliest pending change.
+func NextPriceChangeAt(db *gorm.DB, ctx context.Context) (next time.Time, ok bool, err error) {
+	var c PriceChange
+	err = db.WithContext(ctx).Where("applied_at IS NULL").Order("effective_at").Limit(1).Find(&c).Error
+	if err != nil || c.ID == 0 {
+		return time.Time{}, false, err
+	}
+	return c.EffectiveAt, true, nil
+}
+
+func ListPriceChanges(db *gorm.DB, ctx context.Context, productId, skuId int, offset, limit int) (changes []PriceChange, total int64, err error) {
+	q := db.WithContext(ctx).Model(&PriceChange{}).Where("product_id = ?", productId)
+	if skuId != 0 {
+		q = q.Where("sku_id = ?", skuId)
+	}
+	if err = q.Count(&total).Error; err != nil || total == 0 {
+		return nil, total, err
+	}
+	err = q.Order("effective_at DESC, id DESC").Offset(offset).Limit(limit).Find(&changes).Error
+	return changes, total, err
+}
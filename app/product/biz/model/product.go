@@ -16,11 +16,8 @@ package model
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"time"
 
-	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
@@ -31,6 +28,12 @@ type Product struct {
 	Picture     string     `json:"picture"`
 	Price       float32    `json:"price"`
 	Categories  []Category `json:"categories" gorm:"many2many:product_category"`
+	Skus        []Sku      `json:"skus" gorm:"foreignKey:ProductId;constraint:OnDelete:CASCADE"`
+	// Images in display order, see CoverPicture
+	Images []ProductImage `json:"images" gorm:"foreignKey:ProductId;constraint:OnDelete:CASCADE"`
+	// Rating and ReviewCount aggregate the approved reviews, see RefreshRating
+	Rating      float32 `json:"rating"`
+	ReviewCount uint32  `json:"review_count"`
 }
 
 func (p Product) TableName() string {
@@ -43,55 +46,22 @@ type ProductQuery struct {
 }
 
 func (p ProductQuery) GetById(productId int) (product Product, err error) {
-	err = p.db.WithContext(p.ctx).Model(&Product{}).Where(&Product{Base: Base{ID: productId}}).First(&product).Error
+	err = p.db.WithContext(p.ctx).Model(&Product{}).Where(&Product{Base: Base{ID: productId}}).Preload("Categories").Preload("Skus.Options").Preload("Images", preloadImages).First(&product).Error
 	return
 }
 
-func NewProductQuery(ctx context.Context, db *gorm.DB) ProductQuery {
-	return ProductQuery{ctx: ctx, db: db}
-}
-
-type CachedProductQuery struct {
-	productQuery ProductQuery
-	cacheClient  *redis.Client
-	prefix       string
-}
-
-func (c CachedProductQuery) GetById(productId int) (product Product, err error) {
-	cacheKey := fmt.Sprintf("%s_%s_%d", c.prefix, "product_by_id", productId)
-	cachedResult := c.cacheClient.Get(c.productQuery.ctx, cacheKey)
-
-	err = func() error {
-		err1 := cachedResult.Err()
-		if err1 != nil {
-			return err1
-		}
-		cachedResultByte, err2 := cachedResult.Bytes()
-		if err2 != nil {
-			return err2
-		}
-		err3 := json.Unmarshal(cachedResultByte, &product)
-		if err3 != nil {
-			return err3
-		}
-		return nil
-	}()
-	if err != nil {
-		product, err = c.productQuery.GetById(productId)
-		if err != nil {
-			return Product{}, err
-		}
-		encoded, err := json.Marshal(product)
-		if err != nil {
-			return product, nil
-		}
-		_ = c.cacheClient.Set(c.productQuery.ctx, cacheKey, encoded, time.Hour)
+// GetByIds loads the products with the given ids in one query; ids that do not
+// exist are simply absent from the result.
+func (p ProductQuery) GetByIds(productIds []int) (products []Product, err error) {
+	if len(productIds) == 0 {
+		return nil, nil
 	}
+	err = p.db.WithContext(p.ctx).Model(&Product{}).Where("id IN ?", productIds).Preload("Categories").Preload("Skus.Options").Preload("Images", preloadImages).Find(&products).Error
 	return
 }
 
-func NewCachedProductQuery(pq ProductQuery, cacheClient *redis.Client) CachedProductQuery {
-	return CachedProductQuery{productQuery: pq, cacheClient: cacheClient, prefix: "cloudwego_shop"}
+func NewProductQuery(ctx context.Context, db *gorm.DB) ProductQuery {
+	return ProductQuery{ctx: ctx, db: db}
 }
 
 func GetProductById(db *gorm.DB, ctx context.Context, productId int) (product Product, err error) {
@@ -99,9 +69,9 @@ func GetProductById(db *gorm.DB, ctx context.Context, productId int) (product Pr
 	return product, err
 }
 
-func SearchProduct(db *gorm.DB, ctx context.Context, q string) (product []*Product, err error) {
-	err = db.WithContext(ctx).Model(&Product{}).Find(&product, "name like ? or description like ?", "%"+q+"%", "%"+q+"%").Error
-	return product, err
+func GetProducts(db *gorm.DB, ctx context.Context) (products []Product, err error) {
+	err = db.WithContext(ctx).Model(&Product{}).Preload("Categories").Preload("Images", preloadImages).Find(&products).Error
+	return products, err
 }
 
 func CreateProduct(db *gorm.DB, ctx context.Context, product *Product) (Product, error) {
@@ -111,3 +81,109 @@ func CreateProduct(db *gorm.DB, ctx context.Context, product *Product) (Product,
 	}
 	return *product, nil
 }
+
+const (
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+	SortName      = "name"
+	SortNewest    = "newest"
+)
+
+// ProductCursor is the sort key of the last product on a page; the next page
+// starts strictly after it.
+type ProductCursor struct {
+	Sort      string    `json:"s,omitempty"`
+	ID        int       `json:"id"`
+	Price     float32   `json:"p,omitempty"`
+	Name      string    `json:"n,omitempty"`
+	CreatedAt time.Time `json:"t,omitempty"`
+}
+
+func NewProductCursor(sort string, p Product) ProductCursor {
+	c := ProductCursor{Sort: sort, ID: p.ID}
+	switch sort {
+	case SortPriceAsc, SortPriceDesc:
+		c.Price = p.Price
+	case SortName:
+		c.Name = p.Name
+	case SortNewest:
+		c.CreatedAt = p.CreatedAt
+	}
+	return c
+}
+
+type ProductFilter struct {
+	// CategoryIds matches products in any of the categories.
+	CategoryIds []int
+	MinPrice    float32
+	MaxPrice    float32
+	Sort        string
+	// After switches to keyset pagination and Offset is ignored.
+	After  *ProductCursor
+	Offset int
+	Limit  int
+}
+
+func (f ProductFilter) where(db *gorm.DB) *gorm.DB {
+	if len(f.CategoryIds) > 0 {
+		db = db.Where("product.id IN (?)", db.Session(&gorm.Session{NewDB: true}).Table("product_category").
+			Select("product_category.product_id").
+			Where("product_category.category_id IN ?", f.CategoryIds))
+	}
+	if f.MinPrice > 0 {
+		db = db.Where("product.price >= ?", f.MinPrice)
+	}
+	if f.MaxPrice > 0 {
+		db = db.Where("product.price <= ?", f.MaxPrice)
+	}
+	return db
+}
+
+func (f ProductFilter) page(db *gorm.DB) *gorm.DB {
+	a := f.After
+	switch f.Sort {
+	case SortPriceAsc:
+		db = db.Order("product.price ASC, product.id ASC")
+		if a != nil {
+			db = db.Where("product.price > ? OR (product.price = ? AND product.id > ?)", a.Price, a.Price, a.ID)
+		}
+	case SortPriceDesc:
+		db = db.Order("product.price DESC, product.id ASC")
+		if a != nil {
+			db = db.Where("product.price < ? OR (product.price = ? AND product.id > ?)", a.Price, a.Price, a.ID)
+		}
+	case SortName:
+		db = db.Order("product.name ASC, product.id ASC")
+		if a != nil {
+			db = db.Where("product.name > ? OR (product.name = ? AND product.id > ?)", a.Name, a.Name, a.ID)
+		}
+	case SortNewest:
+		db = db.Order("product.created_at DESC, product.id DESC")
+		if a != nil {
+			db = db.Where("product.created_at < ? OR (product.created_at = ? AND product.id < ?)", a.CreatedAt, a.CreatedAt, a.ID)
+		}
+	default:
+		db = db.Order("product.id ASC")
+		if a != nil {
+			db = db.Where("product.id > ?", a.ID)
+		}
+	}
+	if a == nil && f.Offset > 0 {
+		db = db.Offset(f.Offset)
+	}
+	if f.Limit > 0 {
+		db = db.Limit(f.Limit)
+	}
+	return db
+}
+
+// ListProducts returns one page of products matching f together with the
+// number of matching products across all pages.
+func ListProducts(db *gorm.DB, ctx context.Context, f ProductFilter) (products []Product, total int64, err error) {
+	err = db.WithContext(ctx).Model(&Product{}).Scopes(f.where).Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	err = db.WithContext(ctx).Model(&Product{}).Scopes(f.where, f.page).Preload("Categories").Preload("Images", preloadImages).Find(&products).Error
+	return products, total, err
+}
@@ -0,0 +1,355 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+const (
+	// productTTL is the base lifetime of a product in Redis. Every write adds up
+	// to productTTLJitter so products cached together do not expire together.
+	productTTL       = time.Hour
+	productTTLJitter = 10 * time.Minute
+	// missingTTL keeps "not found" answers short so new products show up quickly.
+	missingTTL = time.Minute
+	// missingMarker is what Redis holds for a product id that does not exist.
+	missingMarker = "-"
+	// genTTL keeps the generation of a product well beyond any lookup that
+	// could still be running against an older one.
+	genTTL = 24 * time.Hour
+
+	// The in-process L1 sits in front of Redis. Entries are dropped by
+	// invalidation events; the short TTL bounds staleness when one is lost.
+	l1Size = 4096
+	l1TTL  = 30 * time.Second
+
+	// loadTimeout bounds a lookup shared by concurrent misses, which runs
+	// apart from the callers waiting for it.
+	loadTimeout = 3 * time.Second
+)
+
+type l1Entry struct {
+	product Product
+	missing bool
+	expires time.Time
+}
+
+var (
+	l1, _ = lru.New(l1Size)
+	// l1Gens holds the sequence number of the last eviction of the recently
+	// evicted products, so that a lookup that started before an eviction does
+	// not put back what it read. It is bounded like the L1: l1Floor is the
+	// latest eviction it forgot, every product is taken to be evicted then.
+	l1Mu      sync.Mutex
+	l1Seq     uint64
+	l1Floor   uint64
+	l1Gens, _ = lru.NewWithEvict(l1Size, func(_, seq any) {
+		l1Floor = max(l1Floor, seq.(uint64))
+	})
+	// loads coalesces concurrent misses of the same product into one lookup.
+	loads singleflight.Group
+
+	// setIfGen writes a product back to Redis unless its generation moved on
+	// since the lookup read it, i.e. unless it was invalidated in between.
+	setIfGen = redis.NewScript(`
+if (redis.call("GET", KEYS[2]) or "") ~= ARGV[1] then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+return 1
+`)
+
+	// BroadcastInvalidation, when set, tells the other product instances that a
+	// product changed so they drop it from their L1.
+	BroadcastInvalidation func(productId int) error
+)
+
+// EvictLocal drops a product from the L1 of this instance.
+func EvictLocal(productId int) {
+	l1Mu.Lock()
+	defer l1Mu.Unlock()
+	l1Seq++
+	l1Gens.Add(productId, l1Seq)
+	l1.Remove(productId)
+}
+
+// l1Gen returns the L1 generation a lookup of a product that is about to
+// read it passes to l1Put. Generations are shared by all products.
+func l1Gen(productId int) uint64 {
+	l1Mu.Lock()
+	defer l1Mu.Unlock()
+	return l1Seq
+}
+
+// l1Evicted reports whether a product was evicted after generation gen,
+// l1Mu must be held.
+func l1Evicted(productId int, gen uint64) bool {
+	evicted := l1Floor
+	if seq, ok := l1Gens.Peek(productId); ok {
+		evicted = max(evicted, seq.(uint64))
+	}
+	return evicted > gen
+}
+
+func l1Get(productId int, now time.Time) (l1Entry, bool) {
+	v, ok := l1.Get(productId)
+	if !ok {
+		return l1Entry{}, false
+	}
+	e := v.(l1Entry)
+	if now.After(e.expires) {
+		l1.Remove(productId)
+		return l1Entry{}, false
+	}
+	return e, true
+}
+
+// l1Put caches a product read at generation gen, unless it was evicted since.
+func l1Put(productId int, gen uint64, product Product, missing bool) {
+	l1Mu.Lock()
+	defer l1Mu.Unlock()
+	if l1Evicted(productId, gen) {
+		return
+	}
+	l1.Add(productId, l1Entry{product: product, missing: missing, expires: time.Now().Add(l1TTL)})
+}
+
+func jitteredTTL() time.Duration {
+	return productTTL + time.Duration(rand.Int63n(int64(productTTLJitter)))
+}
+
+// CachedProductQuery reads products through the L1, Redis and finally MySQL.
+// Products it returns are shared with the cache and must not be modified.
+type CachedProductQuery struct {
+	productQuery ProductQuery
+	cacheClient  *redis.Client
+	prefix       string
+}
+
+func (c CachedProductQuery) key(productId int) string {
+	return fmt.Sprintf("%s_%s_%d", c.prefix, "product_by_id", productId)
+}
+
+// genKey holds the generation of a product in Redis, bumped by Invalidate.
+func (c CachedProductQuery) genKey(productId int) string {
+	return fmt.Sprintf("%s_%s_%d", c.prefix, "product_gen", productId)
+}
+
+// writeBack caches what a lookup read from the database at generation gen
+// in Redis, and reports whether it did. value is the encoded product or
+// missingMarker.
+func (c CachedProductQuery) writeBack(s redis.Scripter, productId int, gen string, value []byte, ttl time.Duration) *redis.Cmd {
+	return setIfGen.Eval(c.productQuery.ctx, s, []string{c.key(productId), c.genKey(productId)}, gen, value, ttl.Milliseconds())
+}
+
+func (c CachedProductQuery) GetById(productId int) (product Product, err error) {
+	if e, ok := l1Get(productId, time.Now()); ok {
+		if e.missing {
+			return Product{}, gorm.ErrRecordNotFound
+		}
+		return e.product, nil
+	}
+	ch := loads.DoChan(c.key(productId), func() (any, error) {
+		// the lookup is shared by every caller waiting for it, so it must
+		// not be cancelled with the one that happened to start it
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c.productQuery.ctx), loadTimeout)
+		defer cancel()
+		shared := c
+		shared.productQuery.ctx = ctx
+		return shared.load(productId)
+	})
+	select {
+	case r := <-ch:
+		if r.Err != nil {
+			return Product{}, r.Err
+		}
+		return r.Val.(Product), nil
+	case <-c.productQuery.ctx.Done():
+		return Product{}, c.productQuery.ctx.Err()
+	}
+}
+
+// load reads a product from Redis, falling back to the database, and records
+// the answer, "not found" included, in both cache levels. The database
+// answer is only cached if the product was not invalidated while it was
+// read, since it may predate the change.
+func (c CachedProductQuery) load(productId int) (product Product, err error) {
+	ctx := c.productQuery.ctx
+	localGen := l1Gen(productId)
+	var gen string
+	cached, err := c.cacheClient.MGet(ctx, c.key(productId), c.genKey(productId)).Result()
+	if err != nil {
+		// Redis is unavailable; the L1 and single-flight still shield MySQL
+		klog.CtxWarnf(ctx, "get product %d from redis: %v", productId, err)
+	} else {
+		gen, _ = cached[1].(string)
+		if s, ok := cached[0].(string); ok {
+			if s == missingMarker {
+				l1Put(productId, localGen, Product{}, true)
+				return Product{}, gorm.ErrRecordNotFound
+			}
+			if json.Unmarshal([]byte(s), &product) == nil {
+				l1Put(productId, localGen, product, false)
+				return product, nil
+			}
+		}
+	}
+
+	product, err = c.productQuery.GetById(productId)
+	missing := errors.Is(err, gorm.ErrRecordNotFound)
+	if err != nil && !missing {
+		return Product{}, err
+	}
+	value, ttl := []byte(missingMarker), missingTTL
+	if !missing {
+		if value, err = json.Marshal(product); err != nil {
+			return product, nil
+		}
+		ttl = jitteredTTL()
+	}
+	// with Redis down only the L1 generation guards the write back
+	if written, err := c.writeBack(c.cacheClient, productId, gen, value, ttl).Int(); err != nil || written == 1 {
+		l1Put(productId, localGen, product, missing)
+	}
+	if missing {
+		return Product{}, gorm.ErrRecordNotFound
+	}
+	return product, nil
+}
+
+// GetByIds serves what it can from the L1, reads the rest with a single MGET
+// and loads the remaining misses from the database in one query, writing them
+// back to the cache unless they were invalidated meanwhile, as load does. Ids
+// that do not exist are absent from the result.
+func (c CachedProductQuery) GetByIds(productIds []int) (products []Product, err error) {
+	ctx := c.productQuery.ctx
+	now := time.Now()
+	var misses []int
+	localGens := make(map[int]uint64)
+	for _, id := range productIds {
+		if e, ok := l1Get(id, now); ok {
+			if !e.missing {
+				products = append(products, e.product)
+			}
+			continue
+		}
+		misses = append(misses, id)
+		localGens[id] = l1Gen(id)
+	}
+	if len(misses) == 0 {
+		return products, nil
+	}
+
+	// the product keys come first, then their generations
+	keys := make([]string, 2*len(misses))
+	for i, id := range misses {
+		keys[i], keys[len(misses)+i] = c.key(id), c.genKey(id)
+	}
+	var dbMisses []int
+	gens := make(map[int]string, len(misses))
+	cached, err := c.cacheClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		klog.CtxWarnf(ctx, "get products %v from redis: %v", misses, err)
+		dbMisses = misses
+	} else {
+		for i, id := range misses {
+			gens[id], _ = cached[len(misses)+i].(string)
+			s, ok := cached[i].(string)
+			if ok && s == missingMarker {
+				l1Put(id, localGens[id], Product{}, true)
+				continue
+			}
+			var product Product
+			if ok && json.Unmarshal([]byte(s), &product) == nil {
+				l1Put(id, localGens[id], product, false)
+				products = append(products, product)
+				continue
+			}
+			dbMisses = append(dbMisses, id)
+		}
+	}
+	if len(dbMisses) == 0 {
+		return products, nil
+	}
+
+	loaded, err := c.productQuery.GetByIds(dbMisses)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[int]Product, len(loaded))
+	for _, product := range loaded {
+		found[product.ID] = product
+	}
+	pipe := c.cacheClient.Pipeline()
+	writes := make(map[int]*redis.Cmd, len(dbMisses))
+	for _, id := range dbMisses {
+		product, ok := found[id]
+		value, ttl := []byte(missingMarker), missingTTL
+		if ok {
+			encoded, err := json.Marshal(product)
+			if err != nil {
+				continue
+			}
+			value, ttl = encoded, jitteredTTL()
+		}
+		writes[id] = c.writeBack(pipe, id, gens[id], value, ttl)
+	}
+	_, _ = pipe.Exec(ctx)
+	for id, cmd := range writes {
+		if written, err := cmd.Int(); err != nil || written == 1 {
+			product, ok := found[id]
+			l1Put(id, localGens[id], product, !ok)
+		}
+	}
+	return append(products, loaded...), nil
+}
+
+// Invalidate drops the cached copy of a product after it changed, in Redis,
+// in the local L1 and, through BroadcastInvalidation, on the other instances.
+// It bumps the generation of the product first, so that lookups still
+// running with a copy read before the change do not write it back.
+func (c CachedProductQuery) Invalidate(productId int) error {
+	ctx := c.productQuery.ctx
+	EvictLocal(productId)
+	// later readers must not join a lookup that started before the change,
+	// the lookup itself keeps running but its answer is not cached
+	loads.Forget(c.key(productId))
+	pipe := c.cacheClient.TxPipeline()
+	pipe.Incr(ctx, c.genKey(productId))
+	pipe.Expire(ctx, c.genKey(productId), genTTL)
+	pipe.Del(ctx, c.key(productId))
+	_, err := pipe.Exec(ctx)
+	if BroadcastInvalidation != nil {
+		err = errors.Join(err, BroadcastInvalidation(productId))
+	}
+	return err
+}
+
+func NewCachedProductQuery(pq ProductQuery, cacheClient *redis.Client) CachedProductQuery {
+	return CachedProductQuery{productQuery: pq, cacheClient: cacheClient, prefix: "cloudwego_shop"}
+}
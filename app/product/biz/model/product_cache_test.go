@@ -0,0 +1,115 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func TestCachedProductQueryL1(t *testing.T) {
+	c := NewCachedProductQuery(NewProductQuery(context.Background(), nil), nil)
+	l1Put(1, l1Gen(1), Product{Base: Base{ID: 1}, Name: "Notebook"}, false)
+	l1Put(2, l1Gen(2), Product{}, true)
+	defer EvictLocal(1)
+	defer EvictLocal(2)
+
+	// both hits are answered without touching Redis or MySQL
+	p, err := c.GetById(1)
+	if err != nil || p.Name != "Notebook" {
+		t.Fatalf("GetById(1) = %v, %v", p, err)
+	}
+	if _, err := c.GetById(2); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("GetById(2) err = %v, want not found", err)
+	}
+	products, err := c.GetByIds([]int{2, 1})
+	if err != nil || len(products) != 1 || products[0].ID != 1 {
+		t.Fatalf("GetByIds = %v, %v", products, err)
+	}
+
+	EvictLocal(1)
+	if _, ok := l1Get(1, time.Now()); ok {
+		t.Fatal("product 1 still cached after EvictLocal")
+	}
+}
+
+func TestL1Expiry(t *testing.T) {
+	l1Put(3, l1Gen(3), Product{Base: Base{ID: 3}}, false)
+	if _, ok := l1Get(3, time.Now()); !ok {
+		t.Fatal("fresh entry missing")
+	}
+	if _, ok := l1Get(3, time.Now().Add(l1TTL+time.Second)); ok {
+		t.Fatal("expired entry returned")
+	}
+	if _, ok := l1.Get(3); ok {
+		t.Fatal("expired entry not removed")
+	}
+}
+
+func TestL1PutAfterEvict(t *testing.T) {
+	// a lookup reads the generation, the product is evicted while it runs
+	gen := l1Gen(4)
+	EvictLocal(4)
+	l1Put(4, gen, Product{Base: Base{ID: 4}, Name: "stale"}, false)
+	if _, ok := l1Get(4, time.Now()); ok {
+		t.Fatal("stale lookup cached after eviction")
+	}
+	l1Put(4, l1Gen(4), Product{Base: Base{ID: 4}}, false)
+	defer EvictLocal(4)
+	if _, ok := l1Get(4, time.Now()); !ok {
+		t.Fatal("fresh lookup not cached")
+	}
+}
+
+func TestL1GensBounded(t *testing.T) {
+	// a lookup starts, then more products are evicted than l1Gens keeps
+	gen := l1Gen(5)
+	EvictLocal(5)
+	for id := 1000; id < 1000+2*l1Size; id++ {
+		EvictLocal(id)
+	}
+	if n := l1Gens.Len(); n > l1Size {
+		t.Fatalf("l1Gens holds %d products, want at most %d", n, l1Size)
+	}
+	// the eviction of product 5 was forgotten, the stale lookup is still
+	// turned away
+	l1Put(5, gen, Product{Base: Base{ID: 5}, Name: "stale"}, false)
+	if _, ok := l1Get(5, time.Now()); ok {
+		t.Fatal("stale lookup cached after its eviction was forgotten")
+	}
+	l1Put(5, l1Gen(5), Product{Base: Base{ID: 5}}, false)
+	defer EvictLocal(5)
+	if _, ok := l1Get(5, time.Now()); !ok {
+		t.Fatal("fresh lookup not cached")
+	}
+}
+
+func TestJitteredTTL(t *testing.T) {
+	seen := make(map[time.Duration]bool)
+	for i := 0; i < 100; i++ {
+		ttl := jitteredTTL()
+		if ttl < productTTL || ttl >= productTTL+productTTLJitter {
+			t.Fatalf("ttl %v out of range", ttl)
+		}
+		seen[ttl] = true
+	}
+	if len(seen) < 2 {
+		t.Fatal("ttl is not jittered")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// ImageURLPrefix is the frontend path that serves blobs by key.
+const ImageURLPrefix = "/images/"
+
+// ProductImage is an uploaded picture of a product. Key and ThumbnailKey name
+// the original and the resized copy in the blob store.
+type ProductImage struct {
+	Base
+	ProductId    int    `json:"product_id" gorm:"index"`
+	Key          string `json:"key" gorm:"size:255"`
+	ThumbnailKey string `json:"thumbnail_key" gorm:"size:255"`
+	Alt          string `json:"alt" gorm:"size:255"`
+	SortOrder    int32  `json:"sort_order"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+}
+
+func (i ProductImage) TableName() string {
+	return "product_image"
+}
+
+func (i ProductImage) URL() string {
+	return ImageURLPrefix + i.Key
+}
+
+func (i ProductImage) ThumbnailURL() string {
+	return ImageURLPrefix + i.ThumbnailKey
+}
+
+// CoverPicture is the thumbnail of the first image, or Picture for products
+// without uploaded images.
+func (p Product) CoverPicture() string {
+	if len(p.Images) > 0 {
+		return p.Images[0].ThumbnailURL()
+	}
+	return p.Picture
+}
+
+func preloadImages(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order, id")
+}
+
+func GetProductImage(db *gorm.DB, ctx context.Context, id int) (image ProductImage, err error) {
+	err = db.WithContext(ctx).First(&image, id).Error
+	return
+}
+
+func GetProductImages(db *gorm.DB, ctx context.Context, productId int) (images []ProductImage, err error) {
+	err = db.WithContext(ctx).Where("product_id = ?", productId).Scopes(preloadImages).Find(&images).Error
+	return
+}
+
+// NextImageSortOrder places a new image after the existing ones.
+func NextImageSortOrder(db *gorm.DB, ctx context.Context, productId int) (int32, error) {
+	var last *int32
+	err := db.WithContext(ctx).Model(&ProductImage{}).Where("product_id = ?", productId).Select("MAX(sort_order)").Scan(&last).Error
+	if err != nil || last == nil {
+		return 0, err
+	}
+	return *last + 1, nil
+}
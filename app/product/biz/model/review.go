@@ -0,0 +1,82 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ReviewApproved = "approved"
+	ReviewPending  = "pending"
+	ReviewRejected = "rejected"
+)
+
+type Review struct {
+	Base
+	ProductId        int    `json:"product_id" gorm:"uniqueIndex:idx_review_product_user;index:idx_review_product_status"`
+	UserId           uint32 `json:"user_id" gorm:"uniqueIndex:idx_review_product_user"`
+	Rating           uint32 `json:"rating"`
+	Title            string `json:"title" gorm:"size:128"`
+	Body             string `json:"body" gorm:"type:text"`
+	VerifiedPurchase bool   `json:"verified_purchase"`
+	Status           string `json:"status" gorm:"size:16;index:idx_review_product_status"`
+}
+
+func (r Review) TableName() string {
+	return "review"
+}
+
+func GetReviewById(db *gorm.DB, ctx context.Context, id int) (review Review, err error) {
+	err = db.WithContext(ctx).First(&review, id).Error
+	return review, err
+}
+
+func ReviewExists(db *gorm.DB, ctx context.Context, productId int, userId uint32) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).Model(&Review{}).Where("product_id = ? AND user_id = ?", productId, userId).Count(&count).Error
+	return count > 0, err
+}
+
+// ListReviews returns a page of the reviews of a product in the given status,
+// newest first, and the number of such reviews.
+func ListReviews(db *gorm.DB, ctx context.Context, productId int, status string, offset, limit int) (reviews []Review, total int64, err error) {
+	q := db.WithContext(ctx).Model(&Review{}).Where("product_id = ? AND status = ?", productId, status)
+	if err = q.Count(&total).Error; err != nil || total == 0 {
+		return nil, total, err
+	}
+	err = q.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&reviews).Error
+	return reviews, total, err
+}
+
+// RefreshRating recomputes the rating and review count of a product from its
+// approved reviews.
+func RefreshRating(db *gorm.DB, ctx context.Context, productId int) error {
+	var agg struct {
+		Rating      float64
+		ReviewCount int64
+	}
+	err := db.WithContext(ctx).Model(&Review{}).
+		Select("COALESCE(AVG(rating), 0) AS rating, COUNT(*) AS review_count").
+		Where("product_id = ? AND status = ?", productId, ReviewApproved).
+		Scan(&agg).Error
+	if err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Model(&Product{}).Where("id = ?", productId).
+		UpdateColumns(map[string]any{"rating": agg.Rating, "review_count": agg.ReviewCount}).Error
+}
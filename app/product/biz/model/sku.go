@@ -0,0 +1,122 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+type Sku struct {
+	Base
+	ProductId int         `json:"product_id" gorm:"index"`
+	Code      string      `json:"code"`
+	Price     float32     `json:"price"`
+	Picture   string      `json:"picture"`
+	Stock     int32       `json:"stock"`
+	Weight    int32       `json:"weight"` // grams
+	Options   []SkuOption `json:"options" gorm:"foreignKey:SkuId;constraint:OnDelete:CASCADE"`
+}
+
+func (s Sku) TableName() string {
+	return "sku"
+}
+
+// SkuOption is one axis value of a sku, e.g. size M.
+type SkuOption struct {
+	Base
+	SkuId int    `json:"sku_id" gorm:"index"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (o SkuOption) TableName() string {
+	return "sku_option"
+}
+
+type StockLine struct {
+	SkuId    int
+	Quantity int32
+}
+
+type InsufficientStockError struct {
+	SkuId int
+}
+
+func (e InsufficientStockError) Error() string {
+	return fmt.Sprintf("insufficient stock for sku %d", e.SkuId)
+}
+
+func GetSkusByIds(db *gorm.DB, ctx context.Context, ids []int) (skus []Sku, err error) {
+	err = db.WithContext(ctx).Model(&Sku{}).Where("id IN ?", ids).Find(&skus).Error
+	return skus, err
+}
+
+// ReserveStock takes every line out of stock or, if any sku is short,
+// none of them. It returns the unit prices of the skus, read while their rows
+// are locked so a price change cannot slip in between.
+func ReserveStock(db *gorm.DB, ctx context.Context, lines []StockLine) (prices map[int]float32, err error) {
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		prices, err = reserveStock(tx, lines)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+func reserveStock(tx *gorm.DB, lines []StockLine) (map[int]float32, error) {
+	// lock rows in a fixed order so concurrent checkouts cannot deadlock
+	lines = append([]StockLine(nil), lines...)
+	sort.Slice(lines, func(i, j int) bool { return lines[i].SkuId < lines[j].SkuId })
+	prices := make(map[int]float32, len(lines))
+	for _, l := range lines {
+		res := tx.Model(&Sku{}).Where("id = ? AND stock >= ?", l.SkuId, l.Quantity).
+			UpdateColumn("stock", gorm.Expr("stock - ?", l.Quantity))
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil, InsufficientStockError{SkuId: l.SkuId}
+		}
+		var price float32
+		if err := tx.Model(&Sku{}).Where("id = ?", l.SkuId).Select("price").Scan(&price).Error; err != nil {
+			return nil, err
+		}
+		prices[l.SkuId] = price
+	}
+	return prices, nil
+}
+
+// ReleaseStock puts reserved quantities back after a checkout failed.
+func ReleaseStock(db *gorm.DB, ctx context.Context, lines []StockLine) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return releaseStock(tx, lines)
+	})
+}
+
+func releaseStock(tx *gorm.DB, lines []StockLine) error {
+	for _, l := range lines {
+		if err := tx.Model(&Sku{}).Where("id = ?", l.SkuId).
+			UpdateColumn("stock", gorm.Expr("stock + ?", l.Quantity)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
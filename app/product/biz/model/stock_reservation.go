@@ -0,0 +1,115 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrReservationReleased = errors.New("reservation is released")
+
+// StockReservation is the stock taken under a reservation id, so that a
+// reservation is taken once however often it is retried and released once.
+// Releasing an id that reserved nothing records it as released, which turns
+// away a reservation that arrives late.
+type StockReservation struct {
+	Base
+	ReservationId string `gorm:"uniqueIndex;size:64"`
+	// Lines and Prices hold, as JSON, the reserved lines and the unit prices
+	// returned for them.
+	Lines    string `gorm:"type:text"`
+	Prices   string `gorm:"type:text"`
+	Released bool
+}
+
+func (r StockReservation) TableName() string {
+	return "stock_reservation"
+}
+
+// ReserveStockOnce is ReserveStock under a reservation id. Reserving an id
+// again returns the prices of the first reservation without taking stock
+// again, reserving a released id fails with ErrReservationReleased.
+func ReserveStockOnce(db *gorm.DB, ctx context.Context, reservationId string, lines []StockLine) (prices map[int]float32, err error) {
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		r, created, err := lockReservation(tx, reservationId, false)
+		if err != nil {
+			return err
+		}
+		if !created {
+			if r.Released {
+				return ErrReservationReleased
+			}
+			return json.Unmarshal([]byte(r.Prices), &prices)
+		}
+		if prices, err = reserveStock(tx, lines); err != nil {
+			return err
+		}
+		linesJSON, err := json.Marshal(lines)
+		if err != nil {
+			return err
+		}
+		pricesJSON, err := json.Marshal(prices)
+		if err != nil {
+			return err
+		}
+		return tx.Model(&r).Updates(map[string]any{"lines": string(linesJSON), "prices": string(pricesJSON)}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+// ReleaseStockOnce puts back the stock reserved under a reservation id and
+// returns its lines, none when the id is already released or reserved
+// nothing.
+func ReleaseStockOnce(db *gorm.DB, ctx context.Context, reservationId string) (lines []StockLine, err error) {
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		r, created, err := lockReservation(tx, reservationId, true)
+		if err != nil || created || r.Released {
+			return err
+		}
+		if err = json.Unmarshal([]byte(r.Lines), &lines); err != nil {
+			return err
+		}
+		if err = releaseStock(tx, lines); err != nil {
+			return err
+		}
+		return tx.Model(&r).Update("released", true).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// lockReservation creates the reservation of an id, or locks it when it
+// exists and reports created false. Creating the row first serializes
+// concurrent reservations and releases of the id on its unique key.
+func lockReservation(tx *gorm.DB, reservationId string, released bool) (r StockReservation, created bool, err error) {
+	r = StockReservation{ReservationId: reservationId, Released: released}
+	res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&r)
+	if res.Error != nil || res.RowsAffected > 0 {
+		return r, res.RowsAffected > 0, res.Error
+	}
+	r = StockReservation{}
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("reservation_id = ?", reservationId).First(&r).Error
+	return r, false, err
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pricing applies scheduled price changes once they take effect.
+package pricing
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+	// minWait keeps a change that fails to apply from spinning the loop
+	minWait   = time.Second
+	batchSize = 100
+)
+
+var wake = make(chan struct{}, 1)
+
+// Wake makes the scheduler look at the pending changes now, e.g. after a new
+// one was scheduled.
+func Wake() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Init starts the scheduler, which calls publish for every repriced product.
+// Every replica runs one; a change is applied by whichever gets to it first.
+func Init(publish func(ctx context.Context, productId int)) {
+	interval := defaultInterval
+	if s := conf.GetConf().Pricing.SchedulerInterval; s > 0 {
+		interval = time.Duration(s) * time.Second
+	}
+	go run(context.Background(), interval, publish)
+}
+
+func run(ctx context.Context, interval time.Duration, publish func(ctx context.Context, productId int)) {
+	for {
+		if _, err := ApplyDue(ctx, time.Now(), publish); err != nil {
+			klog.Errorf("pricing: apply scheduled prices: %v", err)
+		}
+		timer := time.NewTimer(nextWait(ctx, interval))
+		select {
+		case <-timer.C:
+		case <-wake:
+			timer.Stop()
+		}
+	}
+}
+
+// nextWait sleeps until the next pending change, but no longer than interval
+// so changes scheduled on other replicas are picked up too.
+func nextWait(ctx context.Context, interval time.Duration) time.Duration {
+	next, ok, err := model.NextPriceChangeAt(mysql.DB, ctx)
+	if err != nil {
+		klog.Errorf("pricing: find next scheduled price: %v", err)
+		return interval
+	}
+	if !ok {
+		return interval
+	}
+	return min(interval, max(time.Until(next), minWait))
+}
+
+// ApplyDue applies the changes effective at now and publishes the touched
+// products. It returns the number of applied changes.
+func ApplyDue(ctx context.Context, now time.Time, publish func(ctx context.Context, productId int)) (int, error) {
+	changes, err := model.DuePriceChanges(mysql.DB, ctx, now, batchSize)
+	if err != nil {
+		return 0, err
+	}
+	touched := make(map[int]bool)
+	applied := 0
+	for i := range changes {
+		ok, err := model.ApplyPriceChange(mysql.DB, ctx, &changes[i], now)
+		if err != nil {
+			klog.Errorf("pricing: apply price change %d: %v", changes[i].ID, err)
+			continue
+		}
+		if ok {
+			applied++
+			touched[changes[i].ProductId] = true
+		}
+	}
+	for productId := range touched {
+		publish(ctx, productId)
+	}
+	if applied > 0 {
+		klog.Infof("pricing: applied %d scheduled prices", applied)
+	}
+	return applied, nil
+}
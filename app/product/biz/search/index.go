@@ -0,0 +1,386 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Document is the searchable view of a product.
+type Document struct {
+	ID          int
+	Name        string
+	Description string
+	Picture     string
+	Price       float32
+	Categories  []string
+	// Rating and ReviewCount are shown with the results, not ranked on
+	Rating      float32
+	ReviewCount uint32
+}
+
+const (
+	fieldName = iota
+	fieldDescription
+	fieldCategory
+	numFields
+)
+
+var fieldWeights = [numFields]float64{fieldName: 3, fieldDescription: 1, fieldCategory: 2}
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	prefixWeight = 0.8
+	typoWeight   = 0.5
+)
+
+type postings map[int]*[numFields]int
+
+type indexedDocument struct {
+	Document
+	lengths [numFields]int
+}
+
+// Index is an in-memory inverted index over product documents, scored with
+// BM25F across the name, description and category fields.
+type Index struct {
+	mu          sync.RWMutex
+	docs        map[int]*indexedDocument
+	terms       map[string]postings
+	totalLength [numFields]int
+}
+
+func NewIndex() *Index {
+	return &Index{docs: make(map[int]*indexedDocument), terms: make(map[string]postings)}
+}
+
+func (d Document) fields() [numFields]string {
+	return [numFields]string{
+		fieldName:        d.Name,
+		fieldDescription: d.Description,
+		fieldCategory:    strings.Join(d.Categories, " "),
+	}
+}
+
+// Put adds d to the index, replacing any document with the same ID.
+func (idx *Index) Put(d Document) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(d.ID)
+	doc := &indexedDocument{Document: d}
+	for f, text := range d.fields() {
+		tokens := tokenize(text, true)
+		doc.lengths[f] = len(tokens)
+		idx.totalLength[f] += len(tokens)
+		for _, t := range tokens {
+			p, ok := idx.terms[t.term]
+			if !ok {
+				p = make(postings)
+				idx.terms[t.term] = p
+			}
+			tf, ok := p[d.ID]
+			if !ok {
+				tf = new([numFields]int)
+				p[d.ID] = tf
+			}
+			tf[f]++
+		}
+	}
+	idx.docs[d.ID] = doc
+}
+
+// Remove drops the document with the given ID, if present.
+func (idx *Index) Remove(id int) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+func (idx *Index) remove(id int) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	for f, text := range doc.fields() {
+		idx.totalLength[f] -= doc.lengths[f]
+		for _, t := range tokenize(text, true) {
+			if p, ok := idx.terms[t.term]; ok {
+				delete(p, id)
+				if len(p) == 0 {
+					delete(idx.terms, t.term)
+				}
+			}
+		}
+	}
+	delete(idx.docs, id)
+}
+
+// Replace swaps the whole index content for docs.
+func (idx *Index) Replace(docs []Document) {
+	fresh := NewIndex()
+	for _, d := range docs {
+		fresh.Put(d)
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.docs, idx.terms, idx.totalLength = fresh.docs, fresh.terms, fresh.totalLength
+}
+
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+type Query struct {
+	Text string
+	// Categories keeps hits in any of the named categories.
+	Categories []string
+	MinPrice   float32
+	MaxPrice   float32
+	Offset     int
+	Limit      int
+}
+
+type Hit struct {
+	Document
+	Score                float64
+	NameHighlight        string
+	DescriptionHighlight string
+}
+
+type Bucket struct {
+	Value string
+	Count int64
+	Min   float32
+	Max   float32
+}
+
+// PriceRanges are the buckets of the price facet; Max 0 is unbounded.
+var PriceRanges = []Bucket{
+	{Value: "0-10", Min: 0, Max: 10},
+	{Value: "10-50", Min: 10, Max: 50},
+	{Value: "50-100", Min: 50, Max: 100},
+	{Value: "100+", Min: 100},
+}
+
+type Result struct {
+	Hits  []Hit
+	Total int
+	// Each facet is counted with every filter applied except its own, so
+	// picking a category still shows how many hits the other ones have.
+	CategoryFacet []Bucket
+	PriceFacet    []Bucket
+}
+
+// expand maps each query word to the indexed terms it matches and their
+// weight: exact matches count fully, prefixes and typos less.
+func (idx *Index) expand(text string) []map[string]float64 {
+	var expanded []map[string]float64
+	for _, t := range tokenize(text, false) {
+		matches := make(map[string]float64)
+		if _, ok := idx.terms[t.term]; ok {
+			matches[t.term] = 1
+		}
+		if typos := maxTypos(t.term); !isCJKTerm(t.term) && len(t.term) >= 3 {
+			for term := range idx.terms {
+				if _, ok := matches[term]; ok || isCJKTerm(term) {
+					continue
+				}
+				if strings.HasPrefix(term, t.term) {
+					matches[term] = prefixWeight
+				} else if typos > 0 && editDistance(t.term, term, typos) <= typos {
+					matches[term] = typoWeight
+				}
+			}
+		}
+		expanded = append(expanded, matches)
+	}
+	return expanded
+}
+
+func isCJKTerm(term string) bool {
+	for _, r := range term {
+		return isCJK(r)
+	}
+	return false
+}
+
+func (idx *Index) score(expanded []map[string]float64) map[int]float64 {
+	n := float64(len(idx.docs))
+	var avg [numFields]float64
+	for f := range avg {
+		avg[f] = math.Max(1, float64(idx.totalLength[f])/math.Max(1, n))
+	}
+	scores := make(map[int]float64)
+	for _, matches := range expanded {
+		// a document matching a word several ways only counts its best match
+		best := make(map[int]float64)
+		for term, weight := range matches {
+			p := idx.terms[term]
+			df := float64(len(p))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			for id, tf := range p {
+				doc := idx.docs[id]
+				var wtf float64
+				for f := 0; f < numFields; f++ {
+					if tf[f] > 0 {
+						wtf += fieldWeights[f] * float64(tf[f]) / (1 - bm25B + bm25B*float64(doc.lengths[f])/avg[f])
+					}
+				}
+				s := weight * idf * wtf * (bm25K1 + 1) / (wtf + bm25K1)
+				if s > best[id] {
+					best[id] = s
+				}
+			}
+		}
+		for id, s := range best {
+			scores[id] += s
+		}
+	}
+	return scores
+}
+
+func (q Query) inCategory(d *indexedDocument) bool {
+	if len(q.Categories) == 0 {
+		return true
+	}
+	for _, want := range q.Categories {
+		for _, c := range d.Categories {
+			if strings.EqualFold(c, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (q Query) inPriceRange(d *indexedDocument) bool {
+	return (q.MinPrice <= 0 || d.Price >= q.MinPrice) && (q.MaxPrice <= 0 || d.Price <= q.MaxPrice)
+}
+
+// Search ranks the documents matching q.Text; an empty text matches every
+// document in ID order.
+func (idx *Index) Search(q Query) Result {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var expanded []map[string]float64
+	var scores map[int]float64
+	if strings.TrimSpace(q.Text) == "" {
+		scores = make(map[int]float64, len(idx.docs))
+		for id := range idx.docs {
+			scores[id] = 0
+		}
+	} else {
+		expanded = idx.expand(q.Text)
+		scores = idx.score(expanded)
+	}
+
+	var res Result
+	categoryCounts := make(map[string]int64)
+	priceCounts := make([]int64, len(PriceRanges))
+	for id, score := range scores {
+		doc := idx.docs[id]
+		inCategory, inPrice := q.inCategory(doc), q.inPriceRange(doc)
+		if inPrice {
+			for _, c := range doc.Categories {
+				categoryCounts[c]++
+			}
+		}
+		if inCategory {
+			for i, b := range PriceRanges {
+				if doc.Price >= b.Min && (b.Max == 0 || doc.Price < b.Max) {
+					priceCounts[i]++
+				}
+			}
+		}
+		if inCategory && inPrice {
+			res.Hits = append(res.Hits, Hit{Document: doc.Document, Score: score})
+		}
+	}
+	for c, n := range categoryCounts {
+		res.CategoryFacet = append(res.CategoryFacet, Bucket{Value: c, Count: n})
+	}
+	sort.Slice(res.CategoryFacet, func(i, j int) bool {
+		a, b := res.CategoryFacet[i], res.CategoryFacet[j]
+		return a.Count > b.Count || (a.Count == b.Count && a.Value < b.Value)
+	})
+	for i, n := range priceCounts {
+		if n > 0 {
+			b := PriceRanges[i]
+			b.Count = n
+			res.PriceFacet = append(res.PriceFacet, b)
+		}
+	}
+
+	sort.Slice(res.Hits, func(i, j int) bool {
+		a, b := res.Hits[i], res.Hits[j]
+		return a.Score > b.Score || (a.Score == b.Score && a.ID < b.ID)
+	})
+	res.Total = len(res.Hits)
+	if q.Offset >= len(res.Hits) {
+		res.Hits = nil
+	} else {
+		res.Hits = res.Hits[q.Offset:]
+	}
+	if q.Limit > 0 && len(res.Hits) > q.Limit {
+		res.Hits = res.Hits[:q.Limit]
+	}
+
+	matched := make(map[string]bool)
+	for _, matches := range expanded {
+		for term := range matches {
+			matched[term] = true
+		}
+	}
+	for i := range res.Hits {
+		res.Hits[i].NameHighlight = highlight(res.Hits[i].Name, matched)
+		res.Hits[i].DescriptionHighlight = highlight(res.Hits[i].Description, matched)
+	}
+	return res
+}
+
+// highlight html-escapes text and wraps the spans of matched terms in <em>.
+func highlight(text string, matched map[string]bool) string {
+	var spans [][2]int
+	for _, t := range tokenize(text, true) {
+		if !matched[strings.ToLower(t.term)] {
+			continue
+		}
+		if n := len(spans); n > 0 && t.start <= spans[n-1][1] {
+			spans[n-1][1] = max(spans[n-1][1], t.end)
+			continue
+		}
+		spans = append(spans, [2]int{t.start, t.end})
+	}
+	var b strings.Builder
+	last := 0
+	for _, s := range spans {
+		b.WriteString(html.EscapeString(text[last:s[0]]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(text[s[0]:s[1]]))
+		b.WriteString("</em>")
+		last = s[1]
+	}
+	b.WriteString(html.EscapeString(text[last:]))
+	return b.String()
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testIndex() *Index {
+	idx := NewIndex()
+	for _, d := range []Document{
+		{ID: 1, Name: "Notebook", Description: "The cloudwego notebook is a highly efficient notebook.", Price: 9.9, Categories: []string{"Sticker"}},
+		{ID: 2, Name: "Mouse-Pad", Description: "The cloudwego mouse pad is a premium-grade accessory.", Price: 8.8, Categories: []string{"Sticker"}},
+		{ID: 3, Name: "T-Shirt", Description: "The cloudwego t-shirt is a stylish and comfortable clothing item.", Price: 66, Categories: []string{"T-Shirt"}},
+		{ID: 4, Name: "Sweatshirt", Description: "A cozy garment that pairs well with a t-shirt.", Price: 110, Categories: []string{"T-Shirt"}},
+		{ID: 5, Name: "鼠标垫", Description: "云原生主题的鼠标垫", Price: 12, Categories: []string{"Sticker"}},
+	} {
+		idx.Put(d)
+	}
+	return idx
+}
+
+func ids(hits []Hit) (out []int) {
+	for _, h := range hits {
+		out = append(out, h.ID)
+	}
+	return out
+}
+
+func TestTokenize(t *testing.T) {
+	var terms []string
+	for _, tok := range tokenize("Go T-Shirt 鼠标垫!", false) {
+		terms = append(terms, tok.term)
+	}
+	if want := []string{"go", "t", "shirt", "鼠标", "标垫"}; !reflect.DeepEqual(terms, want) {
+		t.Errorf("tokenize = %v, want %v", terms, want)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, c := range []struct {
+		a, b string
+		want int
+	}{
+		{"shirt", "shirt", 0},
+		{"shrit", "shirt", 1},
+		{"notbook", "notebook", 1},
+		{"sweater", "sweatshirt", 3},
+	} {
+		if got := editDistance(c.a, c.b, 2); got != c.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestSearch_Ranking(t *testing.T) {
+	res := testIndex().Search(Query{Text: "t-shirt"})
+	if got := ids(res.Hits); len(got) != 2 || got[0] != 3 {
+		t.Fatalf("hits = %v, want the T-Shirt first", got)
+	}
+}
+
+func TestSearch_Typo(t *testing.T) {
+	res := testIndex().Search(Query{Text: "notbook"})
+	if got := ids(res.Hits); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("hits = %v, want [1]", got)
+	}
+}
+
+func TestSearch_CJK(t *testing.T) {
+	for _, q := range []string{"鼠标", "鼠", "云原生"} {
+		if got := ids(testIndex().Search(Query{Text: q}).Hits); !reflect.DeepEqual(got, []int{5}) {
+			t.Errorf("Search(%q) = %v, want [5]", q, got)
+		}
+	}
+}
+
+func TestSearch_Highlight(t *testing.T) {
+	res := testIndex().Search(Query{Text: "mouse 鼠标"})
+	for _, h := range res.Hits {
+		switch h.ID {
+		case 2:
+			if h.NameHighlight != "<em>Mouse</em>-Pad" {
+				t.Errorf("name highlight = %q", h.NameHighlight)
+			}
+		case 5:
+			if h.DescriptionHighlight != "云原生主题的<em>鼠标</em>垫" {
+				t.Errorf("description highlight = %q", h.DescriptionHighlight)
+			}
+		}
+	}
+}
+
+func TestSearch_FiltersAndFacets(t *testing.T) {
+	res := testIndex().Search(Query{Text: "cloudwego", Categories: []string{"sticker"}, MaxPrice: 9})
+	if got := ids(res.Hits); !reflect.DeepEqual(got, []int{2}) {
+		t.Fatalf("hits = %v, want [2]", got)
+	}
+	// the category facet ignores the category filter, the price facet the price filter
+	wantCategories := []Bucket{{Value: "Sticker", Count: 1}}
+	if !reflect.DeepEqual(res.CategoryFacet, wantCategories) {
+		t.Errorf("category facet = %+v, want %+v", res.CategoryFacet, wantCategories)
+	}
+	wantPrices := []Bucket{{Value: "0-10", Count: 2, Min: 0, Max: 10}}
+	if !reflect.DeepEqual(res.PriceFacet, wantPrices) {
+		t.Errorf("price facet = %+v, want %+v", res.PriceFacet, wantPrices)
+	}
+}
+
+func TestIndex_PutRemove(t *testing.T) {
+	idx := testIndex()
+	idx.Put(Document{ID: 1, Name: "Sketchbook", Price: 9.9})
+	if hits := idx.Search(Query{Text: "notebook"}).Hits; len(hits) != 0 {
+		t.Errorf("stale hits after update: %v", ids(hits))
+	}
+	idx.Remove(3)
+	if got := ids(idx.Search(Query{Text: "t-shirt"}).Hits); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("hits after remove = %v, want [4]", got)
+	}
+	if n := idx.Len(); n != 4 {
+		t.Errorf("Len = %d, want 4", n)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// Default is the index served by SearchProducts and kept in sync by the
+// product write RPCs.
+var Default = NewIndex()
+
+func Init() {
+	if err := Rebuild(context.Background()); err != nil {
+		klog.Errorf("search: build index: %v", err)
+	}
+	if interval := conf.GetConf().Search.RebuildInterval; interval > 0 {
+		go func() {
+			for range time.Tick(time.Duration(interval) * time.Second) {
+				if err := Rebuild(context.Background()); err != nil {
+					klog.Errorf("search: rebuild index: %v", err)
+				}
+			}
+		}()
+	}
+}
+
+// Rebuild reloads every product from MySQL into Default.
+func Rebuild(ctx context.Context) error {
+	products, err := model.GetProducts(mysql.DB, ctx)
+	if err != nil {
+		return err
+	}
+	docs := make([]Document, 0, len(products))
+	for _, p := range products {
+		docs = append(docs, FromProduct(p))
+	}
+	Default.Replace(docs)
+	klog.Infof("search: indexed %d products", len(docs))
+	return nil
+}
+
+func FromProduct(p model.Product) Document {
+	d := Document{ID: p.ID, Name: p.Name, Description: p.Description, Picture: p.CoverPicture(), Price: p.Price, Rating: p.Rating, ReviewCount: p.ReviewCount}
+	for _, c := range p.Categories {
+		d.Categories = append(d.Categories, c.Name)
+	}
+	return d
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// update is a change of the index sent to the other product instances. It
+// carries the document itself, so applying it does not touch MySQL.
+type update struct {
+	Put     *Document `json:"put,omitempty"`
+	Remove  int       `json:"remove,omitempty"`
+	Rebuild bool      `json:"rebuild,omitempty"`
+}
+
+// BroadcastUpdate, when set, sends an encoded index change to the other
+// product instances, which apply it with Apply.
+var BroadcastUpdate func(data []byte) error
+
+// Put indexes a product on this instance and on the others.
+func Put(ctx context.Context, d Document) {
+	Default.Put(d)
+	broadcast(ctx, update{Put: &d})
+}
+
+// Remove drops a product from the index on this instance and on the others.
+func Remove(ctx context.Context, id int) {
+	Default.Remove(id)
+	broadcast(ctx, update{Remove: id})
+}
+
+// RebuildAll rebuilds the index on this instance and has the others rebuild
+// theirs, after a change to many documents such as renaming a category.
+func RebuildAll(ctx context.Context) error {
+	broadcast(ctx, update{Rebuild: true})
+	return Rebuild(ctx)
+}
+
+// Apply applies an index change broadcast by an instance, this one included.
+func Apply(data []byte) error {
+	var u update
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+	switch {
+	case u.Put != nil:
+		Default.Put(*u.Put)
+	case u.Remove != 0:
+		Default.Remove(u.Remove)
+	case u.Rebuild:
+		// a rebuild reads every product, it must not hold up the updates
+		// that follow it
+		go func() {
+			if err := Rebuild(context.Background()); err != nil {
+				klog.Errorf("search: rebuild index: %v", err)
+			}
+		}()
+	default:
+		return errors.New("empty index update")
+	}
+	return nil
+}
+
+// broadcast sends an index change, a lost one is repaired by the periodic
+// rebuild.
+func broadcast(ctx context.Context, u update) {
+	if BroadcastUpdate == nil {
+		return
+	}
+	data, err := json.Marshal(u)
+	if err == nil {
+		err = BroadcastUpdate(data)
+	}
+	if err != nil {
+		klog.CtxWarnf(ctx, "search: broadcast index update: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestBroadcastUpdates(t *testing.T) {
+	defer func(idx *Index, broadcast func([]byte) error) { Default, BroadcastUpdate = idx, broadcast }(Default, BroadcastUpdate)
+	var sent [][]byte
+	BroadcastUpdate = func(data []byte) error {
+		sent = append(sent, data)
+		return nil
+	}
+	ctx := context.Background()
+
+	// the writing instance updates its own index and sends the change
+	Default = NewIndex()
+	Put(ctx, Document{ID: 1, Name: "Notebook", Price: 9.9, Categories: []string{"Sticker"}})
+	Remove(ctx, 2)
+	if Default.Len() != 1 || len(sent) != 2 {
+		t.Fatalf("Len() = %d, sent %d updates, want 1 and 2", Default.Len(), len(sent))
+	}
+
+	// another instance applies it without reading MySQL
+	Default = NewIndex()
+	Default.Put(Document{ID: 2, Name: "Mouse-Pad"})
+	for _, data := range sent {
+		if err := Apply(data); err != nil {
+			t.Fatalf("Apply(%s): %v", data, err)
+		}
+	}
+	if got := ids(Default.Search(Query{Text: "notebook", Limit: 10}).Hits); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("hits after Apply = %v, want [1]", got)
+	}
+	if Default.Len() != 1 {
+		t.Errorf("Len() = %d after Apply, want the removed product gone", Default.Len())
+	}
+	if err := Apply([]byte(`{}`)); err == nil {
+		t.Error("Apply of an empty update succeeded")
+	}
+}
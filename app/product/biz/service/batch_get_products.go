@@ -0,0 +1,94 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+// maxBatchSize bounds the ids of one BatchGetProducts call.
+const maxBatchSize = 200
+
+type BatchGetProductsService struct {
+	ctx context.Context
+} // NewBatchGetProductsService new BatchGetProductsService
+func NewBatchGetProductsService(ctx context.Context) *BatchGetProductsService {
+	return &BatchGetProductsService{ctx: ctx}
+}
+
+// Run returns the products with the given ids in the order asked for, read
+// through the cache, and lists the ids that do not exist.
+func (s *BatchGetProductsService) Run(req *product.BatchGetProductsReq) (resp *product.BatchGetProductsResp, err error) {
+	ids := uniqueIds(req.Ids)
+	if len(ids) > maxBatchSize {
+		return nil, kerrors.NewBizStatusError(40001, "too many product ids")
+	}
+	resp = &product.BatchGetProductsResp{}
+	if len(ids) == 0 {
+		return resp, nil
+	}
+
+	productIds := make([]int, len(ids))
+	for i, id := range ids {
+		productIds[i] = int(id)
+	}
+	products, err := model.NewCachedProductQuery(model.NewProductQuery(s.ctx, mysql.DB), redis.RedisClient).GetByIds(productIds)
+	if err != nil {
+		return nil, err
+	}
+	resp.Products, resp.MissingIds = orderProducts(ids, products)
+	if err = setDisplayPrices(s.ctx, req.Currency, resp.Products...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// uniqueIds drops zero and repeated ids, keeping the first occurrence.
+func uniqueIds(ids []uint32) []uint32 {
+	seen := make(map[uint32]bool, len(ids))
+	out := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+// orderProducts lines products up with the requested ids and reports the ids
+// that were not found.
+func orderProducts(ids []uint32, products []model.Product) (found []*product.Product, missing []uint32) {
+	byId := make(map[uint32]model.Product, len(products))
+	for _, p := range products {
+		byId[uint32(p.ID)] = p
+	}
+	for _, id := range ids {
+		p, ok := byId[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		found = append(found, toProduct(p))
+	}
+	return found, missing
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestBatchGetProducts_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewBatchGetProductsService(ctx)
+	// init req and assert value
+
+	req := &product.BatchGetProductsReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestOrderProducts(t *testing.T) {
+	ids := uniqueIds([]uint32{3, 0, 1, 3, 7})
+	if len(ids) != 3 || ids[0] != 3 || ids[1] != 1 || ids[2] != 7 {
+		t.Fatalf("uniqueIds = %v", ids)
+	}
+	found, missing := orderProducts(ids, []model.Product{
+		{Base: model.Base{ID: 1}, Name: "Notebook"},
+		{Base: model.Base{ID: 3}, Name: "T-Shirt"},
+	})
+	if len(found) != 2 || found[0].Id != 3 || found[1].Id != 1 {
+		t.Fatalf("found = %v", found)
+	}
+	if len(missing) != 1 || missing[0] != 7 {
+		t.Fatalf("missing = %v", missing)
+	}
+}
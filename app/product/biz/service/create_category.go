@@ -0,0 +1,123 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type CreateCategoryService struct {
+	ctx context.Context
+} // NewCreateCategoryService new CreateCategoryService
+func NewCreateCategoryService(ctx context.Context) *CreateCategoryService {
+	return &CreateCategoryService{ctx: ctx}
+}
+
+// Run creates a category below its parent, or at the top level. The slug
+// is derived from the name when none is given.
+func (s *CreateCategoryService) Run(req *product.CreateCategoryReq) (resp *product.CreateCategoryResp, err error) {
+	c := model.Category{
+		ParentId:    int(req.ParentId),
+		Name:        strings.TrimSpace(req.Name),
+		Slug:        req.Slug,
+		Description: req.Description,
+		SortOrder:   int(req.SortOrder),
+	}
+	if err = validateCategory(s.ctx, &c); err != nil {
+		return nil, err
+	}
+	if err = mysql.DB.WithContext(s.ctx).Create(&c).Error; err != nil {
+		return nil, slugError(c.Slug, err)
+	}
+	return &product.CreateCategoryResp{Category: toCategory(c)}, nil
+}
+
+// validateCategory checks the name, fills in a missing slug and makes sure
+// the slug is free and the parent exists.
+func validateCategory(ctx context.Context, c *model.Category) error {
+	if c.Name == "" {
+		return kerrors.NewBizStatusError(40000, "category name is required")
+	}
+	if c.Slug == "" {
+		// names without latin letters or digits, e.g. "贴纸", have nothing
+		// to derive a slug from
+		if c.Slug = slugify(c.Name); c.Slug == "" {
+			return kerrors.NewBizStatusError(40000, "slug is required for a name without latin letters or digits")
+		}
+	}
+	if !slugPattern.MatchString(c.Slug) {
+		return kerrors.NewBizStatusError(40001, "slug may only contain lower case letters, digits and hyphens")
+	}
+	taken, err := model.SlugTaken(mysql.DB, ctx, c.Slug, c.ID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errSlugTaken(c.Slug)
+	}
+	if c.ParentId != 0 {
+		if _, err := model.GetCategoryById(mysql.DB, ctx, c.ParentId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return kerrors.NewBizStatusError(40004, "parent category not found")
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func errSlugTaken(slug string) error {
+	return kerrors.NewBizStatusError(40009, "slug "+slug+" is already used")
+}
+
+// slugError reports a category saved concurrently with the same slug, which
+// SlugTaken could not see, as the slug being taken.
+func slugError(slug string, err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return errSlugTaken(slug)
+	}
+	return err
+}
+
+// slugify lower-cases name and joins its ascii words with hyphens.
+func slugify(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+	return strings.Join(words, "-")
+}
+
+func toCategory(c model.Category) *product.Category {
+	return &product.Category{
+		Id:          uint32(c.ID),
+		ParentId:    uint32(c.ParentId),
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+		SortOrder:   int32(c.SortOrder),
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+func TestCreateCategory_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewCreateCategoryService(ctx)
+	// init req and assert value
+
+	req := &product.CreateCategoryReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestCategorySlugErrors(t *testing.T) {
+	bizErr, ok := kerrors.FromBizStatusError(slugError("t-shirt", fmt.Errorf("create category: %w", gorm.ErrDuplicatedKey)))
+	if !ok || bizErr.BizStatusCode() != 40009 {
+		t.Errorf("slugError(duplicate key) = %v, want 40009", bizErr)
+	}
+	if err := slugError("t-shirt", gorm.ErrInvalidData); err != gorm.ErrInvalidData {
+		t.Errorf("slugError(other) = %v, want it unchanged", err)
+	}
+	// a name without latin letters or digits needs an explicit slug, checked
+	// before the database is looked at
+	c := model.Category{Name: "贴纸"}
+	bizErr, ok = kerrors.FromBizStatusError(validateCategory(context.Background(), &c))
+	if !ok || bizErr.BizStatusCode() != 40000 {
+		t.Errorf("validateCategory(%q) = %v, want 40000", c.Name, bizErr)
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	for name, want := range map[string]string{
+		"T-Shirt":           "t-shirt",
+		"  Long Sleeve Tee": "long-sleeve-tee",
+		"Stickers & Decals": "stickers-decals",
+		"贴纸":                "",
+	} {
+		if got := slugify(name); got != want {
+			t.Errorf("slugify(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
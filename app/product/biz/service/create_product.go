@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
 	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
 	"gorm.io/gorm"
 )
 
@@ -21,6 +24,9 @@ func NewCreateProductService(ctx context.Context) *CreateProductService {
 
 // Run create note info
 func (s *CreateProductService) Run(req *product.CreateProductReq) (resp *product.CreateProductResp, err error) {
+	if err = validateSkus(req.Skus); err != nil {
+		return nil, err
+	}
 	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
 		// 查询数据库中的 Categories
 		var existingCategories []model.Category
@@ -42,6 +48,14 @@ func (s *CreateProductService) Run(req *product.CreateProductReq) (resp *product
 			Picture:     req.Picture,
 			Price:       req.Price,
 			Categories:  existingCategories, // 只关联已存在的 categories
+			Skus:        toSkuModels(req.Skus),
+		}
+		for i := range newProduct.Skus {
+			newProduct.Skus[i].ID = 0
+		}
+		// 没有规格的商品使用一个默认 SKU
+		if len(newProduct.Skus) == 0 {
+			newProduct.Skus = []model.Sku{{Price: req.Price, Picture: req.Picture, Stock: req.Stock}}
 		}
 
 		// 创建 Product
@@ -50,25 +64,23 @@ func (s *CreateProductService) Run(req *product.CreateProductReq) (resp *product
 		}
 
 		// 组装响应
-		var categoryNames []string
-		for _, category := range existingCategories {
-			categoryNames = append(categoryNames, category.Name)
-		}
-
-		resp = &product.CreateProductResp{
-			Product: &product.Product{
-				Id:          uint32(newProduct.ID),
-				Name:        newProduct.Name,
-				Description: newProduct.Description,
-				Picture:     newProduct.Picture,
-				Price:       newProduct.Price,
-				Categories:  categoryNames,
-			},
-		}
+		resp = &product.CreateProductResp{Product: toProduct(*newProduct)}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	// 清除该 id 此前缓存的“商品不存在”
+	if err := model.NewCachedProductQuery(model.NewProductQuery(s.ctx, mysql.DB), redis.RedisClient).Invalidate(int(resp.Product.Id)); err != nil {
+		klog.CtxWarnf(s.ctx, "invalidate product %d cache: %v", resp.Product.Id, err)
+	}
+	search.Put(s.ctx, search.Document{
+		ID:          int(resp.Product.Id),
+		Name:        resp.Product.Name,
+		Description: resp.Product.Description,
+		Picture:     resp.Product.Picture,
+		Price:       resp.Product.Price,
+		Categories:  resp.Product.Categories,
+	})
 	return resp, nil
 }
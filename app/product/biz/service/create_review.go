@@ -0,0 +1,140 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
+	"github.com/cloudwego/biz-demo/gomall/app/product/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/order"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+const (
+	maxReviewTitle = 128
+	maxReviewBody  = 4000
+)
+
+type CreateReviewService struct {
+	ctx context.Context
+} // NewCreateReviewService new CreateReviewService
+func NewCreateReviewService(ctx context.Context) *CreateReviewService {
+	return &CreateReviewService{ctx: ctx}
+}
+
+// Run publishes a user's review of a product, one per user and product,
+// marked as a verified purchase when the user has paid for the product, and
+// refreshes the product's rating.
+func (s *CreateReviewService) Run(req *product.CreateReviewReq) (resp *product.CreateReviewResp, err error) {
+	review := model.Review{
+		ProductId: int(req.ProductId),
+		UserId:    req.UserId,
+		Rating:    req.Rating,
+		Title:     strings.TrimSpace(req.Title),
+		Body:      strings.TrimSpace(req.Body),
+		Status:    model.ReviewApproved,
+	}
+	if err = validateReview(review); err != nil {
+		return nil, err
+	}
+	if _, err = model.GetProductById(mysql.DB, s.ctx, review.ProductId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "product not found")
+		}
+		return nil, err
+	}
+	exists, err := model.ReviewExists(mysql.DB, s.ctx, review.ProductId, review.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, kerrors.NewBizStatusError(40009, "product already reviewed")
+	}
+
+	// an unreachable order service costs the badge, not the review
+	purchased, err := rpc.OrderClient.HasPurchased(s.ctx, &order.HasPurchasedReq{UserId: req.UserId, ProductId: req.ProductId})
+	if err != nil {
+		klog.CtxWarnf(s.ctx, "check purchase of product %d by user %d: %v", req.ProductId, req.UserId, err)
+	} else {
+		review.VerifiedPurchase = purchased.Purchased
+	}
+
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&review).Error; err != nil {
+			return err
+		}
+		return model.RefreshRating(tx, s.ctx, review.ProductId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	SyncProduct(s.ctx, review.ProductId)
+	return &product.CreateReviewResp{Review: toReview(review)}, nil
+}
+
+func validateReview(r model.Review) error {
+	if r.ProductId == 0 || r.UserId == 0 {
+		return kerrors.NewBizStatusError(40000, "product id and user id are required")
+	}
+	if r.Rating < 1 || r.Rating > 5 {
+		return kerrors.NewBizStatusError(40001, "rating must be between 1 and 5")
+	}
+	if r.Title == "" && r.Body == "" {
+		return kerrors.NewBizStatusError(40000, "review title or body is required")
+	}
+	if utf8.RuneCountInString(r.Title) > maxReviewTitle || utf8.RuneCountInString(r.Body) > maxReviewBody {
+		return kerrors.NewBizStatusError(40001, "review is too long")
+	}
+	return nil
+}
+
+// SyncProduct publishes a changed product, e.g. a new rating, image or
+// price, to the cache and the search index.
+func SyncProduct(ctx context.Context, productId int) {
+	cache := model.NewCachedProductQuery(model.NewProductQuery(ctx, mysql.DB), redis.RedisClient)
+	if err := cache.Invalidate(productId); err != nil {
+		klog.CtxWarnf(ctx, "invalidate product %d cache: %v", productId, err)
+	}
+	p, err := cache.GetById(productId)
+	if err != nil {
+		klog.CtxWarnf(ctx, "reload product %d: %v", productId, err)
+		return
+	}
+	search.Put(ctx, search.FromProduct(p))
+}
+
+func toReview(r model.Review) *product.Review {
+	return &product.Review{
+		Id:               uint32(r.ID),
+		ProductId:        uint32(r.ProductId),
+		UserId:           r.UserId,
+		Rating:           r.Rating,
+		Title:            r.Title,
+		Body:             r.Body,
+		VerifiedPurchase: r.VerifiedPurchase,
+		Status:           r.Status,
+		CreatedAt:        r.CreatedAt.Unix(),
+	}
+}
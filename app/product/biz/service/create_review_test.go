@@ -0,0 +1,59 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestCreateReview_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewCreateReviewService(ctx)
+	// init req and assert value
+
+	req := &product.CreateReviewReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestValidateReview(t *testing.T) {
+	ok := model.Review{ProductId: 1, UserId: 2, Rating: 5, Title: "Great"}
+	if err := validateReview(ok); err != nil {
+		t.Fatalf("valid review rejected: %v", err)
+	}
+	for name, r := range map[string]model.Review{
+		"no product": {UserId: 2, Rating: 5, Title: "Great"},
+		"no user":    {ProductId: 1, Rating: 5, Title: "Great"},
+		"rating 0":   {ProductId: 1, UserId: 2, Title: "Great"},
+		"rating 6":   {ProductId: 1, UserId: 2, Rating: 6, Title: "Great"},
+		"empty":      {ProductId: 1, UserId: 2, Rating: 3},
+		"long title": {ProductId: 1, UserId: 2, Rating: 3, Title: strings.Repeat("好", maxReviewTitle+1)},
+	} {
+		if err := validateReview(r); err == nil {
+			t.Errorf("%s: want error", name)
+		}
+	}
+	if !validReviewStatus(model.ReviewRejected) || validReviewStatus("deleted") {
+		t.Error("validReviewStatus")
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type DeleteCategoryService struct {
+	ctx context.Context
+} // NewDeleteCategoryService new DeleteCategoryService
+func NewDeleteCategoryService(ctx context.Context) *DeleteCategoryService {
+	return &DeleteCategoryService{ctx: ctx}
+}
+
+// Run deletes a category without subcategories, taking its products out of
+// it, and refreshes the cache and the search index of those products.
+func (s *DeleteCategoryService) Run(req *product.DeleteCategoryReq) (resp *product.DeleteCategoryResp, err error) {
+	if req.Id == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "category id is required")
+	}
+	var productIds []int
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
+		var c model.Category
+		if err := tx.First(&c, req.Id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return kerrors.NewBizStatusError(40004, "category not found")
+			}
+			return err
+		}
+		var children int64
+		if err := tx.Model(&model.Category{}).Where("parent_id = ?", c.ID).Count(&children).Error; err != nil {
+			return err
+		}
+		if children > 0 {
+			return kerrors.NewBizStatusError(40009, "category has subcategories")
+		}
+		ids, err := model.GetCategoryProductIds(tx, s.ctx, c.ID)
+		if err != nil {
+			return err
+		}
+		productIds = ids
+		if err := tx.Model(&c).Association("Products").Clear(); err != nil {
+			return err
+		}
+		return tx.Delete(&c).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	// cached products still list the category
+	invalidateProducts(s.ctx, productIds)
+	if err := search.RebuildAll(s.ctx); err != nil {
+		klog.CtxWarnf(s.ctx, "rebuild search index: %v", err)
+	}
+	return &product.DeleteCategoryResp{Success: true}, nil
+}
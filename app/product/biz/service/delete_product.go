@@ -2,7 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
 	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 )
 
 type DeleteProductService struct {
@@ -15,6 +24,48 @@ func NewDeleteProductService(ctx context.Context) *DeleteProductService {
 // Run create note info
 func (s *DeleteProductService) Run(req *product.DeleteProductReq) (resp *product.DeleteProductResp, err error) {
 	// Finish your business logic.
+	if req.Id == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+
+	var images []model.ProductImage
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
+		var p model.Product
+		if err := tx.First(&p, req.Id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return kerrors.NewBizStatusError(40004, "product not found")
+			}
+			return err
+		}
+		if err := tx.Model(&p).Association("Categories").Clear(); err != nil {
+			return err
+		}
+		skuIds := tx.Model(&model.Sku{}).Select("id").Where("product_id = ?", p.ID)
+		if err := tx.Where("sku_id IN (?)", skuIds).Delete(&model.SkuOption{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("product_id = ?", p.ID).Delete(&model.Sku{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("product_id = ?", p.ID).Find(&images).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("product_id = ?", p.ID).Delete(&model.ProductImage{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&p).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.NewCachedProductQuery(model.NewProductQuery(s.ctx, mysql.DB), redis.RedisClient).Invalidate(int(req.Id)); err != nil {
+		klog.CtxWarnf(s.ctx, "invalidate product %d cache: %v", req.Id, err)
+	}
+	search.Remove(s.ctx, int(req.Id))
+	for _, image := range images {
+		deleteBlobs(s.ctx, image.Key, image.ThumbnailKey)
+	}
 
-	return
+	return &product.DeleteProductResp{Success: true}, nil
 }
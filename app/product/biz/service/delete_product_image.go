@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type DeleteProductImageService struct {
+	ctx context.Context
+} // NewDeleteProductImageService new DeleteProductImageService
+func NewDeleteProductImageService(ctx context.Context) *DeleteProductImageService {
+	return &DeleteProductImageService{ctx: ctx}
+}
+
+// Run removes an image from its product's gallery and deletes its blobs.
+func (s *DeleteProductImageService) Run(req *product.DeleteProductImageReq) (resp *product.DeleteProductImageResp, err error) {
+	if req.Id == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "image id is required")
+	}
+	image, err := model.GetProductImage(mysql.DB, s.ctx, int(req.Id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "image not found")
+		}
+		return nil, err
+	}
+	if err = mysql.DB.WithContext(s.ctx).Delete(&image).Error; err != nil {
+		return nil, err
+	}
+	deleteBlobs(s.ctx, image.Key, image.ThumbnailKey)
+	SyncProduct(s.ctx, image.ProductId)
+	return &product.DeleteProductImageResp{Success: true}, nil
+}
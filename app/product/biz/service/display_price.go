@@ -0,0 +1,49 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// setDisplayPrices converts the prices of products into code for display.
+// When no exchange rate is available the prices are shown in the base
+// currency rather than failing the request.
+func setDisplayPrices(ctx context.Context, code string, products ...*product.Product) error {
+	if code == "" {
+		code = currency.Base
+	}
+	if !currency.Supported(code) {
+		return kerrors.NewBizStatusError(40001, "unsupported currency "+code)
+	}
+	rate, err := currency.Default.Rate(ctx, code)
+	if err != nil {
+		klog.CtxWarnf(ctx, "show prices in %s instead of %s: %v", currency.Base, code, err)
+		code, rate = currency.Base, 1
+	}
+	for _, p := range products {
+		p.Currency = code
+		p.DisplayPrice = currency.Convert(p.Price, rate, code)
+		for _, sku := range p.Skus {
+			sku.DisplayPrice = currency.Convert(sku.Price, rate, code)
+		}
+	}
+	return nil
+}
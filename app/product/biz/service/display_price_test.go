@@ -0,0 +1,45 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestSetDisplayPrices(t *testing.T) {
+	defer func(c *currency.Converter) { currency.Default = c }(currency.Default)
+	currency.Default = currency.NewConverter(currency.StaticSource{"EUR": 0.9}, time.Hour)
+	ctx := context.Background()
+
+	p := &product.Product{Price: 10, Skus: []*product.Sku{{Price: 12}}}
+	if err := setDisplayPrices(ctx, "EUR", p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Currency != "EUR" || p.DisplayPrice != 9 || p.Skus[0].DisplayPrice != 10.8 {
+		t.Errorf("EUR prices = %s %v %v", p.Currency, p.DisplayPrice, p.Skus[0].DisplayPrice)
+	}
+	// no rate for GBP, fall back to USD
+	if err := setDisplayPrices(ctx, "GBP", p); err != nil || p.Currency != "USD" || p.DisplayPrice != 10 {
+		t.Errorf("GBP prices = %s %v, %v", p.Currency, p.DisplayPrice, err)
+	}
+	if err := setDisplayPrices(ctx, "XYZ", p); err == nil {
+		t.Error("unsupported currency accepted")
+	}
+}
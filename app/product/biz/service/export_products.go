@@ -0,0 +1,88 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/catalog"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+const (
+	defaultExportLimit = 200
+	maxExportLimit     = 1000
+)
+
+type ExportProductsService struct {
+	ctx context.Context
+} // NewExportProductsService new ExportProductsService
+func NewExportProductsService(ctx context.Context) *ExportProductsService {
+	return &ExportProductsService{ctx: ctx}
+}
+
+// Run writes a page of products and their SKUs as CSV or JSON Lines, in id
+// order after AfterId; the next page starts after NextAfterId.
+func (s *ExportProductsService) Run(req *product.ExportProductsReq) (resp *product.ExportProductsResp, err error) {
+	if !catalog.ValidFormat(req.Format) {
+		return nil, kerrors.NewBizStatusError(40001, "format must be csv or jsonl")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultExportLimit
+	}
+	if limit > maxExportLimit {
+		limit = maxExportLimit
+	}
+
+	var products []model.Product
+	err = mysql.DB.WithContext(s.ctx).Where("id > ?", req.AfterId).Order("id").Limit(limit).
+		Preload("Categories").Preload("Skus.Options").Find(&products).Error
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = catalog.Write(req.Format, &buf, exportRows(products), req.AfterId == 0); err != nil {
+		return nil, err
+	}
+	resp = &product.ExportProductsResp{Data: buf.Bytes()}
+	if len(products) == limit {
+		resp.NextAfterId = uint32(products[len(products)-1].ID)
+	}
+	return resp, nil
+}
+
+// exportRows flattens products into one row per sku.
+func exportRows(products []model.Product) (rows []catalog.Row) {
+	for _, p := range products {
+		base := catalog.Row{ProductId: p.ID, Name: p.Name, Description: p.Description, Picture: p.Picture}
+		for _, c := range p.Categories {
+			base.Categories = append(base.Categories, c.Name)
+		}
+		for _, sku := range p.Skus {
+			row := base
+			row.SkuCode, row.Price, row.Stock, row.SkuPicture = sku.Code, sku.Price, sku.Stock, sku.Picture
+			for _, o := range sku.Options {
+				row.Options = append(row.Options, catalog.Option{Name: o.Name, Value: o.Value})
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
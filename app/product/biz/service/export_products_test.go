@@ -0,0 +1,51 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestExportProducts_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewExportProductsService(ctx)
+	// init req and assert value
+
+	req := &product.ExportProductsReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestExportRows(t *testing.T) {
+	rows := exportRows([]model.Product{{
+		Base:       model.Base{ID: 3},
+		Name:       "T-Shirt",
+		Categories: []model.Category{{Name: "T-Shirt"}},
+		Skus: []model.Sku{
+			{Code: "T-SHIRT-F-S", Price: 2.2, Stock: 5, Options: []model.SkuOption{{Name: "Size", Value: "S"}}},
+			{Code: "T-SHIRT-F-M", Price: 2.2, Stock: 0, Options: []model.SkuOption{{Name: "Size", Value: "M"}}},
+		},
+	}})
+	if len(rows) != 2 || rows[1].ProductId != 3 || rows[1].SkuCode != "T-SHIRT-F-M" || rows[1].Options[0].Value != "M" || rows[0].Categories[0] != "T-Shirt" {
+		t.Errorf("rows = %+v", rows)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"path"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/infra/blob"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type GetImageService struct {
+	ctx context.Context
+} // NewGetImageService new GetImageService
+func NewGetImageService(ctx context.Context) *GetImageService {
+	return &GetImageService{ctx: ctx}
+}
+
+// Run returns an image or thumbnail from the blob store by its key.
+func (s *GetImageService) Run(req *product.GetImageReq) (resp *product.GetImageResp, err error) {
+	if req.Key == "" {
+		return nil, kerrors.NewBizStatusError(40000, "image key is required")
+	}
+	if !blob.ValidKey(req.Key) {
+		return nil, kerrors.NewBizStatusError(40001, "invalid image key")
+	}
+	data, err := blob.Default.Get(s.ctx, req.Key)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "image not found")
+		}
+		return nil, err
+	}
+	contentType := mime.TypeByExtension(path.Ext(req.Key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return &product.GetImageResp{Data: data, ContentType: contentType}, nil
+}
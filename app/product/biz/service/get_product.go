@@ -16,6 +16,8 @@ package service
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
@@ -42,13 +44,87 @@ func (s *GetProductService) Run(req *product.GetProductReq) (resp *product.GetPr
 	if err != nil {
 		return nil, err
 	}
-	return &product.GetProductResp{
-		Product: &product.Product{
-			Id:          uint32(p.ID),
-			Picture:     p.Picture,
-			Price:       p.Price,
-			Description: p.Description,
-			Name:        p.Name,
-		},
-	}, err
+	resp = &product.GetProductResp{Product: toProduct(p)}
+	if err = setDisplayPrices(s.ctx, req.Currency, resp.Product); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func toProduct(p model.Product) *product.Product {
+	resp := &product.Product{
+		Id:          uint32(p.ID),
+		Picture:     p.CoverPicture(),
+		Price:       p.Price,
+		Description: p.Description,
+		Name:        p.Name,
+		Rating:      p.Rating,
+		ReviewCount: p.ReviewCount,
+	}
+	for _, c := range p.Categories {
+		resp.Categories = append(resp.Categories, c.Name)
+	}
+	axes := make(map[string]*product.OptionAxis)
+	for _, sku := range p.Skus {
+		v := &product.Sku{
+			Id:        uint32(sku.ID),
+			ProductId: uint32(sku.ProductId),
+			Code:      sku.Code,
+			Price:     sku.Price,
+			Picture:   sku.Picture,
+			Stock:     sku.Stock,
+			Weight:    sku.Weight,
+		}
+		for _, o := range sku.Options {
+			v.Options = append(v.Options, &product.SkuOption{Name: o.Name, Value: o.Value})
+			axis, ok := axes[o.Name]
+			if !ok {
+				axis = &product.OptionAxis{Name: o.Name}
+				axes[o.Name] = axis
+				resp.Options = append(resp.Options, axis)
+			}
+			if !slices.Contains(axis.Values, o.Value) {
+				axis.Values = append(axis.Values, o.Value)
+			}
+		}
+		resp.Skus = append(resp.Skus, v)
+	}
+	for _, image := range p.Images {
+		resp.Images = append(resp.Images, toProductImage(image))
+	}
+	return resp
+}
+
+func toSkuModels(skus []*product.Sku) (out []model.Sku) {
+	for _, v := range skus {
+		sku := model.Sku{Base: model.Base{ID: int(v.Id)}, Code: v.Code, Price: v.Price, Picture: v.Picture, Stock: v.Stock, Weight: v.Weight}
+		for _, o := range v.Options {
+			sku.Options = append(sku.Options, model.SkuOption{Name: o.Name, Value: o.Value})
+		}
+		out = append(out, sku)
+	}
+	return out
+}
+
+func validateSkus(skus []*product.Sku) error {
+	seen := make(map[string]bool)
+	for _, v := range skus {
+		if v.Price < 0 || v.Stock < 0 || v.Weight < 0 {
+			return kerrors.NewBizStatusError(40001, "sku price, stock and weight must not be negative")
+		}
+		var key []string
+		for _, o := range v.Options {
+			if o.Name == "" || o.Value == "" {
+				return kerrors.NewBizStatusError(40001, "sku option name and value are required")
+			}
+			key = append(key, o.Name+"="+o.Value)
+		}
+		slices.Sort(key)
+		k := strings.Join(key, ";")
+		if seen[k] {
+			return kerrors.NewBizStatusError(40001, "duplicate sku options "+k)
+		}
+		seen[k] = true
+	}
+	return nil
 }
@@ -15,7 +15,11 @@
 package service
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 )
 
 func TestGetProduct_Run(t *testing.T) {
@@ -33,3 +37,27 @@ func TestGetProduct_Run(t *testing.T) {
 	// }
 	// // todo: edit your unit test
 }
+
+func TestToProduct_Options(t *testing.T) {
+	sku := func(id int, print, size string) model.Sku {
+		return model.Sku{Base: model.Base{ID: id}, Options: []model.SkuOption{{Name: "Print", Value: print}, {Name: "Size", Value: size}}}
+	}
+	p := toProduct(model.Product{Skus: []model.Sku{sku(1, "Front", "S"), sku(2, "Front", "M"), sku(3, "Back", "S")}})
+	want := []*product.OptionAxis{{Name: "Print", Values: []string{"Front", "Back"}}, {Name: "Size", Values: []string{"S", "M"}}}
+	if len(p.Skus) != 3 || !reflect.DeepEqual(p.Options, want) {
+		t.Errorf("options = %v, want %v", p.Options, want)
+	}
+}
+
+func TestValidateSkus(t *testing.T) {
+	dup := []*product.Sku{
+		{Options: []*product.SkuOption{{Name: "Size", Value: "S"}, {Name: "Print", Value: "Front"}}},
+		{Options: []*product.SkuOption{{Name: "Print", Value: "Front"}, {Name: "Size", Value: "S"}}},
+	}
+	if err := validateSkus(dup); err == nil {
+		t.Error("expected duplicate options to be rejected")
+	}
+	if err := validateSkus([]*product.Sku{{Price: -1}}); err == nil {
+		t.Error("expected negative price to be rejected")
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/catalog"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+// maxImportRows bounds the rows of one ImportProducts call.
+const maxImportRows = 1000
+
+// errDryRun rolls back the import transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+type ImportProductsService struct {
+	ctx context.Context
+} // NewImportProductsService new ImportProductsService
+func NewImportProductsService(ctx context.Context) *ImportProductsService {
+	return &ImportProductsService{ctx: ctx}
+}
+
+// Run upserts the products and SKUs of a CSV or JSON Lines file in one
+// transaction, matching SKUs by code. Rows that can not be imported are
+// reported by their row number and skipped; a dry run reports them without
+// writing anything.
+func (s *ImportProductsService) Run(req *product.ImportProductsReq) (resp *product.ImportProductsResp, err error) {
+	if !catalog.ValidFormat(req.Format) {
+		return nil, kerrors.NewBizStatusError(40001, "format must be csv or jsonl")
+	}
+	rows, rowErrs, err := catalog.Read(req.Format, req.Data)
+	if err != nil {
+		return nil, kerrors.NewBizStatusError(40001, err.Error())
+	}
+	if len(rows)+len(rowErrs) > maxImportRows {
+		return nil, kerrors.NewBizStatusError(40001, fmt.Sprintf("at most %d rows per request", maxImportRows))
+	}
+	rows, rowErrs = validateImportRows(rows, rowErrs)
+
+	resp = &product.ImportProductsResp{}
+	var imp *importer
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
+		imp = &importer{ctx: s.ctx, tx: tx, resp: resp}
+		if err := imp.run(rows); err != nil {
+			return err
+		}
+		if req.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	rowErrs = append(rowErrs, imp.rowErrs...)
+
+	// report rows by their number in the whole file
+	offset := max(req.FirstRow, 1) - 1
+	sort.Slice(rowErrs, func(i, j int) bool { return rowErrs[i].Line < rowErrs[j].Line })
+	for _, e := range rowErrs {
+		resp.Errors = append(resp.Errors, &product.ImportRowError{Row: int32(e.Line) + offset, SkuCode: e.SkuCode, Message: e.Message})
+	}
+	if !req.DryRun {
+		syncImported(s.ctx, imp.touched)
+	}
+	return resp, nil
+}
+
+// validateImportRows drops the rows that cannot be imported whatever the
+// catalogue holds.
+func validateImportRows(rows []catalog.Row, rowErrs []catalog.RowError) ([]catalog.Row, []catalog.RowError) {
+	valid := rows[:0]
+	seen := make(map[string]int)
+	for _, r := range rows {
+		var msg string
+		switch {
+		case r.SkuCode == "":
+			msg = "sku_code is required"
+		case r.Name == "":
+			msg = "name is required"
+		case r.Price < 0 || r.Stock < 0:
+			msg = "price and stock must not be negative"
+		case seen[r.SkuCode] != 0:
+			msg = fmt.Sprintf("duplicate sku_code, first used in row %d", seen[r.SkuCode])
+		}
+		if msg != "" {
+			rowErrs = append(rowErrs, catalog.RowError{Line: r.Line, SkuCode: r.SkuCode, Message: msg})
+			continue
+		}
+		seen[r.SkuCode] = r.Line
+		valid = append(valid, r)
+	}
+	return valid, rowErrs
+}
+
+// importer applies import rows inside one transaction.
+type importer struct {
+	ctx     context.Context
+	tx      *gorm.DB
+	resp    *product.ImportProductsResp
+	rowErrs []catalog.RowError
+	touched []int
+}
+
+// importGroup is a product and the rows of its skus.
+type importGroup struct {
+	product *model.Product
+	rows    []catalog.Row
+	skus    []*model.Sku
+}
+
+func (imp *importer) rowError(r catalog.Row, format string, args ...any) {
+	imp.rowErrs = append(imp.rowErrs, catalog.RowError{Line: r.Line, SkuCode: r.SkuCode, Message: fmt.Sprintf(format, args...)})
+}
+
+func (imp *importer) run(rows []catalog.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	groups, err := imp.plan(rows)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if err := imp.apply(g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// plan resolves the product of every row: the product of the existing sku
+// with that code, else the product_id of the row, else the product with the
+// row name, which is created when there is none.
+func (imp *importer) plan(rows []catalog.Row) ([]*importGroup, error) {
+	codes := make([]string, 0, len(rows))
+	productIds := make([]int, 0, len(rows))
+	var names []string
+	for _, r := range rows {
+		codes = append(codes, r.SkuCode)
+		if r.ProductId != 0 {
+			productIds = append(productIds, r.ProductId)
+		}
+		names = append(names, r.Name)
+	}
+
+	var existing []model.Sku
+	if err := imp.tx.Where("code IN ?", codes).Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	skus := make(map[string][]*model.Sku)
+	for i := range existing {
+		sku := &existing[i]
+		skus[sku.Code] = append(skus[sku.Code], sku)
+		productIds = append(productIds, sku.ProductId)
+	}
+	var loaded []model.Product
+	if err := imp.tx.Where("id IN ? OR name IN ?", productIds, names).Order("id").Find(&loaded).Error; err != nil {
+		return nil, err
+	}
+	byId := make(map[int]*model.Product, len(loaded))
+	byName := make(map[string]*model.Product)
+	for i := range loaded {
+		p := &loaded[i]
+		byId[p.ID] = p
+		if _, ok := byName[p.Name]; !ok {
+			byName[p.Name] = p
+		}
+	}
+	categories, err := model.GetCategories(imp.tx, imp.ctx)
+	if err != nil {
+		return nil, err
+	}
+	categoryNames := make(map[string]bool, len(categories))
+	for _, c := range categories {
+		categoryNames[c.Name] = true
+	}
+
+	var groups []*importGroup
+	byProduct := make(map[*model.Product]*importGroup)
+	for _, r := range rows {
+		if unknown := slices.IndexFunc(r.Categories, func(c string) bool { return !categoryNames[c] }); unknown >= 0 {
+			imp.rowError(r, "unknown category %q", r.Categories[unknown])
+			continue
+		}
+		var p *model.Product
+		var sku *model.Sku
+		switch matches := skus[r.SkuCode]; {
+		case len(matches) > 1:
+			imp.rowError(r, "sku_code is used by %d skus", len(matches))
+			continue
+		case len(matches) == 1:
+			sku = matches[0]
+			if r.ProductId != 0 && r.ProductId != sku.ProductId {
+				imp.rowError(r, "sku belongs to product %d", sku.ProductId)
+				continue
+			}
+			p = byId[sku.ProductId]
+		case r.ProductId != 0:
+			if p = byId[r.ProductId]; p == nil {
+				imp.rowError(r, "product %d not found", r.ProductId)
+				continue
+			}
+		default:
+			if p = byName[r.Name]; p == nil {
+				p = &model.Product{Name: r.Name}
+				byName[r.Name] = p
+			}
+		}
+		g := byProduct[p]
+		if g == nil {
+			g = &importGroup{product: p}
+			byProduct[p] = g
+			groups = append(groups, g)
+		}
+		g.rows = append(g.rows, r)
+		g.skus = append(g.skus, sku)
+	}
+	return groups, nil
+}
+
+// apply upserts a product and its skus; the product fields come from its
+// last row and its price becomes the lowest sku price.
+func (imp *importer) apply(g *importGroup) error {
+	tx, p := imp.tx, g.product
+	last := g.rows[len(g.rows)-1]
+	var categories []model.Category
+	if len(last.Categories) > 0 {
+		if err := tx.Where("name IN ?", last.Categories).Find(&categories).Error; err != nil {
+			return err
+		}
+	}
+	p.Name, p.Description, p.Picture = last.Name, last.Description, last.Picture
+	if p.ID == 0 {
+		p.Categories = categories
+		if err := tx.Omit("Skus").Create(p).Error; err != nil {
+			return err
+		}
+		imp.resp.ProductsCreated++
+	} else {
+		if err := tx.Model(p).Select("Name", "Description", "Picture").Updates(p).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(p).Association("Categories").Replace(categories); err != nil {
+			return err
+		}
+		imp.resp.ProductsUpdated++
+	}
+
+	for i, r := range g.rows {
+		options := make([]model.SkuOption, 0, len(r.Options))
+		for _, o := range r.Options {
+			options = append(options, model.SkuOption{Name: o.Name, Value: o.Value})
+		}
+		if sku := g.skus[i]; sku != nil {
+			oldPrice := sku.Price
+			if err := tx.Model(sku).Updates(map[string]any{"price": r.Price, "stock": r.Stock, "picture": r.SkuPicture}).Error; err != nil {
+				return err
+			}
+			if oldPrice != r.Price {
+				if err := model.RecordPriceChange(tx, imp.ctx, p.ID, sku.ID, oldPrice, r.Price, "import"); err != nil {
+					return err
+				}
+			}
+			if err := tx.Where("sku_id = ?", sku.ID).Delete(&model.SkuOption{}).Error; err != nil {
+				return err
+			}
+			for j := range options {
+				options[j].SkuId = sku.ID
+			}
+			if len(options) > 0 {
+				if err := tx.Create(&options).Error; err != nil {
+					return err
+				}
+			}
+			imp.resp.SkusUpdated++
+			continue
+		}
+		sku := model.Sku{ProductId: p.ID, Code: r.SkuCode, Price: r.Price, Stock: r.Stock, Picture: r.SkuPicture, Options: options}
+		if err := tx.Create(&sku).Error; err != nil {
+			return err
+		}
+		imp.resp.SkusCreated++
+	}
+
+	if err := model.RefreshProductPrice(tx, imp.ctx, p.ID); err != nil {
+		return err
+	}
+	imp.touched = append(imp.touched, p.ID)
+	return nil
+}
+
+// syncImported refreshes the cache and search index entries of imported
+// products.
+func syncImported(ctx context.Context, productIds []int) {
+	if len(productIds) == 0 {
+		return
+	}
+	cache := model.NewCachedProductQuery(model.NewProductQuery(ctx, mysql.DB), redis.RedisClient)
+	for _, id := range productIds {
+		if err := cache.Invalidate(id); err != nil {
+			klog.CtxWarnf(ctx, "invalidate product %d cache: %v", id, err)
+		}
+	}
+	products, err := model.NewProductQuery(ctx, mysql.DB).GetByIds(productIds)
+	if err != nil {
+		klog.CtxWarnf(ctx, "reload imported products: %v", err)
+		return
+	}
+	for _, p := range products {
+		search.Put(ctx, search.FromProduct(p))
+	}
+}
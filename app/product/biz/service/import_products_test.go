@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/catalog"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestImportProducts_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewImportProductsService(ctx)
+	// init req and assert value
+
+	req := &product.ImportProductsReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestValidateImportRows(t *testing.T) {
+	rows, rowErrs := validateImportRows([]catalog.Row{
+		{Line: 1, Name: "Notebook", SkuCode: "A", Price: 1},
+		{Line: 2, Name: "Notebook", Price: 1},
+		{Line: 3, SkuCode: "B", Price: 1},
+		{Line: 4, Name: "Notebook", SkuCode: "C", Price: -1},
+		{Line: 5, Name: "Notebook", SkuCode: "A", Price: 1},
+		{Line: 6, Name: "Pad", SkuCode: "D", Price: 2, Stock: 3},
+	}, []catalog.RowError{{Line: 7, Message: "invalid json"}})
+	if len(rows) != 2 || rows[0].SkuCode != "A" || rows[1].SkuCode != "D" {
+		t.Errorf("rows = %+v", rows)
+	}
+	var lines []int
+	for _, e := range rowErrs {
+		lines = append(lines, e.Line)
+	}
+	if want := []int{7, 2, 3, 4, 5}; fmt.Sprint(lines) != fmt.Sprint(want) {
+		t.Errorf("error rows = %v, want %v", lines, want)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+type ListCategoryTreeService struct {
+	ctx context.Context
+} // NewListCategoryTreeService new ListCategoryTreeService
+func NewListCategoryTreeService(ctx context.Context) *ListCategoryTreeService {
+	return &ListCategoryTreeService{ctx: ctx}
+}
+
+// Run returns every category nested under its parent, siblings in display
+// order.
+func (s *ListCategoryTreeService) Run(req *product.ListCategoryTreeReq) (resp *product.ListCategoryTreeResp, err error) {
+	categories, err := model.GetCategories(mysql.DB, s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &product.ListCategoryTreeResp{Roots: categoryTree(categories)}, nil
+}
+
+// categoryTree nests categories under their parents, keeping the given
+// order among siblings. Categories whose parent is missing become roots.
+func categoryTree(categories []model.Category) (roots []*product.Category) {
+	nodes := make(map[int]*product.Category, len(categories))
+	for _, c := range categories {
+		nodes[c.ID] = toCategory(c)
+	}
+	for _, c := range categories {
+		node := nodes[c.ID]
+		if parent, ok := nodes[c.ParentId]; ok && c.ParentId != c.ID {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
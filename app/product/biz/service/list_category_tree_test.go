@@ -0,0 +1,55 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestListCategoryTree_Run(t *testing.T) {
+	if mysql.DB == nil {
+		t.Skip("needs a mysql connection")
+	}
+	ctx := context.Background()
+	s := NewListCategoryTreeService(ctx)
+	// init req and assert value
+
+	req := &product.ListCategoryTreeReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestCategoryTree(t *testing.T) {
+	roots := categoryTree([]model.Category{
+		{Base: model.Base{ID: 1}, Name: "Apparel"},
+		{Base: model.Base{ID: 4}, Name: "Sticker"},
+		{Base: model.Base{ID: 2}, ParentId: 1, Name: "T-Shirt"},
+		{Base: model.Base{ID: 5}, ParentId: 9, Name: "Orphan"},
+	})
+	if len(roots) != 3 || roots[0].Name != "Apparel" || roots[1].Name != "Sticker" || roots[2].Name != "Orphan" {
+		t.Fatalf("roots = %v", roots)
+	}
+	if len(roots[0].Children) != 1 || roots[0].Children[0].Name != "T-Shirt" {
+		t.Errorf("children = %v", roots[0].Children)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+const (
+	defaultPriceHistoryPageSize = 20
+	maxPriceHistoryPageSize     = 100
+)
+
+type ListPriceHistoryService struct {
+	ctx context.Context
+} // NewListPriceHistoryService new ListPriceHistoryService
+func NewListPriceHistoryService(ctx context.Context) *ListPriceHistoryService {
+	return &ListPriceHistoryService{ctx: ctx}
+}
+
+// Run lists the price changes of a product, or one of its SKUs, one page at
+// a time.
+func (s *ListPriceHistoryService) Run(req *product.ListPriceHistoryReq) (resp *product.ListPriceHistoryResp, err error) {
+	if req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPriceHistoryPageSize
+	}
+	if pageSize > maxPriceHistoryPageSize {
+		pageSize = maxPriceHistoryPageSize
+	}
+
+	changes, total, err := model.ListPriceChanges(mysql.DB, s.ctx, int(req.ProductId), int(req.SkuId), int((page-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	resp = &product.ListPriceHistoryResp{Total: total}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, toPriceChange(c))
+	}
+	return resp, nil
+}
@@ -16,10 +16,18 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
 	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
 )
 
 type ListProductsService struct {
@@ -29,19 +37,114 @@ func NewListProductsService(ctx context.Context) *ListProductsService {
 	return &ListProductsService{ctx: ctx}
 }
 
-// Run create note info
+// Run lists the products of the requested categories and price range, in
+// the requested order, one page at a time by page number or by cursor.
 func (s *ListProductsService) Run(req *product.ListProductsReq) (resp *product.ListProductsResp, err error) {
-	// Finish your business logic.
-	c, err := model.GetProductsByCategoryName(mysql.DB, s.ctx, req.CategoryName)
+	switch req.Sort {
+	case "", model.SortPriceAsc, model.SortPriceDesc, model.SortName, model.SortNewest:
+	default:
+		return nil, kerrors.NewBizStatusError(40001, "unknown sort "+req.Sort)
+	}
+	if req.MinPrice < 0 || req.MaxPrice < 0 || (req.MaxPrice > 0 && req.MinPrice > req.MaxPrice) {
+		return nil, kerrors.NewBizStatusError(40001, "invalid price range")
+	}
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	filter := model.ProductFilter{
+		MinPrice: req.MinPrice,
+		MaxPrice: req.MaxPrice,
+		Sort:     req.Sort,
+		Offset:   int(int64(page-1) * pageSize),
+		// one extra row tells whether there is a next page
+		Limit: int(pageSize) + 1,
+	}
+	if names := categoryNames(req); len(names) > 0 {
+		categories, err := model.GetCategories(mysql.DB, s.ctx)
+		if err != nil {
+			return nil, err
+		}
+		filter.CategoryIds = model.CategoryIdsWithDescendants(categories, names)
+		if len(filter.CategoryIds) == 0 {
+			return &product.ListProductsResp{Page: page, PageSize: pageSize}, nil
+		}
+	}
+	if req.Cursor != "" {
+		after, err := decodeCursor(req.Cursor)
+		if err != nil || after.Sort != req.Sort {
+			return nil, kerrors.NewBizStatusError(40001, "invalid cursor")
+		}
+		filter.After = &after
+		page = 0
+	}
+
+	products, total, err := model.ListProducts(mysql.DB, s.ctx, filter)
 	if err != nil {
 		return nil, err
 	}
-	resp = &product.ListProductsResp{}
-	for _, v1 := range c {
-		for _, v := range v1.Products {
-			resp.Products = append(resp.Products, &product.Product{Id: uint32(v.ID), Name: v.Name, Description: v.Description, Picture: v.Picture, Price: v.Price})
-		}
+	resp = &product.ListProductsResp{Total: total, Page: page, PageSize: pageSize}
+	if int64(len(products)) > pageSize {
+		products = products[:pageSize]
+		resp.NextCursor = encodeCursor(model.NewProductCursor(req.Sort, products[len(products)-1]))
+	}
+	for _, v := range products {
+		resp.Products = append(resp.Products, listedProduct(v))
+	}
+	if err = setDisplayPrices(s.ctx, req.Currency, resp.Products...); err != nil {
+		return nil, err
 	}
 
 	return resp, nil
 }
+
+// listedProduct is the summary of a product shown in a list, with its rating
+// but without its SKUs and images.
+func listedProduct(v model.Product) *product.Product {
+	p := &product.Product{
+		Id:          uint32(v.ID),
+		Name:        v.Name,
+		Description: v.Description,
+		Picture:     v.CoverPicture(),
+		Price:       v.Price,
+		Rating:      v.Rating,
+		ReviewCount: v.ReviewCount,
+	}
+	for _, c := range v.Categories {
+		p.Categories = append(p.Categories, c.Name)
+	}
+	return p
+}
+
+func categoryNames(req *product.ListProductsReq) (names []string) {
+	seen := make(map[string]bool)
+	for _, name := range append([]string{req.CategoryName}, req.CategoryNames...) {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+func encodeCursor(c model.ProductCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (c model.ProductCursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(b, &c)
+	return c, err
+}
@@ -15,21 +15,67 @@
 package service
 
 import (
+	"context"
+	"reflect"
 	"testing"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
 )
 
 func TestListProducts_Run(t *testing.T) {
-	// ctx := context.Background()
-	// s := NewListProductsService(ctx)
-	// // init req and assert value
+	s := NewListProductsService(context.Background())
+	for _, req := range []*product.ListProductsReq{
+		{Sort: "cheapest"},
+		{MinPrice: 10, MaxPrice: 5},
+		{MinPrice: -1},
+		{Cursor: "not a cursor"},
+		{Sort: model.SortName, Cursor: encodeCursor(model.ProductCursor{ID: 3})},
+	} {
+		if _, err := s.Run(req); err == nil {
+			t.Errorf("Run(%v): expected error", req)
+		}
+	}
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	p := model.Product{Base: model.Base{ID: 7, CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}, Name: "Notebook", Price: 9.9}
+	for _, sort := range []string{"", model.SortPriceAsc, model.SortPriceDesc, model.SortName, model.SortNewest} {
+		want := model.NewProductCursor(sort, p)
+		got, err := decodeCursor(encodeCursor(want))
+		if err != nil {
+			t.Fatalf("decodeCursor(%q): %v", sort, err)
+		}
+		if !got.CreatedAt.Equal(want.CreatedAt) || got.Sort != want.Sort || got.ID != want.ID || got.Price != want.Price || got.Name != want.Name {
+			t.Errorf("sort %q: got %+v, want %+v", sort, got, want)
+		}
+	}
+}
+
+func TestListedProduct(t *testing.T) {
+	p := listedProduct(model.Product{
+		Base:        model.Base{ID: 7},
+		Name:        "Notebook",
+		Price:       9.9,
+		Rating:      4.5,
+		ReviewCount: 12,
+		Categories:  []model.Category{{Name: "stationery"}},
+	})
+	if p.Id != 7 || p.Name != "Notebook" || p.Price != 9.9 {
+		t.Errorf("listedProduct = %+v", p)
+	}
+	if p.Rating != 4.5 || p.ReviewCount != 12 {
+		t.Errorf("Rating, ReviewCount = %v, %v, want 4.5, 12", p.Rating, p.ReviewCount)
+	}
+	if !reflect.DeepEqual(p.Categories, []string{"stationery"}) {
+		t.Errorf("Categories = %v, want [stationery]", p.Categories)
+	}
+}
 
-	// req := &product.ListProductsReq{}
-	// resp, err := s.Run(req)
-	// if err != nil {
-	// 	t.Errorf("unexpected error: %v", err)
-	// }
-	// if resp == nil {
-	// 	t.Errorf("unexpected nil response")
-	// }
-	// // todo: edit your unit test
+func TestCategoryNames(t *testing.T) {
+	got := categoryNames(&product.ListProductsReq{CategoryName: "t-shirt", CategoryNames: []string{"", "sticker", "t-shirt"}})
+	if want := []string{"t-shirt", "sticker"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("categoryNames = %v, want %v", got, want)
+	}
 }
@@ -0,0 +1,79 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+const (
+	defaultReviewPageSize = 10
+	maxReviewPageSize     = 50
+)
+
+type ListReviewsService struct {
+	ctx context.Context
+} // NewListReviewsService new ListReviewsService
+func NewListReviewsService(ctx context.Context) *ListReviewsService {
+	return &ListReviewsService{ctx: ctx}
+}
+
+// Run lists the reviews of a product with the given status, approved ones
+// by default, one page at a time.
+func (s *ListReviewsService) Run(req *product.ListReviewsReq) (resp *product.ListReviewsResp, err error) {
+	if req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	status := req.Status
+	if status == "" {
+		status = model.ReviewApproved
+	}
+	if !validReviewStatus(status) {
+		return nil, kerrors.NewBizStatusError(40001, "invalid review status "+status)
+	}
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultReviewPageSize
+	}
+	if pageSize > maxReviewPageSize {
+		pageSize = maxReviewPageSize
+	}
+
+	reviews, total, err := model.ListReviews(mysql.DB, s.ctx, int(req.ProductId), status, int((page-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	resp = &product.ListReviewsResp{Total: total}
+	for _, r := range reviews {
+		resp.Reviews = append(resp.Reviews, toReview(r))
+	}
+	return resp, nil
+}
+
+func validReviewStatus(status string) bool {
+	switch status {
+	case model.ReviewApproved, model.ReviewPending, model.ReviewRejected:
+		return true
+	}
+	return false
+}
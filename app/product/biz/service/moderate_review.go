@@ -0,0 +1,68 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type ModerateReviewService struct {
+	ctx context.Context
+} // NewModerateReviewService new ModerateReviewService
+func NewModerateReviewService(ctx context.Context) *ModerateReviewService {
+	return &ModerateReviewService{ctx: ctx}
+}
+
+// Run approves or rejects a review and refreshes the rating of its product.
+func (s *ModerateReviewService) Run(req *product.ModerateReviewReq) (resp *product.ModerateReviewResp, err error) {
+	if req.Id == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "review id is required")
+	}
+	if !validReviewStatus(req.Status) {
+		return nil, kerrors.NewBizStatusError(40001, "invalid review status "+req.Status)
+	}
+
+	var review model.Review
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		review, err = model.GetReviewById(tx, s.ctx, int(req.Id))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return kerrors.NewBizStatusError(40004, "review not found")
+		}
+		if err != nil {
+			return err
+		}
+		if review.Status == req.Status {
+			return nil
+		}
+		review.Status = req.Status
+		if err := tx.Model(&review).Update("status", review.Status).Error; err != nil {
+			return err
+		}
+		return model.RefreshRating(tx, s.ctx, review.ProductId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	SyncProduct(s.ctx, review.ProductId)
+	return &product.ModerateReviewResp{Review: toReview(review)}, nil
+}
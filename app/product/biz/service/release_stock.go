@@ -0,0 +1,48 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+type ReleaseStockService struct {
+	ctx context.Context
+} // NewReleaseStockService new ReleaseStockService
+func NewReleaseStockService(ctx context.Context) *ReleaseStockService {
+	return &ReleaseStockService{ctx: ctx}
+}
+
+// Run puts reserved stock back, the lines given or, with a reservation id,
+// what was reserved under it. Releasing an id again does nothing.
+func (s *ReleaseStockService) Run(req *product.ReleaseStockReq) (resp *product.ReleaseStockResp, err error) {
+	var lines []model.StockLine
+	if req.ReservationId != "" {
+		lines, err = model.ReleaseStockOnce(mysql.DB, s.ctx, req.ReservationId)
+	} else if lines, err = toStockLines(req.Lines); err == nil {
+		err = model.ReleaseStock(mysql.DB, s.ctx, lines)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) > 0 {
+		invalidateSkuProducts(s.ctx, lines)
+	}
+	return &product.ReleaseStockResp{}, nil
+}
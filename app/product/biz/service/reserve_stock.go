@@ -0,0 +1,106 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type ReserveStockService struct {
+	ctx context.Context
+} // NewReserveStockService new ReserveStockService
+func NewReserveStockService(ctx context.Context) *ReserveStockService {
+	return &ReserveStockService{ctx: ctx}
+}
+
+// Run takes the lines out of stock, all of them or none. With a reservation
+// id it is safe to retry: the stock is taken once.
+func (s *ReserveStockService) Run(req *product.ReserveStockReq) (resp *product.ReserveStockResp, err error) {
+	lines, err := toStockLines(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+	var prices map[int]float32
+	if req.ReservationId != "" {
+		prices, err = model.ReserveStockOnce(mysql.DB, s.ctx, req.ReservationId, lines)
+	} else {
+		prices, err = model.ReserveStock(mysql.DB, s.ctx, lines)
+	}
+	var short model.InsufficientStockError
+	if errors.As(err, &short) {
+		return nil, kerrors.NewBizStatusError(40009, short.Error())
+	}
+	if errors.Is(err, model.ErrReservationReleased) {
+		return nil, kerrors.NewBizStatusError(40009, "reservation "+req.ReservationId+" is already released")
+	}
+	if err != nil {
+		return nil, err
+	}
+	invalidateSkuProducts(s.ctx, lines)
+	resp = &product.ReserveStockResp{Prices: make(map[uint32]float32, len(prices))}
+	for skuId, price := range prices {
+		resp.Prices[uint32(skuId)] = price
+	}
+	return resp, nil
+}
+
+// toStockLines validates the lines and merges the ones of the same sku.
+func toStockLines(lines []*product.StockLine) ([]model.StockLine, error) {
+	if len(lines) == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "stock lines are required")
+	}
+	var out []model.StockLine
+	index := make(map[uint32]int)
+	for _, l := range lines {
+		if l.SkuId == 0 || l.Quantity <= 0 {
+			return nil, kerrors.NewBizStatusError(40001, fmt.Sprintf("invalid stock line %d x %d", l.SkuId, l.Quantity))
+		}
+		if i, ok := index[l.SkuId]; ok {
+			out[i].Quantity += l.Quantity
+			continue
+		}
+		index[l.SkuId] = len(out)
+		out = append(out, model.StockLine{SkuId: int(l.SkuId), Quantity: l.Quantity})
+	}
+	return out, nil
+}
+
+// invalidateSkuProducts drops the cached products whose stock changed.
+func invalidateSkuProducts(ctx context.Context, lines []model.StockLine) {
+	ids := make([]int, 0, len(lines))
+	for _, l := range lines {
+		ids = append(ids, l.SkuId)
+	}
+	skus, err := model.GetSkusByIds(mysql.DB, ctx, ids)
+	if err != nil {
+		klog.CtxWarnf(ctx, "load skus %v: %v", ids, err)
+		return
+	}
+	cache := model.NewCachedProductQuery(model.NewProductQuery(ctx, mysql.DB), redis.RedisClient)
+	for _, sku := range skus {
+		if err := cache.Invalidate(sku.ProductId); err != nil {
+			klog.CtxWarnf(ctx, "invalidate product %d cache: %v", sku.ProductId, err)
+		}
+	}
+}
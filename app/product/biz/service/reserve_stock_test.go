@@ -0,0 +1,48 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+)
+
+func TestReserveStock_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewReserveStockService(ctx)
+	// init req and assert value
+
+	req := &product.ReserveStockReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestToStockLines(t *testing.T) {
+	lines, err := toStockLines([]*product.StockLine{{SkuId: 3, Quantity: 1}, {SkuId: 4, Quantity: 2}, {SkuId: 3, Quantity: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 || lines[0].SkuId != 3 || lines[0].Quantity != 3 || lines[1].Quantity != 2 {
+		t.Errorf("lines = %+v", lines)
+	}
+	if _, err := toStockLines([]*product.StockLine{{SkuId: 3}}); err == nil {
+		t.Error("expected error for zero quantity")
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/pricing"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+const maxPriceReason = 255
+
+type ScheduleProductPriceService struct {
+	ctx context.Context
+} // NewScheduleProductPriceService new ScheduleProductPriceService
+func NewScheduleProductPriceService(ctx context.Context) *ScheduleProductPriceService {
+	return &ScheduleProductPriceService{ctx: ctx}
+}
+
+// Run records a price change of a product's SKUs, or one SKU, and applies
+// it now, or at EffectiveAt when that is in the future.
+func (s *ScheduleProductPriceService) Run(req *product.ScheduleProductPriceReq) (resp *product.ScheduleProductPriceResp, err error) {
+	if req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	if req.Price < 0 {
+		return nil, kerrors.NewBizStatusError(40001, "price must not be negative")
+	}
+	if utf8.RuneCountInString(req.Reason) > maxPriceReason {
+		return nil, kerrors.NewBizStatusError(40001, "reason is too long")
+	}
+	if _, err = model.GetProductById(mysql.DB, s.ctx, int(req.ProductId)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "product not found")
+		}
+		return nil, err
+	}
+	q := mysql.DB.WithContext(s.ctx).Where("product_id = ?", req.ProductId)
+	if req.SkuId != 0 {
+		q = q.Where("id = ?", req.SkuId)
+	}
+	var skus []model.Sku
+	if err = q.Find(&skus).Error; err != nil {
+		return nil, err
+	}
+	if len(skus) == 0 {
+		return nil, kerrors.NewBizStatusError(40004, "sku not found")
+	}
+
+	now := time.Now()
+	effectiveAt := now
+	if req.EffectiveAt > now.Unix() {
+		effectiveAt = time.Unix(req.EffectiveAt, 0)
+	}
+	changes := make([]model.PriceChange, 0, len(skus))
+	for _, sku := range skus {
+		changes = append(changes, model.PriceChange{
+			ProductId:   sku.ProductId,
+			SkuId:       sku.ID,
+			Price:       req.Price,
+			EffectiveAt: effectiveAt,
+			Reason:      req.Reason,
+		})
+	}
+	if err = mysql.DB.WithContext(s.ctx).Create(&changes).Error; err != nil {
+		return nil, err
+	}
+
+	if effectiveAt.After(now) {
+		pricing.Wake()
+	} else {
+		for i := range changes {
+			if _, err = model.ApplyPriceChange(mysql.DB, s.ctx, &changes[i], now); err != nil {
+				return nil, err
+			}
+		}
+		SyncProduct(s.ctx, int(req.ProductId))
+	}
+
+	resp = &product.ScheduleProductPriceResp{}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, toPriceChange(c))
+	}
+	return resp, nil
+}
+
+func toPriceChange(c model.PriceChange) *product.PriceChange {
+	v := &product.PriceChange{
+		Id:          uint32(c.ID),
+		ProductId:   uint32(c.ProductId),
+		SkuId:       uint32(c.SkuId),
+		OldPrice:    c.OldPrice,
+		Price:       c.Price,
+		EffectiveAt: c.EffectiveAt.Unix(),
+		Reason:      c.Reason,
+	}
+	if c.AppliedAt != nil {
+		v.AppliedAt = c.AppliedAt.Unix()
+	}
+	return v
+}
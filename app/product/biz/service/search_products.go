@@ -17,9 +17,9 @@ package service
 import (
 	"context"
 
-	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
-	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
 	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
 type SearchProductsService struct {
@@ -29,19 +29,63 @@ func NewSearchProductsService(ctx context.Context) *SearchProductsService {
 	return &SearchProductsService{ctx: ctx}
 }
 
-// Run create note info
+// Run ranks the products matching the query in the in-memory index, with
+// highlights and category and price facets, one page at a time.
 func (s *SearchProductsService) Run(req *product.SearchProductsReq) (resp *product.SearchProductsResp, err error) {
-	// Finish your business logic.
-	p, err := model.SearchProduct(mysql.DB, s.ctx, req.Query)
-	var results []*product.Product
-	for _, v := range p {
-		results = append(results, &product.Product{
-			Id:          uint32(v.ID),
-			Name:        v.Name,
-			Description: v.Description,
-			Picture:     v.Picture,
-			Price:       v.Price,
+	if req.MinPrice < 0 || req.MaxPrice < 0 || (req.MaxPrice > 0 && req.MinPrice > req.MaxPrice) {
+		return nil, kerrors.NewBizStatusError(40001, "invalid price range")
+	}
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	res := search.Default.Search(search.Query{
+		Text:       req.Query,
+		Categories: req.Categories,
+		MinPrice:   req.MinPrice,
+		MaxPrice:   req.MaxPrice,
+		Offset:     int(int64(page-1) * pageSize),
+		Limit:      int(pageSize),
+	})
+	resp = &product.SearchProductsResp{Total: int64(res.Total)}
+	for _, h := range res.Hits {
+		resp.Results = append(resp.Results, &product.Product{
+			Id:          uint32(h.ID),
+			Name:        h.Name,
+			Description: h.Description,
+			Picture:     h.Picture,
+			Price:       h.Price,
+			Categories:  h.Categories,
+			Rating:      h.Rating,
+			ReviewCount: h.ReviewCount,
+		})
+		resp.Hits = append(resp.Hits, &product.SearchHit{
+			Id:                   uint32(h.ID),
+			Score:                float32(h.Score),
+			NameHighlight:        h.NameHighlight,
+			DescriptionHighlight: h.DescriptionHighlight,
 		})
 	}
-	return &product.SearchProductsResp{Results: results}, err
+	resp.Facets = []*product.Facet{
+		{Name: "category", Buckets: toFacetBuckets(res.CategoryFacet)},
+		{Name: "price", Buckets: toFacetBuckets(res.PriceFacet)},
+	}
+	if err = setDisplayPrices(s.ctx, req.Currency, resp.Results...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func toFacetBuckets(buckets []search.Bucket) (out []*product.FacetBucket) {
+	for _, b := range buckets {
+		out = append(out, &product.FacetBucket{Value: b.Value, Count: b.Count, Min: b.Min, Max: b.Max})
+	}
+	return out
 }
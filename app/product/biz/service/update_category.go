@@ -0,0 +1,101 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+type UpdateCategoryService struct {
+	ctx context.Context
+} // NewUpdateCategoryService new UpdateCategoryService
+func NewUpdateCategoryService(ctx context.Context) *UpdateCategoryService {
+	return &UpdateCategoryService{ctx: ctx}
+}
+
+// Run renames, moves or re-slugs a category and refreshes the cached
+// products in it.
+func (s *UpdateCategoryService) Run(req *product.UpdateCategoryReq) (resp *product.UpdateCategoryResp, err error) {
+	if req.Id == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "category id is required")
+	}
+	c, err := model.GetCategoryById(mysql.DB, s.ctx, int(req.Id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "category not found")
+		}
+		return nil, err
+	}
+	renamed := c.Name != strings.TrimSpace(req.Name)
+
+	c.ParentId = int(req.ParentId)
+	c.Name = strings.TrimSpace(req.Name)
+	c.Slug = req.Slug
+	c.Description = req.Description
+	c.SortOrder = int(req.SortOrder)
+	if c.ParentId != 0 {
+		categories, err := model.GetCategories(mysql.DB, s.ctx)
+		if err != nil {
+			return nil, err
+		}
+		if c.ParentId == c.ID || model.IsDescendant(categories, c.ID, c.ParentId) {
+			return nil, kerrors.NewBizStatusError(40001, "a category cannot be moved below itself")
+		}
+	}
+	if err = validateCategory(s.ctx, &c); err != nil {
+		return nil, err
+	}
+	err = mysql.DB.WithContext(s.ctx).Model(&c).
+		Select("ParentId", "Name", "Slug", "Description", "SortOrder").
+		Updates(&c).Error
+	if err != nil {
+		return nil, slugError(c.Slug, err)
+	}
+	// cached products carry their categories
+	productIds, err := model.GetCategoryProductIds(mysql.DB, s.ctx, c.ID)
+	if err != nil {
+		klog.CtxWarnf(s.ctx, "get products of category %d: %v", c.ID, err)
+	}
+	invalidateProducts(s.ctx, productIds)
+	// search documents carry category names
+	if renamed {
+		if err := search.RebuildAll(s.ctx); err != nil {
+			klog.CtxWarnf(s.ctx, "rebuild search index: %v", err)
+		}
+	}
+	return &product.UpdateCategoryResp{Category: toCategory(c)}, nil
+}
+
+// invalidateProducts drops products from the cache after a change that does
+// not go through the products themselves, such as to one of their categories.
+func invalidateProducts(ctx context.Context, productIds []int) {
+	cache := model.NewCachedProductQuery(model.NewProductQuery(ctx, mysql.DB), redis.RedisClient)
+	for _, id := range productIds {
+		if err := cache.Invalidate(id); err != nil {
+			klog.CtxWarnf(ctx, "invalidate product %d cache: %v", id, err)
+		}
+	}
+}
@@ -2,7 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/redis"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
 	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 )
 
 type UpdateProductService struct {
@@ -15,6 +25,120 @@ func NewUpdateProductService(ctx context.Context) *UpdateProductService {
 // Run create note info
 func (s *UpdateProductService) Run(req *product.UpdateProductReq) (resp *product.UpdateProductResp, err error) {
 	// Finish your business logic.
+	if req.Id == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	if req.Name == "" {
+		return nil, kerrors.NewBizStatusError(40000, "product name is required")
+	}
+	if req.Price < 0 {
+		return nil, kerrors.NewBizStatusError(40001, "price must not be negative")
+	}
+	if err = validateSkus(req.Skus); err != nil {
+		return nil, err
+	}
+
+	var p model.Product
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&p, req.Id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return kerrors.NewBizStatusError(40004, "product not found")
+			}
+			return err
+		}
+		var categories []model.Category
+		if len(req.Categories) > 0 {
+			if err := tx.Where("name IN (?)", req.Categories).Find(&categories).Error; err != nil {
+				return err
+			}
+			if len(categories) != len(req.Categories) {
+				return kerrors.NewBizStatusError(40001, "Invalid category name(s) provided")
+			}
+		}
+
+		p.Name, p.Description, p.Picture, p.Price = req.Name, req.Description, req.Picture, req.Price
+		if err := tx.Model(&p).Select("Name", "Description", "Picture", "Price").Updates(&p).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&p).Association("Categories").Replace(categories); err != nil {
+			return err
+		}
+		if len(req.Skus) > 0 {
+			if err := replaceSkus(tx, p.ID, toSkuModels(req.Skus)); err != nil {
+				return err
+			}
+		}
+		p.Categories = categories
+		return tx.Where("product_id = ?", p.ID).Preload("Options").Find(&p.Skus).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.NewCachedProductQuery(model.NewProductQuery(s.ctx, mysql.DB), redis.RedisClient).Invalidate(p.ID); err != nil {
+		klog.CtxWarnf(s.ctx, "invalidate product %d cache: %v", p.ID, err)
+	}
+	search.Put(s.ctx, search.FromProduct(p))
+
+	return &product.UpdateProductResp{Product: toProduct(p)}, nil
+}
 
-	return
+// replaceSkus makes skus the sku set of the product: skus with an id are
+// updated in place, new ones created and the missing ones deleted.
+func replaceSkus(tx *gorm.DB, productId int, skus []model.Sku) error {
+	var existing []model.Sku
+	if err := tx.Where("product_id = ?", productId).Find(&existing).Error; err != nil {
+		return err
+	}
+	removed := make(map[int]bool)
+	oldPrices := make(map[int]float32)
+	for _, sku := range existing {
+		removed[sku.ID] = true
+		oldPrices[sku.ID] = sku.Price
+	}
+	for _, sku := range skus {
+		sku.ProductId = productId
+		if sku.ID == 0 {
+			if err := tx.Create(&sku).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		if !removed[sku.ID] {
+			return kerrors.NewBizStatusError(40001, fmt.Sprintf("sku %d does not belong to product %d", sku.ID, productId))
+		}
+		delete(removed, sku.ID)
+		if err := tx.Model(&model.Sku{}).Where("id = ?", sku.ID).Updates(map[string]any{
+			"code": sku.Code, "price": sku.Price, "picture": sku.Picture, "stock": sku.Stock, "weight": sku.Weight,
+		}).Error; err != nil {
+			return err
+		}
+		if old := oldPrices[sku.ID]; old != sku.Price {
+			if err := model.RecordPriceChange(tx, tx.Statement.Context, productId, sku.ID, old, sku.Price, "update product"); err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("sku_id = ?", sku.ID).Delete(&model.SkuOption{}).Error; err != nil {
+			return err
+		}
+		for i := range sku.Options {
+			sku.Options[i].SkuId = sku.ID
+		}
+		if len(sku.Options) > 0 {
+			if err := tx.Create(&sku.Options).Error; err != nil {
+				return err
+			}
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(removed))
+	for id := range removed {
+		ids = append(ids, id)
+	}
+	if err := tx.Where("sku_id IN ?", ids).Delete(&model.SkuOption{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(&model.Sku{}, ids).Error
 }
@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type UpdateProductImageService struct {
+	ctx context.Context
+} // NewUpdateProductImageService new UpdateProductImageService
+func NewUpdateProductImageService(ctx context.Context) *UpdateProductImageService {
+	return &UpdateProductImageService{ctx: ctx}
+}
+
+// Run changes the alt text and position of an image in its product's
+// gallery.
+func (s *UpdateProductImageService) Run(req *product.UpdateProductImageReq) (resp *product.UpdateProductImageResp, err error) {
+	if req.Id == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "image id is required")
+	}
+	alt, err := validImageAlt(req.Alt)
+	if err != nil {
+		return nil, err
+	}
+	image, err := model.GetProductImage(mysql.DB, s.ctx, int(req.Id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "image not found")
+		}
+		return nil, err
+	}
+	image.Alt, image.SortOrder = alt, req.SortOrder
+	if err = mysql.DB.WithContext(s.ctx).Model(&image).Select("Alt", "SortOrder").Updates(&image).Error; err != nil {
+		return nil, err
+	}
+	SyncProduct(s.ctx, image.ProductId)
+	return &product.UpdateProductImageResp{Image: toProductImage(image)}, nil
+}
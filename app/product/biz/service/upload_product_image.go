@@ -0,0 +1,161 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/imaging"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/infra/blob"
+	product "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+const (
+	// stays below the 4 MiB default message size of grpc
+	maxImageBytes = 3 << 20
+	maxImageAlt   = 255
+	thumbnailSize = 640
+)
+
+type UploadProductImageService struct {
+	ctx context.Context
+} // NewUploadProductImageService new UploadProductImageService
+func NewUploadProductImageService(ctx context.Context) *UploadProductImageService {
+	return &UploadProductImageService{ctx: ctx}
+}
+
+// Run stores an image of a product and its thumbnail under content
+// addressed keys and appends it to the product's gallery.
+func (s *UploadProductImageService) Run(req *product.UploadProductImageReq) (resp *product.UploadProductImageResp, err error) {
+	if req.ProductId == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "product id is required")
+	}
+	if len(req.Data) == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "image data is required")
+	}
+	if len(req.Data) > maxImageBytes {
+		return nil, kerrors.NewBizStatusError(40001, "image is larger than 3 MiB")
+	}
+	alt, err := validImageAlt(req.Alt)
+	if err != nil {
+		return nil, err
+	}
+	img, format, err := imaging.Decode(req.Data)
+	if err != nil {
+		return nil, kerrors.NewBizStatusError(40001, err.Error())
+	}
+	productId := int(req.ProductId)
+	if _, err = model.GetProductById(mysql.DB, s.ctx, productId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "product not found")
+		}
+		return nil, err
+	}
+
+	// content addressed keys never change, so browsers may cache them forever
+	sum := sha256.Sum256(req.Data)
+	prefix := fmt.Sprintf("products/%d/%s", productId, hex.EncodeToString(sum[:8]))
+	key := prefix + "." + imageExt(format)
+	var duplicates int64
+	if err = mysql.DB.WithContext(s.ctx).Model(&model.ProductImage{}).Where("product_id = ? AND `key` = ?", productId, key).Count(&duplicates).Error; err != nil {
+		return nil, err
+	}
+	if duplicates > 0 {
+		return nil, kerrors.NewBizStatusError(40009, "image already uploaded")
+	}
+
+	thumb, thumbExt, err := imaging.Encode(imaging.Thumbnail(img, thumbnailSize), format)
+	if err != nil {
+		return nil, err
+	}
+	thumbKey := prefix + "_thumb." + thumbExt
+	if err = blob.Default.Put(s.ctx, key, req.Data, "image/"+format); err != nil {
+		return nil, err
+	}
+	if err = blob.Default.Put(s.ctx, thumbKey, thumb, mime.TypeByExtension("."+thumbExt)); err != nil {
+		deleteBlobs(s.ctx, key)
+		return nil, err
+	}
+
+	sortOrder, err := model.NextImageSortOrder(mysql.DB, s.ctx, productId)
+	if err != nil {
+		deleteBlobs(s.ctx, key, thumbKey)
+		return nil, err
+	}
+	image := model.ProductImage{
+		ProductId:    productId,
+		Key:          key,
+		ThumbnailKey: thumbKey,
+		Alt:          alt,
+		SortOrder:    sortOrder,
+		Width:        int32(img.Bounds().Dx()),
+		Height:       int32(img.Bounds().Dy()),
+	}
+	if err = mysql.DB.WithContext(s.ctx).Create(&image).Error; err != nil {
+		deleteBlobs(s.ctx, key, thumbKey)
+		return nil, err
+	}
+	SyncProduct(s.ctx, productId)
+	return &product.UploadProductImageResp{Image: toProductImage(image)}, nil
+}
+
+func validImageAlt(alt string) (string, error) {
+	alt = strings.TrimSpace(alt)
+	if utf8.RuneCountInString(alt) > maxImageAlt {
+		return "", kerrors.NewBizStatusError(40001, "alt text is too long")
+	}
+	return alt, nil
+}
+
+func imageExt(format string) string {
+	if format == "jpeg" {
+		return "jpg"
+	}
+	return format
+}
+
+// deleteBlobs is best effort; a leftover blob only costs storage.
+func deleteBlobs(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := blob.Default.Delete(ctx, key); err != nil {
+			klog.CtxWarnf(ctx, "delete blob %s: %v", key, err)
+		}
+	}
+}
+
+func toProductImage(i model.ProductImage) *product.ProductImage {
+	return &product.ProductImage{
+		Id:           uint32(i.ID),
+		ProductId:    uint32(i.ProductId),
+		Url:          i.URL(),
+		ThumbnailUrl: i.ThumbnailURL(),
+		Alt:          i.Alt,
+		SortOrder:    i.SortOrder,
+		Width:        i.Width,
+		Height:       i.Height,
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command catalog bulk imports and exports the product catalogue.
+//
+//	catalog [-registry addr | -addr host:port] import [-format csv|jsonl] [-dry-run] [-batch n] FILE
+//	catalog [-registry addr | -addr host:port] export [-format csv|jsonl] [-o FILE]
+//
+// Files hold one sku per row, see package biz/catalog for the columns. Import
+// sends the file in batches, so errors of a batch do not stop the next ones.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/catalog"
+	"github.com/cloudwego/biz-demo/gomall/common/clientsuite"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product"
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/product/productcatalogservice"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
+)
+
+func main() {
+	registry := flag.String("registry", "127.0.0.1:8500", "consul address used to find the product service")
+	addr := flag.String("addr", "", "product service host:port, bypasses consul")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	cli, err := newClient(*registry, *addr)
+	if err != nil {
+		fatal(err)
+	}
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "import":
+		err = runImport(cli, args)
+	case "export":
+		err = runExport(cli, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: catalog [-registry addr | -addr host:port] import [-format csv|jsonl] [-dry-run] [-batch n] FILE")
+	fmt.Fprintln(os.Stderr, "       catalog [-registry addr | -addr host:port] export [-format csv|jsonl] [-o FILE]")
+	flag.PrintDefaults()
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "catalog:", err)
+	os.Exit(1)
+}
+
+func newClient(registry, addr string) (productcatalogservice.Client, error) {
+	// batches of a large catalogue take longer than the default timeout
+	opts := []client.Option{client.WithRPCTimeout(time.Minute)}
+	if addr != "" {
+		opts = append(opts,
+			client.WithHostPorts(addr),
+			client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+			client.WithTransportProtocol(transport.GRPC),
+		)
+	} else {
+		opts = append(opts, client.WithSuite(clientsuite.CommonGrpcClientSuite{
+			CurrentServiceName: "catalog-cli",
+			RegistryAddr:       registry,
+		}))
+	}
+	return productcatalogservice.NewClient("product", opts...)
+}
+
+// formatOf picks the format from the flag or else the file extension.
+func formatOf(format, file string) (string, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".csv":
+			format = catalog.FormatCSV
+		case ".jsonl", ".ndjson":
+			format = catalog.FormatJSONL
+		default:
+			return "", errors.New("cannot tell the format from the file name, use -format")
+		}
+	}
+	if !catalog.ValidFormat(format) {
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+	return format, nil
+}
+
+type importStats struct {
+	resp   product.ImportProductsResp
+	errors int
+}
+
+func (s *importStats) add(resp *product.ImportProductsResp) {
+	s.resp.ProductsCreated += resp.ProductsCreated
+	s.resp.ProductsUpdated += resp.ProductsUpdated
+	s.resp.SkusCreated += resp.SkusCreated
+	s.resp.SkusUpdated += resp.SkusUpdated
+	for _, e := range resp.Errors {
+		s.rowError(int(e.Row), e.SkuCode, e.Message)
+	}
+}
+
+func (s *importStats) rowError(row int, skuCode, message string) {
+	s.errors++
+	if skuCode != "" {
+		fmt.Fprintf(os.Stderr, "row %d (%s): %s\n", row, skuCode, message)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "row %d: %s\n", row, message)
+}
+
+func runImport(cli productcatalogservice.Client, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "csv or jsonl, by default taken from the file extension")
+	dryRun := fs.Bool("dry-run", false, "validate and report the changes without applying them")
+	batch := fs.Int("batch", 500, "rows per request, at most 1000")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("import needs exactly one FILE")
+	}
+	if *batch < 1 || *batch > 1000 {
+		return errors.New("-batch must be between 1 and 1000")
+	}
+	file := fs.Arg(0)
+	f, err := formatOf(*format, file)
+	if err != nil {
+		return err
+	}
+	in, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	var stats importStats
+	send := func(data []byte, firstRow int) error {
+		resp, err := cli.ImportProducts(context.Background(), &product.ImportProductsReq{
+			Format:   f,
+			Data:     data,
+			DryRun:   *dryRun,
+			FirstRow: int32(firstRow),
+		})
+		if err != nil {
+			return fmt.Errorf("rows from %d: %w", firstRow, err)
+		}
+		stats.add(resp)
+		return nil
+	}
+	if f == catalog.FormatCSV {
+		err = streamCSV(in, *batch, send, &stats)
+	} else {
+		err = streamJSONL(in, *batch, send)
+	}
+	if err != nil {
+		return err
+	}
+
+	verb := "imported"
+	if *dryRun {
+		verb = "dry run, would import"
+	}
+	r := &stats.resp
+	fmt.Printf("%s: %d products created, %d updated; %d skus created, %d updated; %d rows with errors\n",
+		verb, r.ProductsCreated, r.ProductsUpdated, r.SkusCreated, r.SkusUpdated, stats.errors)
+	if stats.errors > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// streamCSV sends the file in batches, each starting with the header row.
+func streamCSV(in io.Reader, batch int, send func([]byte, int) error, stats *importStats) error {
+	r := csv.NewReader(bufio.NewReader(in))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+	var records [][]string
+	firstRow, row := 1, 0
+	flush := func() error {
+		if len(records) == 0 {
+			return nil
+		}
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		_ = w.Write(header)
+		_ = w.WriteAll(records)
+		if err := send(buf.Bytes(), firstRow); err != nil {
+			return err
+		}
+		records, firstRow = records[:0], row+1
+		return nil
+	}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			stats.rowError(row, "", parseErr.Err.Error())
+			// a batch holds consecutive rows, so the next one starts after this
+			if err := flush(); err != nil {
+				return err
+			}
+			firstRow = row + 1
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		if len(records) == batch {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// streamJSONL sends the file in batches of non-empty lines.
+func streamJSONL(in io.Reader, batch int, send func([]byte, int) error) error {
+	s := bufio.NewScanner(in)
+	s.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	var buf bytes.Buffer
+	lines, firstRow := 0, 1
+	for s.Scan() {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+		if lines++; lines == batch {
+			if err := send(buf.Bytes(), firstRow); err != nil {
+				return err
+			}
+			buf.Reset()
+			firstRow += lines
+			lines = 0
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+	if lines > 0 {
+		return send(buf.Bytes(), firstRow)
+	}
+	return nil
+}
+
+func runExport(cli productcatalogservice.Client, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "", "csv or jsonl, by default taken from -o or else csv")
+	output := fs.String("o", "", "output file, standard output by default")
+	_ = fs.Parse(args)
+	if *format == "" && *output == "" {
+		*format = catalog.FormatCSV
+	}
+	f, err := formatOf(*format, *output)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		if out, err = os.Create(*output); err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	w := bufio.NewWriter(out)
+	var afterId uint32
+	for {
+		resp, err := cli.ExportProducts(context.Background(), &product.ExportProductsReq{Format: f, AfterId: afterId})
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(resp.Data); err != nil {
+			return err
+		}
+		if afterId = resp.NextAfterId; afterId == 0 {
+			break
+		}
+	}
+	return w.Flush()
+}
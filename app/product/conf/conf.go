@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"sync"
 
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/kr/pretty"
 	"gopkg.in/validator.v2"
@@ -32,10 +33,14 @@ var (
 
 type Config struct {
 	Env      string
-	Kitex    Kitex    `yaml:"kitex"`
-	MySQL    MySQL    `yaml:"mysql"`
-	Redis    Redis    `yaml:"redis"`
-	Registry Registry `yaml:"registry"`
+	Kitex    Kitex           `yaml:"kitex"`
+	MySQL    MySQL           `yaml:"mysql"`
+	Redis    Redis           `yaml:"redis"`
+	Registry Registry        `yaml:"registry"`
+	Search   Search          `yaml:"search"`
+	Blob     Blob            `yaml:"blob"`
+	Pricing  Pricing         `yaml:"pricing"`
+	Currency currency.Config `yaml:"currency"`
 	// Centralized Config Server
 	ConfigServer ConfigServer `yaml:"configServer"`
 }
@@ -71,6 +76,34 @@ type Registry struct {
 	Password        string   `yaml:"password"`
 }
 
+type Search struct {
+	// RebuildInterval reloads the index from MySQL every so many seconds to
+	// pick up writes served by other replicas; 0 disables it.
+	RebuildInterval int `yaml:"rebuild_interval"`
+}
+
+type Pricing struct {
+	// SchedulerInterval is the longest wait, in seconds, before the price
+	// scheduler looks for changes scheduled by other replicas. Defaults to 30.
+	SchedulerInterval int `yaml:"scheduler_interval"`
+}
+
+// Blob selects where uploaded images are kept. The s3 driver reads its
+// credentials from S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY.
+type Blob struct {
+	// Driver is local (the default) or s3
+	Driver string `yaml:"driver"`
+	// Dir is the root of the local driver
+	Dir string `yaml:"dir"`
+	S3  S3     `yaml:"s3"`
+}
+
+type S3 struct {
+	Endpoint string `yaml:"endpoint"`
+	Region   string `yaml:"region"`
+	Bucket   string `yaml:"bucket"`
+}
+
 type ConfigServer struct{}
 
 // GetConf gets configuration instance
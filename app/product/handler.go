@@ -65,3 +65,129 @@ func (s *ProductCatalogServiceImpl) DeleteProduct(ctx context.Context, req *prod
 
 	return resp, err
 }
+
+// ReserveStock implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ReserveStock(ctx context.Context, req *product.ReserveStockReq) (resp *product.ReserveStockResp, err error) {
+	resp, err = service.NewReserveStockService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ReleaseStock implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ReleaseStock(ctx context.Context, req *product.ReleaseStockReq) (resp *product.ReleaseStockResp, err error) {
+	resp, err = service.NewReleaseStockService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateCategory implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) CreateCategory(ctx context.Context, req *product.CreateCategoryReq) (resp *product.CreateCategoryResp, err error) {
+	resp, err = service.NewCreateCategoryService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateCategory implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) UpdateCategory(ctx context.Context, req *product.UpdateCategoryReq) (resp *product.UpdateCategoryResp, err error) {
+	resp, err = service.NewUpdateCategoryService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DeleteCategory implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) DeleteCategory(ctx context.Context, req *product.DeleteCategoryReq) (resp *product.DeleteCategoryResp, err error) {
+	resp, err = service.NewDeleteCategoryService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListCategoryTree implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ListCategoryTree(ctx context.Context, req *product.ListCategoryTreeReq) (resp *product.ListCategoryTreeResp, err error) {
+	resp, err = service.NewListCategoryTreeService(ctx).Run(req)
+
+	return resp, err
+}
+
+// BatchGetProducts implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) BatchGetProducts(ctx context.Context, req *product.BatchGetProductsReq) (resp *product.BatchGetProductsResp, err error) {
+	resp, err = service.NewBatchGetProductsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// CreateReview implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) CreateReview(ctx context.Context, req *product.CreateReviewReq) (resp *product.CreateReviewResp, err error) {
+	resp, err = service.NewCreateReviewService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListReviews implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ListReviews(ctx context.Context, req *product.ListReviewsReq) (resp *product.ListReviewsResp, err error) {
+	resp, err = service.NewListReviewsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ModerateReview implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ModerateReview(ctx context.Context, req *product.ModerateReviewReq) (resp *product.ModerateReviewResp, err error) {
+	resp, err = service.NewModerateReviewService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ImportProducts implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ImportProducts(ctx context.Context, req *product.ImportProductsReq) (resp *product.ImportProductsResp, err error) {
+	resp, err = service.NewImportProductsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ExportProducts implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ExportProducts(ctx context.Context, req *product.ExportProductsReq) (resp *product.ExportProductsResp, err error) {
+	resp, err = service.NewExportProductsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UploadProductImage implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) UploadProductImage(ctx context.Context, req *product.UploadProductImageReq) (resp *product.UploadProductImageResp, err error) {
+	resp, err = service.NewUploadProductImageService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateProductImage implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) UpdateProductImage(ctx context.Context, req *product.UpdateProductImageReq) (resp *product.UpdateProductImageResp, err error) {
+	resp, err = service.NewUpdateProductImageService(ctx).Run(req)
+
+	return resp, err
+}
+
+// DeleteProductImage implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) DeleteProductImage(ctx context.Context, req *product.DeleteProductImageReq) (resp *product.DeleteProductImageResp, err error) {
+	resp, err = service.NewDeleteProductImageService(ctx).Run(req)
+
+	return resp, err
+}
+
+// GetImage implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) GetImage(ctx context.Context, req *product.GetImageReq) (resp *product.GetImageResp, err error) {
+	resp, err = service.NewGetImageService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ScheduleProductPrice implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ScheduleProductPrice(ctx context.Context, req *product.ScheduleProductPriceReq) (resp *product.ScheduleProductPriceResp, err error) {
+	resp, err = service.NewScheduleProductPriceService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListPriceHistory implements the ProductCatalogServiceImpl interface.
+func (s *ProductCatalogServiceImpl) ListPriceHistory(ctx context.Context, req *product.ListPriceHistoryReq) (resp *product.ListPriceHistoryResp, err error) {
+	resp, err = service.NewListPriceHistoryService(ctx).Run(req)
+
+	return resp, err
+}
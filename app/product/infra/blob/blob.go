@@ -0,0 +1,74 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blob stores binary objects such as product images under
+// slash-separated keys, on the local filesystem or in an S3-compatible bucket.
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/cloudwego/biz-demo/gomall/app/product/conf"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+type Store interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns ErrNotFound for a missing key.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete succeeds for a missing key.
+	Delete(ctx context.Context, key string) error
+}
+
+// Default is the store chosen by the blob section of the config.
+var Default Store
+
+func Init() {
+	c := conf.GetConf().Blob
+	switch c.Driver {
+	case "", "local":
+		Default = NewLocal(c.Dir)
+	case "s3":
+		Default = NewS3(S3Config{
+			Endpoint:  c.S3.Endpoint,
+			Region:    c.S3.Region,
+			Bucket:    c.S3.Bucket,
+			AccessKey: os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		})
+	default:
+		panic(fmt.Sprintf("unknown blob driver %q", c.Driver))
+	}
+}
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+(/[A-Za-z0-9_.-]+)*$`)
+
+// ValidKey rejects keys that could escape the store, e.g. "../conf".
+func ValidKey(key string) bool {
+	if !keyPattern.MatchString(key) {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "." || part == ".." {
+			return false
+		}
+	}
+	return true
+}
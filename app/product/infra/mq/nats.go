@@ -0,0 +1,65 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"strconv"
+
+	"github.com/nats-io/nats.go"
+)
+
+// ProductInvalidateSubject carries the id of a product that changed, and
+// the changes of the search index as JSON objects. It is a plain subject
+// rather than a stream so every product instance receives it.
+const ProductInvalidateSubject = "product.invalidate"
+
+var (
+	Nc  *nats.Conn
+	err error
+)
+
+func Init() {
+	Nc, err = nats.Connect(nats.DefaultURL)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// PublishInvalidation announces that productId changed.
+func PublishInvalidation(productId int) error {
+	return Nc.Publish(ProductInvalidateSubject, []byte(strconv.Itoa(productId)))
+}
+
+// PublishIndexUpdate announces a change of the search index, encoded as a
+// JSON object.
+func PublishIndexUpdate(data []byte) error {
+	return Nc.Publish(ProductInvalidateSubject, data)
+}
+
+// SubscribeInvalidation calls evict with every announced product id and
+// index with every announced index change, including the ones published by
+// this instance.
+func SubscribeInvalidation(evict func(productId int), index func(data []byte)) error {
+	_, err := Nc.Subscribe(ProductInvalidateSubject, func(msg *nats.Msg) {
+		if len(msg.Data) > 0 && msg.Data[0] == '{' {
+			index(msg.Data)
+			return
+		}
+		if id, err := strconv.Atoi(string(msg.Data)); err == nil {
+			evict(id)
+		}
+	})
+	return err
+}
@@ -19,7 +19,15 @@ import (
 	"strings"
 
 	"github.com/cloudwego/biz-demo/gomall/app/product/biz/dal"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/pricing"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/search"
+	"github.com/cloudwego/biz-demo/gomall/app/product/biz/service"
 	"github.com/cloudwego/biz-demo/gomall/app/product/conf"
+	"github.com/cloudwego/biz-demo/gomall/app/product/infra/blob"
+	"github.com/cloudwego/biz-demo/gomall/app/product/infra/mq"
+	"github.com/cloudwego/biz-demo/gomall/app/product/infra/rpc"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
 	"github.com/cloudwego/biz-demo/gomall/common/mtl"
 	"github.com/cloudwego/biz-demo/gomall/common/serversuite"
 	"github.com/cloudwego/biz-demo/gomall/common/utils"
@@ -44,6 +52,24 @@ func main() {
 	mtl.InitTracing(serviceName)
 	mtl.InitMetric(serviceName, conf.GetConf().Kitex.MetricsPort, conf.GetConf().Registry.RegistryAddress[0])
 	dal.Init()
+	mq.Init()
+	// every instance drops changed products from its local cache and
+	// applies the changes of the search index to its own
+	model.BroadcastInvalidation = mq.PublishInvalidation
+	search.BroadcastUpdate = mq.PublishIndexUpdate
+	if err := mq.SubscribeInvalidation(model.EvictLocal, func(data []byte) {
+		if err := search.Apply(data); err != nil {
+			klog.Warnf("apply search index update: %v", err)
+		}
+	}); err != nil {
+		panic(err)
+	}
+	search.Init()
+	blob.Init()
+	currency.Init(conf.GetConf().Currency)
+	// scheduled prices take effect on every replica
+	pricing.Init(service.SyncProduct)
+	rpc.InitClient()
 	opts := kitexInit()
 
 	svr := productcatalogservice.NewServer(new(ProductCatalogServiceImpl), opts...)
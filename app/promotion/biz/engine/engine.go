@@ -0,0 +1,182 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package engine computes the discounts of a set of promotion rules on the
+// lines of an order.
+package engine
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	TypePercentage = "percentage"
+	TypeFixed      = "fixed"
+	TypeBuyXGetY   = "buy_x_get_y"
+)
+
+var (
+	ErrNoMatch  = errors.New("no item qualifies")
+	ErrMinSpend = errors.New("minimum spend not reached")
+)
+
+type Line struct {
+	Categories []string
+	UnitPrice  float64
+	Quantity   int
+}
+
+type Rule struct {
+	Type        string
+	Value       float64
+	BuyQuantity int
+	GetQuantity int
+	// Categories limits the rule to lines of these categories, empty for all
+	Categories []string
+	MinSpend   float64
+}
+
+// Outcome is what a rule did to the order.
+type Outcome struct {
+	Discount float64
+	// Skipped is ErrNoMatch or ErrMinSpend when the rule gave no discount
+	Skipped error
+}
+
+type Result struct {
+	// Outcomes has one entry per rule, in the order of the rules
+	Outcomes []Outcome
+	// LineDiscounts is the total discount of every line
+	LineDiscounts []float64
+	Total         float64
+}
+
+// Evaluate applies the rules one after another, each to what the previous
+// ones left of the line amounts, so the discount never exceeds the order.
+// Amounts are rounded to cents.
+func Evaluate(lines []Line, rules []Rule) Result {
+	remaining := make([]float64, len(lines))
+	for i, l := range lines {
+		remaining[i] = round(l.UnitPrice * float64(l.Quantity))
+	}
+	res := Result{Outcomes: make([]Outcome, len(rules)), LineDiscounts: make([]float64, len(lines))}
+	for r, rule := range rules {
+		eligible := matching(lines, rule.Categories)
+		var subtotal float64
+		for _, i := range eligible {
+			subtotal += remaining[i]
+		}
+		switch {
+		case len(eligible) == 0 || subtotal <= 0:
+			res.Outcomes[r].Skipped = ErrNoMatch
+			continue
+		case subtotal < rule.MinSpend:
+			res.Outcomes[r].Skipped = ErrMinSpend
+			continue
+		}
+		discounts := ruleDiscounts(rule, lines, eligible, remaining, subtotal)
+		for i, d := range discounts {
+			d = math.Min(round(d), remaining[i])
+			remaining[i] = round(remaining[i] - d)
+			res.LineDiscounts[i] = round(res.LineDiscounts[i] + d)
+			res.Outcomes[r].Discount = round(res.Outcomes[r].Discount + d)
+		}
+		if res.Outcomes[r].Discount == 0 {
+			res.Outcomes[r].Skipped = ErrNoMatch
+		}
+		res.Total = round(res.Total + res.Outcomes[r].Discount)
+	}
+	return res
+}
+
+// ruleDiscounts returns the discount of a rule by line index.
+func ruleDiscounts(rule Rule, lines []Line, eligible []int, remaining []float64, subtotal float64) map[int]float64 {
+	out := make(map[int]float64, len(eligible))
+	switch rule.Type {
+	case TypePercentage:
+		pct := math.Min(math.Max(rule.Value, 0), 100) / 100
+		for _, i := range eligible {
+			out[i] = remaining[i] * pct
+		}
+	case TypeFixed:
+		// spread the amount over the lines by their share of the subtotal
+		amount := round(math.Min(math.Max(rule.Value, 0), subtotal))
+		left := amount
+		for n, i := range eligible {
+			if n == len(eligible)-1 {
+				out[i] = left
+				break
+			}
+			share := round(amount * remaining[i] / subtotal)
+			out[i] = share
+			left -= share
+		}
+	case TypeBuyXGetY:
+		group := rule.BuyQuantity + rule.GetQuantity
+		if rule.BuyQuantity < 1 || rule.GetQuantity < 1 {
+			return out
+		}
+		type unit struct {
+			line  int
+			price float64
+		}
+		var units []unit
+		for _, i := range eligible {
+			price := remaining[i] / float64(lines[i].Quantity)
+			for q := 0; q < lines[i].Quantity; q++ {
+				units = append(units, unit{i, price})
+			}
+		}
+		// within every group of buy+get units, dearest first, the last get
+		// units are free
+		sort.SliceStable(units, func(a, b int) bool { return units[a].price > units[b].price })
+		for start := 0; start+group <= len(units); start += group {
+			for _, u := range units[start+rule.BuyQuantity : start+group] {
+				out[u.line] += u.price
+			}
+		}
+	}
+	return out
+}
+
+func matching(lines []Line, categories []string) []int {
+	var out []int
+	for i, l := range lines {
+		if l.Quantity > 0 && inCategories(l.Categories, categories) {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+func inCategories(lineCategories, ruleCategories []string) bool {
+	if len(ruleCategories) == 0 {
+		return true
+	}
+	for _, a := range lineCategories {
+		for _, b := range ruleCategories {
+			if strings.EqualFold(a, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
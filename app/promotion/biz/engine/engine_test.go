@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	lines := []Line{
+		{Categories: []string{"T-Shirt"}, UnitPrice: 10, Quantity: 3},
+		{Categories: []string{"Sticker"}, UnitPrice: 2.5, Quantity: 4},
+	}
+	for _, c := range []struct {
+		name  string
+		rules []Rule
+		lines []float64
+		total float64
+		skip  []error
+	}{
+		{"percentage", []Rule{{Type: TypePercentage, Value: 10}}, []float64{3, 1}, 4, []error{nil}},
+		{"category", []Rule{{Type: TypePercentage, Value: 50, Categories: []string{"sticker"}}}, []float64{0, 5}, 5, []error{nil}},
+		{"fixed spread", []Rule{{Type: TypeFixed, Value: 8}}, []float64{6, 2}, 8, []error{nil}},
+		{"fixed capped", []Rule{{Type: TypeFixed, Value: 100, Categories: []string{"Sticker"}}}, []float64{0, 10}, 10, []error{nil}},
+		{"buy 2 get 1", []Rule{{Type: TypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1}}, []float64{10, 2.5}, 12.5, []error{nil}},
+		{"min spend", []Rule{{Type: TypeFixed, Value: 5, MinSpend: 50}}, []float64{0, 0}, 0, []error{ErrMinSpend}},
+		{"no match", []Rule{{Type: TypeFixed, Value: 5, Categories: []string{"Mug"}}}, []float64{0, 0}, 0, []error{ErrNoMatch}},
+		{"stacked", []Rule{{Type: TypePercentage, Value: 50}, {Type: TypeFixed, Value: 100}}, []float64{30, 10}, 40, []error{nil, nil}},
+	} {
+		res := Evaluate(lines, c.rules)
+		if !reflect.DeepEqual(res.LineDiscounts, c.lines) || res.Total != c.total {
+			t.Errorf("%s: line discounts %v total %v, want %v %v", c.name, res.LineDiscounts, res.Total, c.lines, c.total)
+		}
+		for i, want := range c.skip {
+			if res.Outcomes[i].Skipped != want {
+				t.Errorf("%s: rule %d skipped %v, want %v", c.name, i, res.Outcomes[i].Skipped, want)
+			}
+		}
+	}
+}
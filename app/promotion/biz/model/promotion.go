@@ -0,0 +1,208 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrUsageLimit = errors.New("promotion usage limit reached")
+	ErrKeyUsed    = errors.New("redemption key is already used")
+)
+
+// Promotion is a discount rule, see engine.Rule. Promotions with a Code are
+// coupons; the others apply to every order they match.
+type Promotion struct {
+	Base
+	Name string `gorm:"size:128"`
+	// Code is stored upper case and nil for automatic promotions
+	Code        *string `gorm:"size:64;uniqueIndex"`
+	Type        string  `gorm:"size:16"`
+	Value       float32
+	BuyQuantity int32
+	GetQuantity int32
+	Categories  []string `gorm:"serializer:json"`
+	MinSpend    float32
+	// UsageLimit and PerUserLimit are 0 for unlimited
+	UsageLimit   int32
+	PerUserLimit int32
+	UsedCount    int32
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+	Active       bool
+}
+
+func (p Promotion) TableName() string {
+	return "promotion"
+}
+
+// Redemption is one use of a promotion by an order.
+type Redemption struct {
+	Base
+	PromotionId int    `gorm:"index"`
+	UserId      uint32 `gorm:"index"`
+	Discount    float32
+	// Key is the RedemptionKey of the order, empty when it has none.
+	Key string `gorm:"size:64;index"`
+}
+
+func (r Redemption) TableName() string {
+	return "redemption"
+}
+
+// RedemptionKey is the idempotency key of the redemptions of one order, e.g.
+// its checkout id. Redemptions are made once under a key and released once;
+// releasing a key that redeemed nothing records it as released, which turns
+// away redemptions that arrive late.
+type RedemptionKey struct {
+	Base
+	Key      string `gorm:"uniqueIndex;size:64"`
+	Released bool
+}
+
+func (k RedemptionKey) TableName() string {
+	return "redemption_key"
+}
+
+func CreatePromotion(db *gorm.DB, ctx context.Context, p *Promotion) error {
+	return db.WithContext(ctx).Create(p).Error
+}
+
+func ListPromotions(db *gorm.DB, ctx context.Context, activeOnly bool) (promotions []Promotion, err error) {
+	q := db.WithContext(ctx).Order("id")
+	if activeOnly {
+		q = q.Where("active = ?", true)
+	}
+	err = q.Find(&promotions).Error
+	return
+}
+
+func GetPromotionByCode(db *gorm.DB, ctx context.Context, code string) (p Promotion, err error) {
+	err = db.WithContext(ctx).Where("code = ?", code).First(&p).Error
+	return
+}
+
+// AutomaticPromotions returns the active promotions without a code that are
+// valid at now and not used up, by id.
+func AutomaticPromotions(db *gorm.DB, ctx context.Context, now time.Time) (promotions []Promotion, err error) {
+	err = db.WithContext(ctx).
+		Where("code IS NULL AND active = ?", true).
+		Where("(starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)", now, now).
+		Where("usage_limit = 0 OR used_count < usage_limit").
+		Order("id").Find(&promotions).Error
+	return
+}
+
+func CountUserRedemptions(db *gorm.DB, ctx context.Context, promotionId int, userId uint32) (count int64, err error) {
+	err = db.WithContext(ctx).Model(&Redemption{}).Where("promotion_id = ? AND user_id = ?", promotionId, userId).Count(&count).Error
+	return
+}
+
+// Redeem records a use of the promotion, checking its limits under a row
+// lock so concurrent checkouts cannot exceed them.
+func Redeem(tx *gorm.DB, ctx context.Context, promotionId int, userId uint32, discount float32, key string) (Redemption, error) {
+	var p Promotion
+	if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&p, promotionId).Error; err != nil {
+		return Redemption{}, err
+	}
+	if p.UsageLimit > 0 && p.UsedCount >= p.UsageLimit {
+		return Redemption{}, ErrUsageLimit
+	}
+	if p.PerUserLimit > 0 {
+		used, err := CountUserRedemptions(tx, ctx, promotionId, userId)
+		if err != nil {
+			return Redemption{}, err
+		}
+		if used >= int64(p.PerUserLimit) {
+			return Redemption{}, ErrUsageLimit
+		}
+	}
+	if err := tx.WithContext(ctx).Model(&p).UpdateColumn("used_count", gorm.Expr("used_count + 1")).Error; err != nil {
+		return Redemption{}, err
+	}
+	r := Redemption{PromotionId: promotionId, UserId: userId, Discount: discount, Key: key}
+	err := tx.WithContext(ctx).Create(&r).Error
+	return r, err
+}
+
+// ReleaseRedemptions gives uses back to their promotions, e.g. after the
+// payment of the order failed. Unknown ids are ignored.
+func ReleaseRedemptions(db *gorm.DB, ctx context.Context, ids []int) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var redemptions []Redemption
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", ids).Find(&redemptions).Error; err != nil {
+			return err
+		}
+		return releaseRedemptions(tx, redemptions)
+	})
+}
+
+// ClaimRedemptionKey records a key before its redemptions are made, db must
+// be a transaction. A key that is already used, or released, fails with
+// ErrKeyUsed.
+func ClaimRedemptionKey(tx *gorm.DB, ctx context.Context, key string) error {
+	res := tx.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&RedemptionKey{Key: key})
+	if res.Error == nil && res.RowsAffected == 0 {
+		return ErrKeyUsed
+	}
+	return res.Error
+}
+
+// ReleaseRedemptionsByKey gives the uses made under a key back to their
+// promotions. Releasing a key again does nothing.
+func ReleaseRedemptionsByKey(db *gorm.DB, ctx context.Context, key string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// creating the key first serializes the release with a concurrent
+		// ApplyCoupon of the key on its unique index
+		k := RedemptionKey{Key: key, Released: true}
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&k)
+		if res.Error != nil || res.RowsAffected > 0 {
+			return res.Error
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("`key` = ?", key).First(&k).Error; err != nil {
+			return err
+		}
+		if k.Released {
+			return nil
+		}
+		var redemptions []Redemption
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("`key` = ?", key).Find(&redemptions).Error; err != nil {
+			return err
+		}
+		if err := releaseRedemptions(tx, redemptions); err != nil {
+			return err
+		}
+		return tx.Model(&k).Update("released", true).Error
+	})
+}
+
+func releaseRedemptions(tx *gorm.DB, redemptions []Redemption) error {
+	for _, r := range redemptions {
+		if err := tx.Model(&Promotion{}).Where("id = ? AND used_count > 0", r.PromotionId).
+			UpdateColumn("used_count", gorm.Expr("used_count - 1")).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&r).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
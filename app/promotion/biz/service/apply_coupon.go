@@ -0,0 +1,80 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/model"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type ApplyCouponService struct {
+	ctx context.Context
+} // NewApplyCouponService new ApplyCouponService
+func NewApplyCouponService(ctx context.Context) *ApplyCouponService {
+	return &ApplyCouponService{ctx: ctx}
+}
+
+// Run redeems the promotions that discount the lines. With a redemption
+// key the redemptions are made at most once under it, so a caller that
+// lost the response can release them by key instead of applying again.
+func (s *ApplyCouponService) Run(req *promotion.ApplyCouponReq) (resp *promotion.ApplyCouponResp, err error) {
+	plan, err := planDiscount(s.ctx, req.UserId, req.CouponCode, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+	resp = &promotion.ApplyCouponResp{
+		Promotions:    plan.applied(),
+		LineDiscounts: plan.lineDiscounts(),
+		Discount:      float32(plan.result.Total),
+	}
+	err = mysql.DB.WithContext(s.ctx).Transaction(func(tx *gorm.DB) error {
+		if req.RedemptionKey != "" {
+			err := model.ClaimRedemptionKey(tx, s.ctx, req.RedemptionKey)
+			if errors.Is(err, model.ErrKeyUsed) {
+				return kerrors.NewBizStatusError(40009, "coupon is already applied or released under this key")
+			}
+			if err != nil {
+				return err
+			}
+		}
+		for i, o := range plan.result.Outcomes {
+			if o.Discount <= 0 {
+				continue
+			}
+			p := plan.promotions[i]
+			r, err := model.Redeem(tx, s.ctx, p.ID, req.UserId, float32(o.Discount), req.RedemptionKey)
+			// another order took the last use since planDiscount looked
+			if errors.Is(err, model.ErrUsageLimit) {
+				return kerrors.NewBizStatusError(40009, fmt.Sprintf("promotion %q has been used up", p.Name))
+			}
+			if err != nil {
+				return err
+			}
+			resp.RedemptionIds = append(resp.RedemptionIds, uint32(r.ID))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
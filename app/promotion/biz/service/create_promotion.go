@@ -0,0 +1,144 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/engine"
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/model"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type CreatePromotionService struct {
+	ctx context.Context
+} // NewCreatePromotionService new CreatePromotionService
+func NewCreatePromotionService(ctx context.Context) *CreatePromotionService {
+	return &CreatePromotionService{ctx: ctx}
+}
+
+// Run creates an automatic promotion, or a coupon when it has a code, which
+// must be unused.
+func (s *CreatePromotionService) Run(req *promotion.CreatePromotionReq) (resp *promotion.CreatePromotionResp, err error) {
+	if req.Promotion == nil {
+		return nil, kerrors.NewBizStatusError(40000, "promotion is required")
+	}
+	p, err := fromPromotion(req.Promotion)
+	if err != nil {
+		return nil, err
+	}
+	if p.Code != nil {
+		_, err = model.GetPromotionByCode(mysql.DB, s.ctx, *p.Code)
+		if err == nil {
+			return nil, kerrors.NewBizStatusError(40009, "coupon code already exists")
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+	if err = model.CreatePromotion(mysql.DB, s.ctx, &p); err != nil {
+		return nil, err
+	}
+	return &promotion.CreatePromotionResp{Promotion: toPromotion(p)}, nil
+}
+
+// fromPromotion validates a promotion and converts it to the model.
+func fromPromotion(v *promotion.Promotion) (model.Promotion, error) {
+	p := model.Promotion{
+		Name:         strings.TrimSpace(v.Name),
+		Type:         v.Type,
+		Value:        v.Value,
+		BuyQuantity:  v.BuyQuantity,
+		GetQuantity:  v.GetQuantity,
+		MinSpend:     v.MinSpend,
+		UsageLimit:   v.UsageLimit,
+		PerUserLimit: v.PerUserLimit,
+		Active:       v.Active,
+	}
+	for _, c := range v.Categories {
+		if c = strings.TrimSpace(c); c != "" {
+			p.Categories = append(p.Categories, c)
+		}
+	}
+	if code := normalizeCode(v.Code); code != "" {
+		p.Code = &code
+	}
+	if v.StartsAt > 0 {
+		t := time.Unix(v.StartsAt, 0)
+		p.StartsAt = &t
+	}
+	if v.EndsAt > 0 {
+		t := time.Unix(v.EndsAt, 0)
+		p.EndsAt = &t
+	}
+
+	if p.Name == "" {
+		return p, kerrors.NewBizStatusError(40000, "promotion name is required")
+	}
+	switch p.Type {
+	case engine.TypePercentage:
+		if p.Value <= 0 || p.Value > 100 {
+			return p, kerrors.NewBizStatusError(40001, "percentage must be between 0 and 100")
+		}
+	case engine.TypeFixed:
+		if p.Value <= 0 {
+			return p, kerrors.NewBizStatusError(40001, "fixed discount must be positive")
+		}
+	case engine.TypeBuyXGetY:
+		if p.BuyQuantity < 1 || p.GetQuantity < 1 {
+			return p, kerrors.NewBizStatusError(40001, "buy and get quantities must be at least 1")
+		}
+	default:
+		return p, kerrors.NewBizStatusError(40001, "promotion type must be percentage, fixed or buy_x_get_y")
+	}
+	if p.MinSpend < 0 || p.UsageLimit < 0 || p.PerUserLimit < 0 {
+		return p, kerrors.NewBizStatusError(40001, "minimum spend and limits must not be negative")
+	}
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return p, kerrors.NewBizStatusError(40001, "promotion must end after it starts")
+	}
+	return p, nil
+}
+
+func toPromotion(p model.Promotion) *promotion.Promotion {
+	v := &promotion.Promotion{
+		Id:           uint32(p.ID),
+		Name:         p.Name,
+		Code:         codeOf(p),
+		Type:         p.Type,
+		Value:        p.Value,
+		BuyQuantity:  p.BuyQuantity,
+		GetQuantity:  p.GetQuantity,
+		Categories:   p.Categories,
+		MinSpend:     p.MinSpend,
+		UsageLimit:   p.UsageLimit,
+		PerUserLimit: p.PerUserLimit,
+		UsedCount:    p.UsedCount,
+		Active:       p.Active,
+	}
+	if p.StartsAt != nil {
+		v.StartsAt = p.StartsAt.Unix()
+	}
+	if p.EndsAt != nil {
+		v.EndsAt = p.EndsAt.Unix()
+	}
+	return v
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+)
+
+func TestCreatePromotion_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewCreatePromotionService(ctx)
+	// init req and assert value
+
+	req := &promotion.CreatePromotionReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestFromPromotion(t *testing.T) {
+	p, err := fromPromotion(&promotion.Promotion{Name: " Summer ", Code: " summer10 ", Type: "percentage", Value: 10, Categories: []string{"T-Shirt", " "}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Summer" || p.Code == nil || *p.Code != "SUMMER10" || len(p.Categories) != 1 {
+		t.Errorf("fromPromotion = %+v", p)
+	}
+	for _, v := range []*promotion.Promotion{
+		{Type: "percentage", Value: 10},
+		{Name: "a", Type: "percentage", Value: 120},
+		{Name: "a", Type: "fixed"},
+		{Name: "a", Type: "buy_x_get_y", BuyQuantity: 2},
+		{Name: "a", Type: "bogus", Value: 1},
+		{Name: "a", Type: "fixed", Value: 1, StartsAt: 200, EndsAt: 100},
+	} {
+		if _, err := fromPromotion(v); err == nil {
+			t.Errorf("fromPromotion(%v) accepted", v)
+		}
+	}
+}
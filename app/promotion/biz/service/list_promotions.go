@@ -0,0 +1,43 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/model"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+)
+
+type ListPromotionsService struct {
+	ctx context.Context
+} // NewListPromotionsService new ListPromotionsService
+func NewListPromotionsService(ctx context.Context) *ListPromotionsService {
+	return &ListPromotionsService{ctx: ctx}
+}
+
+// Run lists the promotions, only the ones running now when ActiveOnly.
+func (s *ListPromotionsService) Run(req *promotion.ListPromotionsReq) (resp *promotion.ListPromotionsResp, err error) {
+	promotions, err := model.ListPromotions(mysql.DB, s.ctx, req.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+	resp = &promotion.ListPromotionsResp{}
+	for _, p := range promotions {
+		resp.Promotions = append(resp.Promotions, toPromotion(p))
+	}
+	return resp, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/engine"
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/model"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type PreviewDiscountService struct {
+	ctx context.Context
+} // NewPreviewDiscountService new PreviewDiscountService
+func NewPreviewDiscountService(ctx context.Context) *PreviewDiscountService {
+	return &PreviewDiscountService{ctx: ctx}
+}
+
+// Run computes the discount the promotions and the coupon would give the
+// lines, without redeeming anything.
+func (s *PreviewDiscountService) Run(req *promotion.PreviewDiscountReq) (resp *promotion.PreviewDiscountResp, err error) {
+	plan, err := planDiscount(s.ctx, req.UserId, req.CouponCode, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+	return &promotion.PreviewDiscountResp{
+		Promotions:    plan.applied(),
+		LineDiscounts: plan.lineDiscounts(),
+		Discount:      float32(plan.result.Total),
+	}, nil
+}
+
+// discountPlan is the evaluation of the promotions of an order; promotions
+// and result.Outcomes are parallel.
+type discountPlan struct {
+	promotions []model.Promotion
+	result     engine.Result
+}
+
+// planDiscount evaluates the automatic promotions the user may still use
+// and, last, the coupon. A coupon that cannot be used is an error rather than
+// silently ignored.
+func planDiscount(ctx context.Context, userId uint32, code string, lines []*promotion.Line) (*discountPlan, error) {
+	if len(lines) == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "order lines are required")
+	}
+	engineLines := make([]engine.Line, 0, len(lines))
+	for _, l := range lines {
+		if l.Quantity <= 0 || l.UnitPrice < 0 {
+			return nil, kerrors.NewBizStatusError(40001, fmt.Sprintf("invalid line of sku %d", l.SkuId))
+		}
+		engineLines = append(engineLines, engine.Line{Categories: l.Categories, UnitPrice: float64(l.UnitPrice), Quantity: int(l.Quantity)})
+	}
+
+	now := time.Now()
+	automatic, err := model.AutomaticPromotions(mysql.DB, ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	var promotions []model.Promotion
+	for _, p := range automatic {
+		if p.PerUserLimit > 0 {
+			used, err := model.CountUserRedemptions(mysql.DB, ctx, p.ID, userId)
+			if err != nil {
+				return nil, err
+			}
+			if used >= int64(p.PerUserLimit) {
+				continue
+			}
+		}
+		promotions = append(promotions, p)
+	}
+	code = normalizeCode(code)
+	if code != "" {
+		coupon, err := model.GetPromotionByCode(mysql.DB, ctx, code)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "coupon not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err = checkCoupon(ctx, coupon, userId, now); err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, coupon)
+	}
+
+	rules := make([]engine.Rule, 0, len(promotions))
+	for _, p := range promotions {
+		rules = append(rules, toRule(p))
+	}
+	plan := &discountPlan{promotions: promotions, result: engine.Evaluate(engineLines, rules)}
+	if code != "" {
+		coupon := promotions[len(promotions)-1]
+		switch plan.result.Outcomes[len(promotions)-1].Skipped {
+		case engine.ErrMinSpend:
+			return nil, kerrors.NewBizStatusError(40001, fmt.Sprintf("coupon needs a minimum spend of %.2f", coupon.MinSpend))
+		case engine.ErrNoMatch:
+			return nil, kerrors.NewBizStatusError(40001, "coupon does not apply to any item of the order")
+		}
+	}
+	return plan, nil
+}
+
+func checkCoupon(ctx context.Context, c model.Promotion, userId uint32, now time.Time) error {
+	switch {
+	case !c.Active:
+		return kerrors.NewBizStatusError(40001, "coupon is not active")
+	case c.StartsAt != nil && now.Before(*c.StartsAt):
+		return kerrors.NewBizStatusError(40001, "coupon is not valid yet")
+	case c.EndsAt != nil && !now.Before(*c.EndsAt):
+		return kerrors.NewBizStatusError(40001, "coupon has expired")
+	case c.UsageLimit > 0 && c.UsedCount >= c.UsageLimit:
+		return kerrors.NewBizStatusError(40009, "coupon has been used up")
+	}
+	if c.PerUserLimit > 0 {
+		used, err := model.CountUserRedemptions(mysql.DB, ctx, c.ID, userId)
+		if err != nil {
+			return err
+		}
+		if used >= int64(c.PerUserLimit) {
+			return kerrors.NewBizStatusError(40009, "coupon already used")
+		}
+	}
+	return nil
+}
+
+func (p *discountPlan) applied() (out []*promotion.AppliedPromotion) {
+	for i, o := range p.result.Outcomes {
+		if o.Discount <= 0 {
+			continue
+		}
+		promo := p.promotions[i]
+		out = append(out, &promotion.AppliedPromotion{
+			PromotionId: uint32(promo.ID),
+			Name:        promo.Name,
+			Code:        codeOf(promo),
+			Discount:    float32(o.Discount),
+		})
+	}
+	return out
+}
+
+func (p *discountPlan) lineDiscounts() []float32 {
+	out := make([]float32, len(p.result.LineDiscounts))
+	for i, d := range p.result.LineDiscounts {
+		out[i] = float32(d)
+	}
+	return out
+}
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func codeOf(p model.Promotion) string {
+	if p.Code == nil {
+		return ""
+	}
+	return *p.Code
+}
+
+func toRule(p model.Promotion) engine.Rule {
+	return engine.Rule{
+		Type:        p.Type,
+		Value:       float64(p.Value),
+		BuyQuantity: int(p.BuyQuantity),
+		GetQuantity: int(p.GetQuantity),
+		Categories:  p.Categories,
+		MinSpend:    float64(p.MinSpend),
+	}
+}
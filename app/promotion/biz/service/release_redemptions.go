@@ -0,0 +1,53 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/model"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+)
+
+type ReleaseRedemptionsService struct {
+	ctx context.Context
+} // NewReleaseRedemptionsService new ReleaseRedemptionsService
+func NewReleaseRedemptionsService(ctx context.Context) *ReleaseRedemptionsService {
+	return &ReleaseRedemptionsService{ctx: ctx}
+}
+
+// Run gives the uses of the redemptions back to their promotions, either
+// the listed ones or the ones made under the redemption key.
+func (s *ReleaseRedemptionsService) Run(req *promotion.ReleaseRedemptionsReq) (resp *promotion.ReleaseRedemptionsResp, err error) {
+	if req.RedemptionKey != "" {
+		if err = model.ReleaseRedemptionsByKey(mysql.DB, s.ctx, req.RedemptionKey); err != nil {
+			return nil, err
+		}
+		return &promotion.ReleaseRedemptionsResp{}, nil
+	}
+	if len(req.RedemptionIds) == 0 {
+		return nil, kerrors.NewBizStatusError(40000, "redemption ids or key are required")
+	}
+	ids := make([]int, 0, len(req.RedemptionIds))
+	for _, id := range req.RedemptionIds {
+		ids = append(ids, int(id))
+	}
+	if err = model.ReleaseRedemptions(mysql.DB, s.ctx, ids); err != nil {
+		return nil, err
+	}
+	return &promotion.ReleaseRedemptionsResp{}, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/cloudwego/biz-demo/gomall/app/promotion/biz/service"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+)
+
+// PromotionServiceImpl implements the last service interface defined in the IDL.
+type PromotionServiceImpl struct{}
+
+// CreatePromotion implements the PromotionServiceImpl interface.
+func (s *PromotionServiceImpl) CreatePromotion(ctx context.Context, req *promotion.CreatePromotionReq) (resp *promotion.CreatePromotionResp, err error) {
+	resp, err = service.NewCreatePromotionService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ListPromotions implements the PromotionServiceImpl interface.
+func (s *PromotionServiceImpl) ListPromotions(ctx context.Context, req *promotion.ListPromotionsReq) (resp *promotion.ListPromotionsResp, err error) {
+	resp, err = service.NewListPromotionsService(ctx).Run(req)
+
+	return resp, err
+}
+
+// PreviewDiscount implements the PromotionServiceImpl interface.
+func (s *PromotionServiceImpl) PreviewDiscount(ctx context.Context, req *promotion.PreviewDiscountReq) (resp *promotion.PreviewDiscountResp, err error) {
+	resp, err = service.NewPreviewDiscountService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ApplyCoupon implements the PromotionServiceImpl interface.
+func (s *PromotionServiceImpl) ApplyCoupon(ctx context.Context, req *promotion.ApplyCouponReq) (resp *promotion.ApplyCouponResp, err error) {
+	resp, err = service.NewApplyCouponService(ctx).Run(req)
+
+	return resp, err
+}
+
+// ReleaseRedemptions implements the PromotionServiceImpl interface.
+func (s *PromotionServiceImpl) ReleaseRedemptions(ctx context.Context, req *promotion.ReleaseRedemptionsReq) (resp *promotion.ReleaseRedemptionsResp, err error) {
+	resp, err = service.NewReleaseRedemptionsService(ctx).Run(req)
+
+	return resp, err
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationPreference holds the channels a user wants to be notified on.
+// Users without a row get DefaultNotificationPreference.
+type NotificationPreference struct {
+	Base
+	UserId         int `gorm:"uniqueIndex"`
+	EmailEnabled   bool
+	SmsEnabled     bool
+	WebhookEnabled bool
+	Phone          string `gorm:"size:32"`
+	WebhookUrl     string `gorm:"size:512"`
+	// OptOuts is a comma separated list of topics
+	OptOuts  string `gorm:"size:256"`
+	Locale   string `gorm:"size:16"`
+	Currency string `gorm:"size:3"`
+}
+
+func (p NotificationPreference) TableName() string {
+	return "notification_preference"
+}
+
+func DefaultNotificationPreference(userId int) *NotificationPreference {
+	return &NotificationPreference{UserId: userId, EmailEnabled: true}
+}
+
+func (p *NotificationPreference) OptOutList() []string {
+	if p.OptOuts == "" {
+		return nil
+	}
+	return strings.Split(p.OptOuts, ",")
+}
+
+func (p *NotificationPreference) SetOptOuts(topics []string) {
+	p.OptOuts = strings.Join(topics, ",")
+}
+
+func GetNotificationPreference(db *gorm.DB, ctx context.Context, userId int) (*NotificationPreference, error) {
+	var p NotificationPreference
+	err := db.WithContext(ctx).Where(&NotificationPreference{UserId: userId}).First(&p).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return DefaultNotificationPreference(userId), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func SaveNotificationPreference(db *gorm.DB, ctx context.Context, p *NotificationPreference) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"email_enabled", "sms_enabled", "webhook_enabled", "phone", "webhook_url", "opt_outs", "locale", "currency", "updated_at",
+		}),
+	}).Create(p).Error
+}
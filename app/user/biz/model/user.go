@@ -35,6 +35,11 @@ func GetByEmail(db *gorm.DB, ctx context.Context, email string) (user *User, err
 	return
 }
 
+func GetById(db *gorm.DB, ctx context.Context, id int) (user *User, err error) {
+	err = db.WithContext(ctx).Model(&User{}).Where("id = ?", id).First(&user).Error
+	return
+}
+
 func Create(db *gorm.DB, ctx context.Context, user *User) error {
 	return db.WithContext(ctx).Create(user).Error
 }
@@ -7,7 +7,7 @@ import (
 
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
-	user "github.com/cloudwego/biz-demo/gomall/app/user/kitex_gen/user"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 )
 
 type DeleteService struct {
@@ -3,7 +3,8 @@ package service
 import (
 	"context"
 	"testing"
-	user "github.com/cloudwego/biz-demo/gomall/app/user/kitex_gen/user"
+
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 )
 
 func TestDelete_Run(t *testing.T) {
@@ -0,0 +1,65 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+type GetNotificationPreferencesService struct {
+	ctx context.Context
+} // NewGetNotificationPreferencesService new GetNotificationPreferencesService
+func NewGetNotificationPreferencesService(ctx context.Context) *GetNotificationPreferencesService {
+	return &GetNotificationPreferencesService{ctx: ctx}
+}
+
+// Run returns the user's email address together with their notification preferences.
+func (s *GetNotificationPreferencesService) Run(req *user.GetNotificationPreferencesReq) (resp *user.GetNotificationPreferencesResp, err error) {
+	if req.UserId <= 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user_id is required")
+	}
+	u, err := model.GetById(mysql.DB, s.ctx, int(req.UserId))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, kerrors.NewBizStatusError(40004, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	p, err := model.GetNotificationPreference(mysql.DB, s.ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetNotificationPreferencesResp{Email: u.Email, Preferences: toNotificationPreferences(p)}, nil
+}
+
+func toNotificationPreferences(p *model.NotificationPreference) *user.NotificationPreferences {
+	return &user.NotificationPreferences{
+		EmailEnabled:   p.EmailEnabled,
+		SmsEnabled:     p.SmsEnabled,
+		WebhookEnabled: p.WebhookEnabled,
+		Phone:          p.Phone,
+		WebhookUrl:     p.WebhookUrl,
+		OptOuts:        p.OptOutList(),
+		Locale:         p.Locale,
+		Currency:       p.Currency,
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/dal/mysql"
+	"github.com/cloudwego/biz-demo/gomall/app/user/biz/model"
+	"github.com/cloudwego/biz-demo/gomall/common/currency"
+	"github.com/cloudwego/biz-demo/gomall/common/i18n"
+	"github.com/cloudwego/biz-demo/gomall/common/utils"
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"gorm.io/gorm"
+)
+
+// optOutTopics are the topics a user may opt out of. Account notifications
+// such as password resets are always delivered.
+var optOutTopics = map[string]bool{
+	"order":     true,
+	"shipping":  true,
+	"marketing": true,
+}
+
+type UpdateNotificationPreferencesService struct {
+	ctx context.Context
+} // NewUpdateNotificationPreferencesService new UpdateNotificationPreferencesService
+func NewUpdateNotificationPreferencesService(ctx context.Context) *UpdateNotificationPreferencesService {
+	return &UpdateNotificationPreferencesService{ctx: ctx}
+}
+
+// Run replaces the user's notification preferences.
+func (s *UpdateNotificationPreferencesService) Run(req *user.UpdateNotificationPreferencesReq) (resp *user.UpdateNotificationPreferencesResp, err error) {
+	if req.UserId <= 0 {
+		return nil, kerrors.NewBizStatusError(40000, "user_id is required")
+	}
+	if req.Preferences == nil {
+		return nil, kerrors.NewBizStatusError(40000, "preferences is required")
+	}
+	if err = validateNotificationPreferences(s.ctx, req.Preferences); err != nil {
+		return nil, kerrors.NewBizStatusError(40001, err.Error())
+	}
+
+	if _, err = model.GetById(mysql.DB, s.ctx, int(req.UserId)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, kerrors.NewBizStatusError(40004, "user not found")
+		}
+		return nil, err
+	}
+
+	p := &model.NotificationPreference{
+		UserId:         int(req.UserId),
+		EmailEnabled:   req.Preferences.EmailEnabled,
+		SmsEnabled:     req.Preferences.SmsEnabled,
+		WebhookEnabled: req.Preferences.WebhookEnabled,
+		Phone:          req.Preferences.Phone,
+		WebhookUrl:     req.Preferences.WebhookUrl,
+		Locale:         req.Preferences.Locale,
+		Currency:       req.Preferences.Currency,
+	}
+	p.SetOptOuts(req.Preferences.OptOuts)
+	if err = model.SaveNotificationPreference(mysql.DB, s.ctx, p); err != nil {
+		return nil, err
+	}
+	return &user.UpdateNotificationPreferencesResp{Preferences: toNotificationPreferences(p)}, nil
+}
+
+func validateNotificationPreferences(ctx context.Context, p *user.NotificationPreferences) error {
+	if p.SmsEnabled && p.Phone == "" {
+		return errors.New("phone is required to enable sms")
+	}
+	if p.WebhookEnabled {
+		u, err := url.Parse(p.WebhookUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+			return errors.New("a http(s) webhook_url is required to enable webhooks")
+		}
+		// the email service posts signed requests to the URL, it must not
+		// be pointed at hosts inside the network. The email service checks
+		// the address again when it connects, in case the DNS changes.
+		ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+		if err = utils.CheckPublicHost(ctx, u.Hostname()); err != nil {
+			return fmt.Errorf("webhook_url must point to a public host: %v", err)
+		}
+	}
+	if p.Locale != "" && !i18n.Supported(p.Locale) {
+		return fmt.Errorf("unsupported locale %q", p.Locale)
+	}
+	if p.Currency != "" && !currency.Supported(p.Currency) {
+		return fmt.Errorf("unsupported currency %q", p.Currency)
+	}
+	for _, topic := range p.OptOuts {
+		if !optOutTopics[topic] {
+			return fmt.Errorf("unknown opt-out topic %q", topic)
+		}
+	}
+	return nil
+}
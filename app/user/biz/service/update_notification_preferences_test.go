@@ -0,0 +1,50 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
+)
+
+func TestUpdateNotificationPreferences_Run(t *testing.T) {
+	ctx := context.Background()
+	s := NewUpdateNotificationPreferencesService(ctx)
+	// init req and assert value
+
+	req := &user.UpdateNotificationPreferencesReq{}
+	resp, err := s.Run(req)
+	t.Logf("err: %v", err)
+	t.Logf("resp: %v", resp)
+
+	// todo: edit your unit test
+}
+
+func TestValidateNotificationPreferences(t *testing.T) {
+	ctx := context.Background()
+	if err := validateNotificationPreferences(ctx, &user.NotificationPreferences{Currency: "EUR"}); err != nil {
+		t.Errorf("EUR rejected: %v", err)
+	}
+	if err := validateNotificationPreferences(ctx, &user.NotificationPreferences{Currency: "XYZ"}); err == nil {
+		t.Error("unsupported currency accepted")
+	}
+	for _, u := range []string{"http://127.0.0.1/hook", "http://169.254.169.254/latest/meta-data", "https://10.0.0.1", "http://[::1]:8080", "http://localhost/hook"} {
+		if err := validateNotificationPreferences(ctx, &user.NotificationPreferences{WebhookEnabled: true, WebhookUrl: u}); err == nil {
+			t.Errorf("internal webhook_url %s accepted", u)
+		}
+	}
+}
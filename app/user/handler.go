@@ -18,7 +18,6 @@ import (
 	"context"
 
 	"github.com/cloudwego/biz-demo/gomall/app/user/biz/service"
-	"github.com/cloudwego/biz-demo/gomall/app/user/kitex_gen/user"
 	user "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/user"
 )
 
@@ -45,3 +44,17 @@ func (s *UserServiceImpl) Delete(ctx context.Context, req *user.DeleteReq) (resp
 
 	return resp, err
 }
+
+// GetNotificationPreferences implements the UserServiceImpl interface.
+func (s *UserServiceImpl) GetNotificationPreferences(ctx context.Context, req *user.GetNotificationPreferencesReq) (resp *user.GetNotificationPreferencesResp, err error) {
+	resp, err = service.NewGetNotificationPreferencesService(ctx).Run(req)
+
+	return resp, err
+}
+
+// UpdateNotificationPreferences implements the UserServiceImpl interface.
+func (s *UserServiceImpl) UpdateNotificationPreferences(ctx context.Context, req *user.UpdateNotificationPreferencesReq) (resp *user.UpdateNotificationPreferencesResp, err error) {
+	resp, err = service.NewUpdateNotificationPreferencesService(ctx).Run(req)
+
+	return resp, err
+}
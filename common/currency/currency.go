@@ -0,0 +1,206 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package currency converts prices from the base currency of the shop into
+// the currencies shoppers can pick. Exchange rates come from a pluggable
+// Source and are cached by a Converter.
+package currency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// Base is the currency prices are kept in.
+const Base = "USD"
+
+var (
+	ErrUnsupported = errors.New("unsupported currency")
+	ErrNoRate      = errors.New("no exchange rate")
+)
+
+type info struct {
+	symbol string
+	// digits of the minor unit
+	decimals int
+}
+
+// currencies the shop offers, Codes lists them in display order.
+var (
+	currencies = map[string]info{
+		"USD": {"$", 2},
+		"EUR": {"€", 2},
+		"GBP": {"£", 2},
+		"CNY": {"¥", 2},
+		"JPY": {"JP¥", 0},
+		"CAD": {"CA$", 2},
+	}
+	codes = []string{"USD", "EUR", "GBP", "CNY", "JPY", "CAD"}
+)
+
+// Codes returns the currencies the shop offers.
+func Codes() []string {
+	return append([]string(nil), codes...)
+}
+
+// Supported reports whether code is a currency the shop offers.
+func Supported(code string) bool {
+	_, ok := currencies[code]
+	return ok
+}
+
+// Round rounds amount to the minor unit of code.
+func Round(amount float64, code string) float64 {
+	p := math.Pow10(decimals(code))
+	return math.Round(amount*p) / p
+}
+
+// ToMinor converts amount to a whole number of the minor unit of code, e.g.
+// cents, rounding to the nearest unit. Balances are kept in minor units so
+// that repeated debits do not drift.
+func ToMinor(amount float64, code string) int64 {
+	return int64(math.Round(amount * math.Pow10(decimals(code))))
+}
+
+// FromMinor converts units of the minor unit of code back to an amount.
+func FromMinor(units int64, code string) float64 {
+	return float64(units) / math.Pow10(decimals(code))
+}
+
+// decimals returns the digits of the minor unit of code, 2 for currencies
+// the shop does not offer.
+func decimals(code string) int {
+	if c, ok := currencies[code]; ok {
+		return c.decimals
+	}
+	return 2
+}
+
+// Convert converts amount in Base with rate and rounds it to the minor unit
+// of code.
+func Convert(amount float32, rate float64, code string) float32 {
+	return float32(Round(float64(amount)*rate, code))
+}
+
+// Format renders amount with the symbol of code, e.g. "$12.50" or "JP¥1350".
+func Format(code string, amount float64) string {
+	c, ok := currencies[code]
+	if !ok {
+		return strconv.FormatFloat(amount, 'f', 2, 64) + " " + code
+	}
+	return c.symbol + strconv.FormatFloat(amount, 'f', c.decimals, 64)
+}
+
+// Rates maps a currency to how much of it one unit of Base buys.
+type Rates map[string]float64
+
+// Source provides exchange rates.
+type Source interface {
+	Rates(ctx context.Context) (Rates, error)
+}
+
+// Converter caches the rates of a Source for refresh. When a refresh fails
+// it keeps using the rates it has and tries again on the next lookup.
+type Converter struct {
+	source  Source
+	refresh time.Duration
+
+	mu      sync.Mutex
+	rates   Rates
+	fetched time.Time
+}
+
+// NewConverter returns a Converter that fetches rates from source at most
+// once every refresh.
+func NewConverter(source Source, refresh time.Duration) *Converter {
+	return &Converter{source: source, refresh: refresh}
+}
+
+// Rate returns how much of code one unit of Base buys. An empty code means
+// Base.
+func (c *Converter) Rate(ctx context.Context, code string) (float64, error) {
+	if code == "" || code == Base {
+		return 1, nil
+	}
+	if !Supported(code) {
+		return 0, fmt.Errorf("%w %q", ErrUnsupported, code)
+	}
+	rates, err := c.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rate, ok := rates[code]
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("%w for %s", ErrNoRate, code)
+	}
+	return rate, nil
+}
+
+func (c *Converter) load(ctx context.Context) (Rates, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rates != nil && time.Since(c.fetched) < c.refresh {
+		return c.rates, nil
+	}
+	rates, err := c.source.Rates(ctx)
+	if err != nil {
+		if c.rates == nil {
+			return nil, err
+		}
+		klog.CtxWarnf(ctx, "refresh exchange rates failed, keep the rates of %s: %v", c.fetched.Format(time.RFC3339), err)
+		return c.rates, nil
+	}
+	c.rates, c.fetched = rates, time.Now()
+	return rates, nil
+}
+
+// Default converts with the rates configured by Init. Until then it only
+// knows Base.
+var Default = NewConverter(StaticSource{}, time.Hour)
+
+// Config selects the source of the exchange rates.
+type Config struct {
+	// "file" (default) or "http"
+	Source string `yaml:"source"`
+	// rates file for the file source, or URL for the http source
+	File string `yaml:"file"`
+	URL  string `yaml:"url"`
+	// how long fetched rates are used before they are fetched again
+	Refresh time.Duration `yaml:"refresh"`
+}
+
+// Init sets Default to a Converter for cfg.
+func Init(cfg Config) {
+	var source Source
+	switch cfg.Source {
+	case "", "file":
+		source = FileSource{Path: cfg.File}
+	case "http":
+		source = &HTTPSource{URL: cfg.URL}
+	default:
+		panic(fmt.Errorf("unknown exchange rate source %q", cfg.Source))
+	}
+	refresh := cfg.Refresh
+	if refresh <= 0 {
+		refresh = time.Hour
+	}
+	Default = NewConverter(source, refresh)
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	cases := map[string]string{
+		Format("USD", 12.5):    "$12.50",
+		Format("JPY", 1350.4):  "JP¥1350",
+		Format("EUR", 0):       "€0.00",
+		Format("XYZ", 3.14159): "3.14 XYZ",
+	}
+	for got, want := range cases {
+		if got != want {
+			t.Errorf("Format = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	if got := Convert(10, 0.925, "EUR"); got != 9.25 {
+		t.Errorf("Convert EUR = %v, want 9.25", got)
+	}
+	if got := Convert(10, 151.37, "JPY"); got != 1514 {
+		t.Errorf("Convert JPY = %v, want 1514", got)
+	}
+}
+
+func TestMinor(t *testing.T) {
+	if got := ToMinor(float64(float32(12.3)), "USD"); got != 1230 {
+		t.Errorf("ToMinor USD = %v, want 1230", got)
+	}
+	if got := ToMinor(1350.4, "JPY"); got != 1350 {
+		t.Errorf("ToMinor JPY = %v, want 1350", got)
+	}
+	if got := FromMinor(1230, "USD"); got != 12.3 {
+		t.Errorf("FromMinor USD = %v, want 12.3", got)
+	}
+	if got := FromMinor(1350, "JPY"); got != 1350 {
+		t.Errorf("FromMinor JPY = %v, want 1350", got)
+	}
+}
+
+type flakySource struct {
+	rates Rates
+	fail  bool
+	calls int
+}
+
+func (s *flakySource) Rates(ctx context.Context) (Rates, error) {
+	s.calls++
+	if s.fail {
+		return nil, errors.New("provider down")
+	}
+	return s.rates, nil
+}
+
+func TestConverterRate(t *testing.T) {
+	ctx := context.Background()
+	src := &flakySource{rates: Rates{"EUR": 0.9}}
+	c := NewConverter(src, time.Hour)
+	if rate, err := c.Rate(ctx, ""); err != nil || rate != 1 {
+		t.Errorf("Rate(base) = %v, %v", rate, err)
+	}
+	if rate, err := c.Rate(ctx, "EUR"); err != nil || rate != 0.9 {
+		t.Errorf("Rate(EUR) = %v, %v", rate, err)
+	}
+	if _, err := c.Rate(ctx, "GBP"); !errors.Is(err, ErrNoRate) {
+		t.Errorf("Rate(GBP) err = %v, want ErrNoRate", err)
+	}
+	if _, err := c.Rate(ctx, "XYZ"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Rate(XYZ) err = %v, want ErrUnsupported", err)
+	}
+	if src.calls != 1 {
+		t.Errorf("source called %d times, want 1", src.calls)
+	}
+
+	// expired rates are kept while the source fails
+	c.fetched = time.Now().Add(-2 * time.Hour)
+	src.fail = true
+	if rate, err := c.Rate(ctx, "EUR"); err != nil || rate != 0.9 {
+		t.Errorf("Rate(EUR) with failing source = %v, %v", rate, err)
+	}
+	if _, err := NewConverter(src, time.Hour).Rate(ctx, "EUR"); err == nil {
+		t.Error("Rate without any rates succeeded")
+	}
+}
+
+func TestHTTPSource(t *testing.T) {
+	srv := httptest.NewServer(StubHandler(Rates{"CNY": 7.2}))
+	defer srv.Close()
+	rates, err := (&HTTPSource{URL: srv.URL}).Rates(context.Background())
+	if err != nil || rates["CNY"] != 7.2 {
+		t.Errorf("Rates = %v, %v", rates, err)
+	}
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	defer down.Close()
+	if _, err = (&HTTPSource{URL: down.URL}).Rates(context.Background()); err == nil {
+		t.Error("Rates from a failing provider succeeded")
+	}
+}
+
+func TestFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(`{"base": "EUR", "rates": {"USD": 1.08}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (FileSource{Path: path}).Rates(context.Background()); err == nil {
+		t.Error("rates with another base were accepted")
+	}
+	if err := os.WriteFile(path, []byte(`{"base": "USD", "rates": {"EUR": 0.92}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if rates, err := (FileSource{Path: path}).Rates(context.Background()); err != nil || rates["EUR"] != 0.92 {
+		t.Errorf("Rates = %v, %v", rates, err)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// ratesDoc is the format of rate files and of the HTTP rate provider:
+//
+//	{"base": "USD", "rates": {"EUR": 0.92, "JPY": 151.3}}
+type ratesDoc struct {
+	Base  string `json:"base"`
+	Rates Rates  `json:"rates"`
+}
+
+func parseRates(data []byte) (Rates, error) {
+	var doc ratesDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Base != Base {
+		return nil, fmt.Errorf("rates are based on %q, want %s", doc.Base, Base)
+	}
+	for code, rate := range doc.Rates {
+		if rate <= 0 {
+			return nil, fmt.Errorf("invalid rate %v for %s", rate, code)
+		}
+	}
+	return doc.Rates, nil
+}
+
+// StaticSource serves fixed rates.
+type StaticSource Rates
+
+func (s StaticSource) Rates(ctx context.Context) (Rates, error) {
+	return Rates(s), nil
+}
+
+// FileSource reads rates from a JSON file, see ratesDoc.
+type FileSource struct {
+	Path string
+}
+
+func (s FileSource) Rates(ctx context.Context) (Rates, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	return parseRates(data)
+}
+
+// HTTPSource fetches rates from a provider answering GET URL with a JSON
+// document, see ratesDoc.
+type HTTPSource struct {
+	URL string
+	// defaults to a client with a 5s timeout
+	Client *http.Client
+}
+
+var defaultClient = &http.Client{Timeout: 5 * time.Second}
+
+func (s *HTTPSource) Rates(ctx context.Context) (Rates, error) {
+	client := s.Client
+	if client == nil {
+		client = defaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("exchange rate provider returned %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return parseRates(data)
+}
+
+// StubHandler serves rates the way HTTPSource expects them, it stands in for
+// a real provider in local development and tests.
+func StubHandler(rates Rates) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ratesDoc{Base: Base, Rates: rates})
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package i18n holds the message catalogues shared by the frontend and the
+// email service, one JSON file per locale under locales/.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is used when no supported locale can be negotiated, and as
+// the fallback for keys missing from other catalogues.
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+var (
+	catalogues = map[string]map[string]string{}
+	locales    []string
+)
+
+func init() {
+	files, err := localeFS.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+	for _, f := range files {
+		data, err := localeFS.ReadFile(path.Join("locales", f.Name()))
+		if err != nil {
+			panic(err)
+		}
+		messages := map[string]string{}
+		if err = json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Errorf("parse %s: %w", f.Name(), err))
+		}
+		locale := strings.TrimSuffix(f.Name(), ".json")
+		catalogues[locale] = messages
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+}
+
+// Locales returns the supported locales.
+func Locales() []string {
+	return append([]string(nil), locales...)
+}
+
+// Supported reports whether locale has a catalogue.
+func Supported(locale string) bool {
+	_, ok := catalogues[locale]
+	return ok
+}
+
+// Normalize maps a language tag such as "zh", "zh-cn" or "en-US" to a
+// supported locale, or returns "" if there is none.
+func Normalize(tag string) string {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	if tag == "" {
+		return ""
+	}
+	for _, l := range locales {
+		if strings.EqualFold(l, tag) {
+			return l
+		}
+	}
+	base, _, _ := strings.Cut(tag, "-")
+	for _, l := range locales {
+		lb, _, _ := strings.Cut(l, "-")
+		if strings.EqualFold(lb, base) {
+			return l
+		}
+	}
+	return ""
+}
+
+// MatchAcceptLanguage returns the supported locale preferred by an
+// Accept-Language header, or "" if none of its languages is supported.
+func MatchAcceptLanguage(header string) string {
+	type candidate struct {
+		tag string
+		q   float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if tag != "" && tag != "*" && q > 0 {
+			candidates = append(candidates, candidate{tag: tag, q: q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	for _, c := range candidates {
+		if l := Normalize(c.tag); l != "" {
+			return l
+		}
+	}
+	return ""
+}
+
+// T translates key into locale and formats it with args. Keys missing from
+// the catalogue fall back to DefaultLocale, then to the key itself.
+func T(locale, key string, args ...any) string {
+	msg, ok := catalogues[locale][key]
+	if !ok {
+		if msg, ok = catalogues[DefaultLocale][key]; !ok {
+			msg = key
+		}
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Translator returns T bound to locale, to be used as a template function.
+func Translator(locale string) func(key string, args ...any) string {
+	return func(key string, args ...any) string {
+		return T(locale, key, args...)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+import "testing"
+
+func TestCataloguesHaveSameKeys(t *testing.T) {
+	for _, l := range Locales() {
+		for key := range catalogues[DefaultLocale] {
+			if _, ok := catalogues[l][key]; !ok {
+				t.Errorf("%s is missing %q", l, key)
+			}
+		}
+		for key := range catalogues[l] {
+			if _, ok := catalogues[DefaultLocale][key]; !ok {
+				t.Errorf("%s has %q which is not in %s", l, key, DefaultLocale)
+			}
+		}
+	}
+}
+
+func TestMatchAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                           "",
+		"fr-FR":                      "",
+		"zh-CN,zh;q=0.9,en;q=0.8":    "zh-CN",
+		"en-US,en;q=0.9":             "en",
+		"fr;q=1.0, zh-TW;q=0.5, *":   "zh-CN",
+		"en;q=0.2, zh-Hans-CN;q=0.7": "zh-CN",
+	}
+	for header, want := range cases {
+		if got := MatchAcceptLanguage(header); got != want {
+			t.Errorf("MatchAcceptLanguage(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	if got := T("zh-CN", "email.order_confirmation.subject", "42"); got != "您的 CloudWeGo 商城订单 42" {
+		t.Errorf("unexpected translation: %q", got)
+	}
+	if got := T("fr", "nav.about"); got != "About" {
+		t.Errorf("unsupported locales should fall back to %s, got %q", DefaultLocale, got)
+	}
+	if got := T("en", "no.such.key"); got != "no.such.key" {
+		t.Errorf("missing keys should be returned as is, got %q", got)
+	}
+}
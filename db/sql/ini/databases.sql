@@ -14,4 +14,10 @@ CREATE DATABASE IF NOT EXISTS `product`
     DEFAULT CHARACTER SET = 'utf8mb4';
 
 CREATE DATABASE IF NOT EXISTS `user`
-    DEFAULT CHARACTER SET = 'utf8mb4';
\ No newline at end of file
+    DEFAULT CHARACTER SET = 'utf8mb4';
+
+CREATE DATABASE IF NOT EXISTS `email`
+    DEFAULT CHARACTER SET = 'utf8mb4';
+
+CREATE DATABASE IF NOT EXISTS `promotion`
+    DEFAULT CHARACTER SET = 'utf8mb4';
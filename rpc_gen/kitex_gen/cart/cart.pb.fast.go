@@ -24,6 +24,11 @@ func (x *CartItem) FastRead(buf []byte, _type int8, number int32) (offset int, e
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -47,6 +52,11 @@ func (x *CartItem) fastReadField2(buf []byte, _type int8) (offset int, err error
 	return offset, err
 }
 
+func (x *CartItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.SkuId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
 func (x *AddItemReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -239,6 +249,7 @@ func (x *CartItem) FastWrite(buf []byte) (offset int) {
 	}
 	offset += x.fastWriteField1(buf[offset:])
 	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
 	return offset
 }
 
@@ -258,6 +269,14 @@ func (x *CartItem) fastWriteField2(buf []byte) (offset int) {
 	return offset
 }
 
+func (x *CartItem) fastWriteField3(buf []byte) (offset int) {
+	if x.SkuId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetSkuId())
+	return offset
+}
+
 func (x *AddItemReq) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
@@ -378,6 +397,7 @@ func (x *CartItem) Size() (n int) {
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
+	n += x.sizeField3()
 	return n
 }
 
@@ -397,6 +417,14 @@ func (x *CartItem) sizeField2() (n int) {
 	return n
 }
 
+func (x *CartItem) sizeField3() (n int) {
+	if x.SkuId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(3, x.GetSkuId())
+	return n
+}
+
 func (x *AddItemReq) Size() (n int) {
 	if x == nil {
 		return n
@@ -514,6 +542,7 @@ func (x *EmptyCartResp) Size() (n int) {
 var fieldIDToName_CartItem = map[int32]string{
 	1: "ProductId",
 	2: "Quantity",
+	3: "SkuId",
 }
 
 var fieldIDToName_AddItemReq = map[int32]string{
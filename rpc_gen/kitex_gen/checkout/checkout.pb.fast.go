@@ -110,6 +110,51 @@ func (x *CheckoutReq) FastRead(buf []byte, _type int8, number int32) (offset int
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -163,6 +208,56 @@ func (x *CheckoutReq) fastReadField6(buf []byte, _type int8) (offset int, err er
 	return offset, nil
 }
 
+func (x *CheckoutReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Locale, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.CouponCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.QuoteId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.BillingAddress = &v
+	return offset, nil
+}
+
+func (x *CheckoutReq) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.ClientIp, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.GiftCardCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutReq) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	x.UseWallet, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
 func (x *CheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -175,6 +270,11 @@ func (x *CheckoutResp) FastRead(buf []byte, _type int8, number int32) (offset in
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -188,155 +288,2406 @@ ReadFieldError:
 	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CheckoutResp[number], err)
 }
 
-func (x *CheckoutResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *CheckoutResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.CheckoutId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutStatus) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CheckoutStatus[number], err)
+}
+
+func (x *CheckoutStatus) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.CheckoutId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutStatus) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutStatus) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Step, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutStatus) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Error, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutStatus) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutStatus) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CheckoutStatus) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.UpdatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetCheckoutStatusReq[number], err)
+}
+
+func (x *GetCheckoutStatusReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CheckoutId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetCheckoutStatusResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetCheckoutStatusResp[number], err)
+}
+
+func (x *GetCheckoutStatusResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v CheckoutStatus
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Status = &v
+	return offset, nil
+}
+
+func (x *QuoteReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QuoteReq[number], err)
+}
+
+func (x *QuoteReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CouponCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *QuoteReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QuoteLine[number], err)
+}
+
+func (x *QuoteLine) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.SkuId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Picture, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.UnitPrice, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Cost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteLine) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuotePromotion) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QuotePromotion[number], err)
+}
+
+func (x *QuotePromotion) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuotePromotion) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuotePromotion) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteTax) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QuoteTax[number], err)
+}
+
+func (x *QuoteTax) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteTax) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Rate, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteTax) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Quote[number], err)
+}
+
+func (x *Quote) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.QuoteId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v QuoteLine
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Lines = append(x.Lines, &v)
+	return offset, nil
+}
+
+func (x *Quote) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v QuotePromotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Promotions = append(x.Promotions, &v)
+	return offset, nil
+}
+
+func (x *Quote) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Subtotal, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Shipping, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.TaxInclusive, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *Quote) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	var v QuoteTax
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Taxes = append(x.Taxes, &v)
+	return offset, nil
+}
+
+func (x *Quote) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	var v ShippingOption
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.ShippingOption = &v
+	return offset, nil
+}
+
+func (x *Quote) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.ExchangeRate, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *QuoteResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_QuoteResp[number], err)
+}
+
+func (x *QuoteResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Quote
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Quote = &v
+	return offset, nil
+}
+
+func (x *ShippingOption) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ShippingOption[number], err)
+}
+
+func (x *ShippingOption) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Method, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingOption) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingOption) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Days, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ShippingOption) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Price, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *ListShippingOptionsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListShippingOptionsReq[number], err)
+}
+
+func (x *ListShippingOptionsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ListShippingOptionsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CouponCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListShippingOptionsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Address
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Address = &v
+	return offset, nil
+}
+
+func (x *ListShippingOptionsReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListShippingOptionsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListShippingOptionsResp[number], err)
+}
+
+func (x *ListShippingOptionsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v ShippingOption
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Options = append(x.Options, &v)
+	return offset, nil
+}
+
+func (x *ReviewOrderReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReviewOrderReq[number], err)
+}
+
+func (x *ReviewOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReviewOrderReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Approve, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ReviewOrderReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Reviewer, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReviewOrderReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Note, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReviewOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReviewOrderResp[number], err)
+}
+
+func (x *ReviewOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.State, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Address) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *Address) fastWriteField1(buf []byte) (offset int) {
+	if x.StreetAddress == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetStreetAddress())
+	return offset
+}
+
+func (x *Address) fastWriteField2(buf []byte) (offset int) {
+	if x.City == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCity())
+	return offset
+}
+
+func (x *Address) fastWriteField3(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetState())
+	return offset
+}
+
+func (x *Address) fastWriteField4(buf []byte) (offset int) {
+	if x.Country == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCountry())
+	return offset
+}
+
+func (x *Address) fastWriteField5(buf []byte) (offset int) {
+	if x.ZipCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetZipCode())
+	return offset
+}
+
+func (x *CheckoutReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Firstname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetFirstname())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Lastname == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetLastname())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField6(buf []byte) (offset int) {
+	if x.CreditCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 6, x.GetCreditCard())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField7(buf []byte) (offset int) {
+	if x.Locale == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetLocale())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField8(buf []byte) (offset int) {
+	if x.CouponCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCouponCode())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField9(buf []byte) (offset int) {
+	if x.QuoteId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetQuoteId())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField10(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 10, x.GetShippingMethod())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField11(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 11, x.GetCurrency())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField12(buf []byte) (offset int) {
+	if x.BillingAddress == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 12, x.GetBillingAddress())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField13(buf []byte) (offset int) {
+	if x.ClientIp == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 13, x.GetClientIp())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField14(buf []byte) (offset int) {
+	if x.GiftCardCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 14, x.GetGiftCardCode())
+	return offset
+}
+
+func (x *CheckoutReq) fastWriteField15(buf []byte) (offset int) {
+	if !x.UseWallet {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 15, x.GetUseWallet())
+	return offset
+}
+
+func (x *CheckoutResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *CheckoutResp) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *CheckoutResp) fastWriteField2(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTransactionId())
+	return offset
+}
+
+func (x *CheckoutResp) fastWriteField3(buf []byte) (offset int) {
+	if x.CheckoutId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCheckoutId())
+	return offset
+}
+
+func (x *CheckoutStatus) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	return offset
+}
+
+func (x *CheckoutStatus) fastWriteField1(buf []byte) (offset int) {
+	if x.CheckoutId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCheckoutId())
+	return offset
+}
+
+func (x *CheckoutStatus) fastWriteField2(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetState())
+	return offset
+}
+
+func (x *CheckoutStatus) fastWriteField3(buf []byte) (offset int) {
+	if x.Step == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetStep())
+	return offset
+}
+
+func (x *CheckoutStatus) fastWriteField4(buf []byte) (offset int) {
+	if x.Error == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetError())
+	return offset
+}
+
+func (x *CheckoutStatus) fastWriteField5(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetOrderId())
+	return offset
+}
+
+func (x *CheckoutStatus) fastWriteField6(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetTransactionId())
+	return offset
+}
+
+func (x *CheckoutStatus) fastWriteField7(buf []byte) (offset int) {
+	if x.UpdatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 7, x.GetUpdatedAt())
+	return offset
+}
+
+func (x *GetCheckoutStatusReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *GetCheckoutStatusReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *GetCheckoutStatusReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CheckoutId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCheckoutId())
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetCheckoutStatusResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Status == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetStatus())
+	return offset
+}
+
+func (x *QuoteReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CouponCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCouponCode())
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.GetAddress())
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField4(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetShippingMethod())
+	return offset
+}
+
+func (x *QuoteReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetCurrency())
+	return offset
+}
+
+func (x *QuoteLine) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField2(buf []byte) (offset int) {
+	if x.SkuId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetSkuId())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField3(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetName())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField4(buf []byte) (offset int) {
+	if x.Picture == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPicture())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField5(buf []byte) (offset int) {
+	if x.UnitPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetUnitPrice())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField6(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 6, x.GetQuantity())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField7(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetDiscount())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField8(buf []byte) (offset int) {
+	if x.Cost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 8, x.GetCost())
+	return offset
+}
+
+func (x *QuoteLine) fastWriteField9(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 9, x.GetTax())
+	return offset
+}
+
+func (x *QuotePromotion) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *QuotePromotion) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *QuotePromotion) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCode())
+	return offset
+}
+
+func (x *QuotePromotion) fastWriteField3(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetDiscount())
+	return offset
+}
+
+func (x *QuoteTax) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *QuoteTax) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *QuoteTax) fastWriteField2(buf []byte) (offset int) {
+	if x.Rate == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetRate())
+	return offset
+}
+
+func (x *QuoteTax) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *Quote) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	return offset
+}
+
+func (x *Quote) fastWriteField1(buf []byte) (offset int) {
+	if x.QuoteId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetQuoteId())
+	return offset
+}
+
+func (x *Quote) fastWriteField2(buf []byte) (offset int) {
+	if x.Lines == nil {
+		return offset
+	}
+	for i := range x.GetLines() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetLines()[i])
+	}
+	return offset
+}
+
+func (x *Quote) fastWriteField3(buf []byte) (offset int) {
+	if x.Promotions == nil {
+		return offset
+	}
+	for i := range x.GetPromotions() {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.GetPromotions()[i])
+	}
+	return offset
+}
+
+func (x *Quote) fastWriteField4(buf []byte) (offset int) {
+	if x.Subtotal == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetSubtotal())
+	return offset
+}
+
+func (x *Quote) fastWriteField5(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetDiscount())
+	return offset
+}
+
+func (x *Quote) fastWriteField6(buf []byte) (offset int) {
+	if x.Shipping == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 6, x.GetShipping())
+	return offset
+}
+
+func (x *Quote) fastWriteField7(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetTax())
+	return offset
+}
+
+func (x *Quote) fastWriteField8(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 8, x.GetTotal())
+	return offset
+}
+
+func (x *Quote) fastWriteField9(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetCurrency())
+	return offset
+}
+
+func (x *Quote) fastWriteField10(buf []byte) (offset int) {
+	if !x.TaxInclusive {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 10, x.GetTaxInclusive())
+	return offset
+}
+
+func (x *Quote) fastWriteField11(buf []byte) (offset int) {
+	if x.Taxes == nil {
+		return offset
+	}
+	for i := range x.GetTaxes() {
+		offset += fastpb.WriteMessage(buf[offset:], 11, x.GetTaxes()[i])
+	}
+	return offset
+}
+
+func (x *Quote) fastWriteField12(buf []byte) (offset int) {
+	if x.ShippingOption == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 12, x.GetShippingOption())
+	return offset
+}
+
+func (x *Quote) fastWriteField13(buf []byte) (offset int) {
+	if x.ExchangeRate == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 13, x.GetExchangeRate())
+	return offset
+}
+
+func (x *QuoteResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *QuoteResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Quote == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetQuote())
+	return offset
+}
+
+func (x *ShippingOption) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ShippingOption) fastWriteField1(buf []byte) (offset int) {
+	if x.Method == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetMethod())
+	return offset
+}
+
+func (x *ShippingOption) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *ShippingOption) fastWriteField3(buf []byte) (offset int) {
+	if x.Days == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetDays())
+	return offset
+}
+
+func (x *ShippingOption) fastWriteField4(buf []byte) (offset int) {
+	if x.Price == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetPrice())
+	return offset
+}
+
+func (x *ListShippingOptionsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ListShippingOptionsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ListShippingOptionsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CouponCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCouponCode())
+	return offset
+}
+
+func (x *ListShippingOptionsReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.GetAddress())
+	return offset
+}
+
+func (x *ListShippingOptionsReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCurrency())
+	return offset
+}
+
+func (x *ListShippingOptionsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListShippingOptionsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Options == nil {
+		return offset
+	}
+	for i := range x.GetOptions() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOptions()[i])
+	}
+	return offset
+}
+
+func (x *ReviewOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ReviewOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *ReviewOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if !x.Approve {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 2, x.GetApprove())
+	return offset
+}
+
+func (x *ReviewOrderReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Reviewer == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetReviewer())
+	return offset
+}
+
+func (x *ReviewOrderReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Note == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetNote())
+	return offset
+}
+
+func (x *ReviewOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ReviewOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.State == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetState())
+	return offset
+}
+
+func (x *Address) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Address) sizeField1() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetStreetAddress())
+	return n
+}
+
+func (x *Address) sizeField2() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCity())
+	return n
+}
+
+func (x *Address) sizeField3() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetState())
+	return n
+}
+
+func (x *Address) sizeField4() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCountry())
+	return n
+}
+
+func (x *Address) sizeField5() (n int) {
+	if x.ZipCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetZipCode())
+	return n
+}
+
+func (x *CheckoutReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	return n
+}
+
+func (x *CheckoutReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *CheckoutReq) sizeField2() (n int) {
+	if x.Firstname == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetFirstname())
+	return n
+}
+
+func (x *CheckoutReq) sizeField3() (n int) {
+	if x.Lastname == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetLastname())
+	return n
+}
+
+func (x *CheckoutReq) sizeField4() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetEmail())
+	return n
+}
+
+func (x *CheckoutReq) sizeField5() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(5, x.GetAddress())
+	return n
+}
+
+func (x *CheckoutReq) sizeField6() (n int) {
+	if x.CreditCard == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(6, x.GetCreditCard())
+	return n
+}
+
+func (x *CheckoutReq) sizeField7() (n int) {
+	if x.Locale == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetLocale())
+	return n
+}
+
+func (x *CheckoutReq) sizeField8() (n int) {
+	if x.CouponCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCouponCode())
+	return n
+}
+
+func (x *CheckoutReq) sizeField9() (n int) {
+	if x.QuoteId == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetQuoteId())
+	return n
+}
+
+func (x *CheckoutReq) sizeField10() (n int) {
+	if x.ShippingMethod == "" {
+		return n
+	}
+	n += fastpb.SizeString(10, x.GetShippingMethod())
+	return n
+}
+
+func (x *CheckoutReq) sizeField11() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(11, x.GetCurrency())
+	return n
+}
+
+func (x *CheckoutReq) sizeField12() (n int) {
+	if x.BillingAddress == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(12, x.GetBillingAddress())
+	return n
+}
+
+func (x *CheckoutReq) sizeField13() (n int) {
+	if x.ClientIp == "" {
+		return n
+	}
+	n += fastpb.SizeString(13, x.GetClientIp())
+	return n
+}
+
+func (x *CheckoutReq) sizeField14() (n int) {
+	if x.GiftCardCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(14, x.GetGiftCardCode())
+	return n
+}
+
+func (x *CheckoutReq) sizeField15() (n int) {
+	if !x.UseWallet {
+		return n
+	}
+	n += fastpb.SizeBool(15, x.GetUseWallet())
+	return n
+}
+
+func (x *CheckoutResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *CheckoutResp) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *CheckoutResp) sizeField2() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetTransactionId())
+	return n
+}
+
+func (x *CheckoutResp) sizeField3() (n int) {
+	if x.CheckoutId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetCheckoutId())
+	return n
+}
+
+func (x *CheckoutStatus) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	return n
+}
+
+func (x *CheckoutStatus) sizeField1() (n int) {
+	if x.CheckoutId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCheckoutId())
+	return n
+}
+
+func (x *CheckoutStatus) sizeField2() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetState())
+	return n
+}
+
+func (x *CheckoutStatus) sizeField3() (n int) {
+	if x.Step == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetStep())
+	return n
+}
+
+func (x *CheckoutStatus) sizeField4() (n int) {
+	if x.Error == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetError())
+	return n
+}
+
+func (x *CheckoutStatus) sizeField5() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetOrderId())
+	return n
+}
+
+func (x *CheckoutStatus) sizeField6() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetTransactionId())
+	return n
+}
+
+func (x *CheckoutStatus) sizeField7() (n int) {
+	if x.UpdatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(7, x.GetUpdatedAt())
+	return n
+}
+
+func (x *GetCheckoutStatusReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetCheckoutStatusReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *GetCheckoutStatusReq) sizeField2() (n int) {
+	if x.CheckoutId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCheckoutId())
+	return n
+}
+
+func (x *GetCheckoutStatusResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetCheckoutStatusResp) sizeField1() (n int) {
+	if x.Status == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetStatus())
+	return n
+}
+
+func (x *QuoteReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *QuoteReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *QuoteReq) sizeField2() (n int) {
+	if x.CouponCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCouponCode())
+	return n
+}
+
+func (x *QuoteReq) sizeField3() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.GetAddress())
+	return n
+}
+
+func (x *QuoteReq) sizeField4() (n int) {
+	if x.ShippingMethod == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetShippingMethod())
+	return n
+}
+
+func (x *QuoteReq) sizeField5() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetCurrency())
+	return n
+}
+
+func (x *QuoteLine) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	return n
+}
+
+func (x *QuoteLine) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetProductId())
+	return n
+}
+
+func (x *QuoteLine) sizeField2() (n int) {
+	if x.SkuId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(2, x.GetSkuId())
+	return n
+}
+
+func (x *QuoteLine) sizeField3() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetName())
+	return n
+}
+
+func (x *QuoteLine) sizeField4() (n int) {
+	if x.Picture == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetPicture())
+	return n
+}
+
+func (x *QuoteLine) sizeField5() (n int) {
+	if x.UnitPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetUnitPrice())
+	return n
+}
+
+func (x *QuoteLine) sizeField6() (n int) {
+	if x.Quantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(6, x.GetQuantity())
+	return n
+}
+
+func (x *QuoteLine) sizeField7() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(7, x.GetDiscount())
+	return n
+}
+
+func (x *QuoteLine) sizeField8() (n int) {
+	if x.Cost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(8, x.GetCost())
+	return n
+}
+
+func (x *QuoteLine) sizeField9() (n int) {
+	if x.Tax == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(9, x.GetTax())
+	return n
+}
+
+func (x *QuotePromotion) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *QuotePromotion) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *QuotePromotion) sizeField2() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCode())
+	return n
+}
+
+func (x *QuotePromotion) sizeField3() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetDiscount())
+	return n
+}
+
+func (x *QuoteTax) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *QuoteTax) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *QuoteTax) sizeField2() (n int) {
+	if x.Rate == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetRate())
+	return n
 }
 
-func (x *CheckoutResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *QuoteTax) sizeField3() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetAmount())
+	return n
 }
 
-func (x *Address) FastWrite(buf []byte) (offset int) {
+func (x *Quote) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	return n
 }
 
-func (x *Address) fastWriteField1(buf []byte) (offset int) {
-	if x.StreetAddress == "" {
-		return offset
+func (x *Quote) sizeField1() (n int) {
+	if x.QuoteId == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetStreetAddress())
-	return offset
+	n += fastpb.SizeString(1, x.GetQuoteId())
+	return n
 }
 
-func (x *Address) fastWriteField2(buf []byte) (offset int) {
-	if x.City == "" {
-		return offset
+func (x *Quote) sizeField2() (n int) {
+	if x.Lines == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetCity())
-	return offset
+	for i := range x.GetLines() {
+		n += fastpb.SizeMessage(2, x.GetLines()[i])
+	}
+	return n
 }
 
-func (x *Address) fastWriteField3(buf []byte) (offset int) {
-	if x.State == "" {
-		return offset
+func (x *Quote) sizeField3() (n int) {
+	if x.Promotions == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetState())
-	return offset
+	for i := range x.GetPromotions() {
+		n += fastpb.SizeMessage(3, x.GetPromotions()[i])
+	}
+	return n
 }
 
-func (x *Address) fastWriteField4(buf []byte) (offset int) {
-	if x.Country == "" {
-		return offset
+func (x *Quote) sizeField4() (n int) {
+	if x.Subtotal == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetCountry())
-	return offset
+	n += fastpb.SizeFloat(4, x.GetSubtotal())
+	return n
 }
 
-func (x *Address) fastWriteField5(buf []byte) (offset int) {
-	if x.ZipCode == "" {
-		return offset
+func (x *Quote) sizeField5() (n int) {
+	if x.Discount == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 5, x.GetZipCode())
-	return offset
+	n += fastpb.SizeFloat(5, x.GetDiscount())
+	return n
 }
 
-func (x *CheckoutReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *Quote) sizeField6() (n int) {
+	if x.Shipping == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	offset += x.fastWriteField6(buf[offset:])
-	return offset
+	n += fastpb.SizeFloat(6, x.GetShipping())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *Quote) sizeField7() (n int) {
+	if x.Tax == 0 {
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += fastpb.SizeFloat(7, x.GetTax())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField2(buf []byte) (offset int) {
-	if x.Firstname == "" {
-		return offset
+func (x *Quote) sizeField8() (n int) {
+	if x.Total == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetFirstname())
-	return offset
+	n += fastpb.SizeFloat(8, x.GetTotal())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField3(buf []byte) (offset int) {
-	if x.Lastname == "" {
-		return offset
+func (x *Quote) sizeField9() (n int) {
+	if x.Currency == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetLastname())
-	return offset
+	n += fastpb.SizeString(9, x.GetCurrency())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField4(buf []byte) (offset int) {
-	if x.Email == "" {
-		return offset
+func (x *Quote) sizeField10() (n int) {
+	if !x.TaxInclusive {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetEmail())
-	return offset
+	n += fastpb.SizeBool(10, x.GetTaxInclusive())
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField5(buf []byte) (offset int) {
-	if x.Address == nil {
-		return offset
+func (x *Quote) sizeField11() (n int) {
+	if x.Taxes == nil {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
-	return offset
+	for i := range x.GetTaxes() {
+		n += fastpb.SizeMessage(11, x.GetTaxes()[i])
+	}
+	return n
 }
 
-func (x *CheckoutReq) fastWriteField6(buf []byte) (offset int) {
-	if x.CreditCard == nil {
-		return offset
+func (x *Quote) sizeField12() (n int) {
+	if x.ShippingOption == nil {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 6, x.GetCreditCard())
-	return offset
+	n += fastpb.SizeMessage(12, x.GetShippingOption())
+	return n
 }
 
-func (x *CheckoutResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *Quote) sizeField13() (n int) {
+	if x.ExchangeRate == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeDouble(13, x.GetExchangeRate())
+	return n
 }
 
-func (x *CheckoutResp) fastWriteField1(buf []byte) (offset int) {
-	if x.OrderId == "" {
-		return offset
+func (x *QuoteResp) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
-	return offset
+	n += x.sizeField1()
+	return n
 }
 
-func (x *CheckoutResp) fastWriteField2(buf []byte) (offset int) {
-	if x.TransactionId == "" {
-		return offset
+func (x *QuoteResp) sizeField1() (n int) {
+	if x.Quote == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetTransactionId())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetQuote())
+	return n
 }
 
-func (x *Address) Size() (n int) {
+func (x *ShippingOption) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -344,51 +2695,42 @@ func (x *Address) Size() (n int) {
 	n += x.sizeField2()
 	n += x.sizeField3()
 	n += x.sizeField4()
-	n += x.sizeField5()
-	return n
-}
-
-func (x *Address) sizeField1() (n int) {
-	if x.StreetAddress == "" {
-		return n
-	}
-	n += fastpb.SizeString(1, x.GetStreetAddress())
 	return n
 }
 
-func (x *Address) sizeField2() (n int) {
-	if x.City == "" {
+func (x *ShippingOption) sizeField1() (n int) {
+	if x.Method == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetCity())
+	n += fastpb.SizeString(1, x.GetMethod())
 	return n
 }
 
-func (x *Address) sizeField3() (n int) {
-	if x.State == "" {
+func (x *ShippingOption) sizeField2() (n int) {
+	if x.Name == "" {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetState())
+	n += fastpb.SizeString(2, x.GetName())
 	return n
 }
 
-func (x *Address) sizeField4() (n int) {
-	if x.Country == "" {
+func (x *ShippingOption) sizeField3() (n int) {
+	if x.Days == "" {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetCountry())
+	n += fastpb.SizeString(3, x.GetDays())
 	return n
 }
 
-func (x *Address) sizeField5() (n int) {
-	if x.ZipCode == "" {
+func (x *ShippingOption) sizeField4() (n int) {
+	if x.Price == 0 {
 		return n
 	}
-	n += fastpb.SizeString(5, x.GetZipCode())
+	n += fastpb.SizeFloat(4, x.GetPrice())
 	return n
 }
 
-func (x *CheckoutReq) Size() (n int) {
+func (x *ListShippingOptionsReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -396,12 +2738,10 @@ func (x *CheckoutReq) Size() (n int) {
 	n += x.sizeField2()
 	n += x.sizeField3()
 	n += x.sizeField4()
-	n += x.sizeField5()
-	n += x.sizeField6()
 	return n
 }
 
-func (x *CheckoutReq) sizeField1() (n int) {
+func (x *ListShippingOptionsReq) sizeField1() (n int) {
 	if x.UserId == 0 {
 		return n
 	}
@@ -409,56 +2749,60 @@ func (x *CheckoutReq) sizeField1() (n int) {
 	return n
 }
 
-func (x *CheckoutReq) sizeField2() (n int) {
-	if x.Firstname == "" {
+func (x *ListShippingOptionsReq) sizeField2() (n int) {
+	if x.CouponCode == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetFirstname())
+	n += fastpb.SizeString(2, x.GetCouponCode())
 	return n
 }
 
-func (x *CheckoutReq) sizeField3() (n int) {
-	if x.Lastname == "" {
+func (x *ListShippingOptionsReq) sizeField3() (n int) {
+	if x.Address == nil {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetLastname())
+	n += fastpb.SizeMessage(3, x.GetAddress())
 	return n
 }
 
-func (x *CheckoutReq) sizeField4() (n int) {
-	if x.Email == "" {
+func (x *ListShippingOptionsReq) sizeField4() (n int) {
+	if x.Currency == "" {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetEmail())
+	n += fastpb.SizeString(4, x.GetCurrency())
 	return n
 }
 
-func (x *CheckoutReq) sizeField5() (n int) {
-	if x.Address == nil {
+func (x *ListShippingOptionsResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeMessage(5, x.GetAddress())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *CheckoutReq) sizeField6() (n int) {
-	if x.CreditCard == nil {
+func (x *ListShippingOptionsResp) sizeField1() (n int) {
+	if x.Options == nil {
 		return n
 	}
-	n += fastpb.SizeMessage(6, x.GetCreditCard())
+	for i := range x.GetOptions() {
+		n += fastpb.SizeMessage(1, x.GetOptions()[i])
+	}
 	return n
 }
 
-func (x *CheckoutResp) Size() (n int) {
+func (x *ReviewOrderReq) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
 	return n
 }
 
-func (x *CheckoutResp) sizeField1() (n int) {
+func (x *ReviewOrderReq) sizeField1() (n int) {
 	if x.OrderId == "" {
 		return n
 	}
@@ -466,11 +2810,43 @@ func (x *CheckoutResp) sizeField1() (n int) {
 	return n
 }
 
-func (x *CheckoutResp) sizeField2() (n int) {
-	if x.TransactionId == "" {
+func (x *ReviewOrderReq) sizeField2() (n int) {
+	if !x.Approve {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetTransactionId())
+	n += fastpb.SizeBool(2, x.GetApprove())
+	return n
+}
+
+func (x *ReviewOrderReq) sizeField3() (n int) {
+	if x.Reviewer == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetReviewer())
+	return n
+}
+
+func (x *ReviewOrderReq) sizeField4() (n int) {
+	if x.Note == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetNote())
+	return n
+}
+
+func (x *ReviewOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ReviewOrderResp) sizeField1() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetState())
 	return n
 }
 
@@ -483,17 +2859,127 @@ var fieldIDToName_Address = map[int32]string{
 }
 
 var fieldIDToName_CheckoutReq = map[int32]string{
-	1: "UserId",
-	2: "Firstname",
-	3: "Lastname",
-	4: "Email",
-	5: "Address",
-	6: "CreditCard",
+	1:  "UserId",
+	2:  "Firstname",
+	3:  "Lastname",
+	4:  "Email",
+	5:  "Address",
+	6:  "CreditCard",
+	7:  "Locale",
+	8:  "CouponCode",
+	9:  "QuoteId",
+	10: "ShippingMethod",
+	11: "Currency",
+	12: "BillingAddress",
+	13: "ClientIp",
+	14: "GiftCardCode",
+	15: "UseWallet",
 }
 
 var fieldIDToName_CheckoutResp = map[int32]string{
 	1: "OrderId",
 	2: "TransactionId",
+	3: "CheckoutId",
+}
+
+var fieldIDToName_CheckoutStatus = map[int32]string{
+	1: "CheckoutId",
+	2: "State",
+	3: "Step",
+	4: "Error",
+	5: "OrderId",
+	6: "TransactionId",
+	7: "UpdatedAt",
+}
+
+var fieldIDToName_GetCheckoutStatusReq = map[int32]string{
+	1: "UserId",
+	2: "CheckoutId",
+}
+
+var fieldIDToName_GetCheckoutStatusResp = map[int32]string{
+	1: "Status",
+}
+
+var fieldIDToName_QuoteReq = map[int32]string{
+	1: "UserId",
+	2: "CouponCode",
+	3: "Address",
+	4: "ShippingMethod",
+	5: "Currency",
+}
+
+var fieldIDToName_QuoteLine = map[int32]string{
+	1: "ProductId",
+	2: "SkuId",
+	3: "Name",
+	4: "Picture",
+	5: "UnitPrice",
+	6: "Quantity",
+	7: "Discount",
+	8: "Cost",
+	9: "Tax",
+}
+
+var fieldIDToName_QuotePromotion = map[int32]string{
+	1: "Name",
+	2: "Code",
+	3: "Discount",
+}
+
+var fieldIDToName_QuoteTax = map[int32]string{
+	1: "Name",
+	2: "Rate",
+	3: "Amount",
+}
+
+var fieldIDToName_Quote = map[int32]string{
+	1:  "QuoteId",
+	2:  "Lines",
+	3:  "Promotions",
+	4:  "Subtotal",
+	5:  "Discount",
+	6:  "Shipping",
+	7:  "Tax",
+	8:  "Total",
+	9:  "Currency",
+	10: "TaxInclusive",
+	11: "Taxes",
+	12: "ShippingOption",
+	13: "ExchangeRate",
+}
+
+var fieldIDToName_QuoteResp = map[int32]string{
+	1: "Quote",
+}
+
+var fieldIDToName_ShippingOption = map[int32]string{
+	1: "Method",
+	2: "Name",
+	3: "Days",
+	4: "Price",
+}
+
+var fieldIDToName_ListShippingOptionsReq = map[int32]string{
+	1: "UserId",
+	2: "CouponCode",
+	3: "Address",
+	4: "Currency",
+}
+
+var fieldIDToName_ListShippingOptionsResp = map[int32]string{
+	1: "Options",
+}
+
+var fieldIDToName_ReviewOrderReq = map[int32]string{
+	1: "OrderId",
+	2: "Approve",
+	3: "Reviewer",
+	4: "Note",
+}
+
+var fieldIDToName_ReviewOrderResp = map[int32]string{
+	1: "State",
 }
 
 var _ = payment.File_payment_proto
@@ -21,7 +21,11 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "CheckoutService"
 	handlerType := (*checkout.CheckoutService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Checkout": kitex.NewMethodInfo(checkoutHandler, newCheckoutArgs, newCheckoutResult, false),
+		"Checkout":            kitex.NewMethodInfo(checkoutHandler, newCheckoutArgs, newCheckoutResult, false),
+		"GetCheckoutStatus":   kitex.NewMethodInfo(getCheckoutStatusHandler, newGetCheckoutStatusArgs, newGetCheckoutStatusResult, false),
+		"Quote":               kitex.NewMethodInfo(quoteHandler, newQuoteArgs, newQuoteResult, false),
+		"ListShippingOptions": kitex.NewMethodInfo(listShippingOptionsHandler, newListShippingOptionsArgs, newListShippingOptionsResult, false),
+		"ReviewOrder":         kitex.NewMethodInfo(reviewOrderHandler, newReviewOrderArgs, newReviewOrderResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "checkout",
@@ -191,6 +195,618 @@ func (p *CheckoutResult) GetResult() interface{} {
 	return p.Success
 }
 
+func getCheckoutStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.GetCheckoutStatusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).GetCheckoutStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetCheckoutStatusArgs:
+		success, err := handler.(checkout.CheckoutService).GetCheckoutStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetCheckoutStatusResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetCheckoutStatusArgs() interface{} {
+	return &GetCheckoutStatusArgs{}
+}
+
+func newGetCheckoutStatusResult() interface{} {
+	return &GetCheckoutStatusResult{}
+}
+
+type GetCheckoutStatusArgs struct {
+	Req *checkout.GetCheckoutStatusReq
+}
+
+func (p *GetCheckoutStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.GetCheckoutStatusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetCheckoutStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetCheckoutStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetCheckoutStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetCheckoutStatusArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.GetCheckoutStatusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetCheckoutStatusArgs_Req_DEFAULT *checkout.GetCheckoutStatusReq
+
+func (p *GetCheckoutStatusArgs) GetReq() *checkout.GetCheckoutStatusReq {
+	if !p.IsSetReq() {
+		return GetCheckoutStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetCheckoutStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetCheckoutStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetCheckoutStatusResult struct {
+	Success *checkout.GetCheckoutStatusResp
+}
+
+var GetCheckoutStatusResult_Success_DEFAULT *checkout.GetCheckoutStatusResp
+
+func (p *GetCheckoutStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.GetCheckoutStatusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetCheckoutStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetCheckoutStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetCheckoutStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetCheckoutStatusResult) Unmarshal(in []byte) error {
+	msg := new(checkout.GetCheckoutStatusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetCheckoutStatusResult) GetSuccess() *checkout.GetCheckoutStatusResp {
+	if !p.IsSetSuccess() {
+		return GetCheckoutStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetCheckoutStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.GetCheckoutStatusResp)
+}
+
+func (p *GetCheckoutStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetCheckoutStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func quoteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.QuoteReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).Quote(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *QuoteArgs:
+		success, err := handler.(checkout.CheckoutService).Quote(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*QuoteResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newQuoteArgs() interface{} {
+	return &QuoteArgs{}
+}
+
+func newQuoteResult() interface{} {
+	return &QuoteResult{}
+}
+
+type QuoteArgs struct {
+	Req *checkout.QuoteReq
+}
+
+func (p *QuoteArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.QuoteReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *QuoteArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *QuoteArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *QuoteArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *QuoteArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.QuoteReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var QuoteArgs_Req_DEFAULT *checkout.QuoteReq
+
+func (p *QuoteArgs) GetReq() *checkout.QuoteReq {
+	if !p.IsSetReq() {
+		return QuoteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *QuoteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *QuoteArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type QuoteResult struct {
+	Success *checkout.QuoteResp
+}
+
+var QuoteResult_Success_DEFAULT *checkout.QuoteResp
+
+func (p *QuoteResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.QuoteResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *QuoteResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *QuoteResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *QuoteResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *QuoteResult) Unmarshal(in []byte) error {
+	msg := new(checkout.QuoteResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *QuoteResult) GetSuccess() *checkout.QuoteResp {
+	if !p.IsSetSuccess() {
+		return QuoteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *QuoteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.QuoteResp)
+}
+
+func (p *QuoteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *QuoteResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listShippingOptionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.ListShippingOptionsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).ListShippingOptions(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListShippingOptionsArgs:
+		success, err := handler.(checkout.CheckoutService).ListShippingOptions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListShippingOptionsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListShippingOptionsArgs() interface{} {
+	return &ListShippingOptionsArgs{}
+}
+
+func newListShippingOptionsResult() interface{} {
+	return &ListShippingOptionsResult{}
+}
+
+type ListShippingOptionsArgs struct {
+	Req *checkout.ListShippingOptionsReq
+}
+
+func (p *ListShippingOptionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.ListShippingOptionsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListShippingOptionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListShippingOptionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListShippingOptionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListShippingOptionsArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.ListShippingOptionsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListShippingOptionsArgs_Req_DEFAULT *checkout.ListShippingOptionsReq
+
+func (p *ListShippingOptionsArgs) GetReq() *checkout.ListShippingOptionsReq {
+	if !p.IsSetReq() {
+		return ListShippingOptionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListShippingOptionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListShippingOptionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListShippingOptionsResult struct {
+	Success *checkout.ListShippingOptionsResp
+}
+
+var ListShippingOptionsResult_Success_DEFAULT *checkout.ListShippingOptionsResp
+
+func (p *ListShippingOptionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.ListShippingOptionsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListShippingOptionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListShippingOptionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListShippingOptionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListShippingOptionsResult) Unmarshal(in []byte) error {
+	msg := new(checkout.ListShippingOptionsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListShippingOptionsResult) GetSuccess() *checkout.ListShippingOptionsResp {
+	if !p.IsSetSuccess() {
+		return ListShippingOptionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListShippingOptionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.ListShippingOptionsResp)
+}
+
+func (p *ListShippingOptionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListShippingOptionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func reviewOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(checkout.ReviewOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(checkout.CheckoutService).ReviewOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ReviewOrderArgs:
+		success, err := handler.(checkout.CheckoutService).ReviewOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReviewOrderResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newReviewOrderArgs() interface{} {
+	return &ReviewOrderArgs{}
+}
+
+func newReviewOrderResult() interface{} {
+	return &ReviewOrderResult{}
+}
+
+type ReviewOrderArgs struct {
+	Req *checkout.ReviewOrderReq
+}
+
+func (p *ReviewOrderArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(checkout.ReviewOrderReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ReviewOrderArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ReviewOrderArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ReviewOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReviewOrderArgs) Unmarshal(in []byte) error {
+	msg := new(checkout.ReviewOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReviewOrderArgs_Req_DEFAULT *checkout.ReviewOrderReq
+
+func (p *ReviewOrderArgs) GetReq() *checkout.ReviewOrderReq {
+	if !p.IsSetReq() {
+		return ReviewOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReviewOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReviewOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReviewOrderResult struct {
+	Success *checkout.ReviewOrderResp
+}
+
+var ReviewOrderResult_Success_DEFAULT *checkout.ReviewOrderResp
+
+func (p *ReviewOrderResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(checkout.ReviewOrderResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReviewOrderResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReviewOrderResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReviewOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReviewOrderResult) Unmarshal(in []byte) error {
+	msg := new(checkout.ReviewOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReviewOrderResult) GetSuccess() *checkout.ReviewOrderResp {
+	if !p.IsSetSuccess() {
+		return ReviewOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReviewOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*checkout.ReviewOrderResp)
+}
+
+func (p *ReviewOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReviewOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -210,3 +826,43 @@ func (p *kClient) Checkout(ctx context.Context, Req *checkout.CheckoutReq) (r *c
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq) (r *checkout.GetCheckoutStatusResp, err error) {
+	var _args GetCheckoutStatusArgs
+	_args.Req = Req
+	var _result GetCheckoutStatusResult
+	if err = p.c.Call(ctx, "GetCheckoutStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Quote(ctx context.Context, Req *checkout.QuoteReq) (r *checkout.QuoteResp, err error) {
+	var _args QuoteArgs
+	_args.Req = Req
+	var _result QuoteResult
+	if err = p.c.Call(ctx, "Quote", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListShippingOptions(ctx context.Context, Req *checkout.ListShippingOptionsReq) (r *checkout.ListShippingOptionsResp, err error) {
+	var _args ListShippingOptionsArgs
+	_args.Req = Req
+	var _result ListShippingOptionsResult
+	if err = p.c.Call(ctx, "ListShippingOptions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReviewOrder(ctx context.Context, Req *checkout.ReviewOrderReq) (r *checkout.ReviewOrderResp, err error) {
+	var _args ReviewOrderArgs
+	_args.Req = Req
+	var _result ReviewOrderResult
+	if err = p.c.Call(ctx, "ReviewOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
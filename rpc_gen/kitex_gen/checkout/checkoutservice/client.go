@@ -12,6 +12,10 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Checkout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.CheckoutResp, err error)
+	GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error)
+	Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error)
+	ListShippingOptions(ctx context.Context, Req *checkout.ListShippingOptionsReq, callOptions ...callopt.Option) (r *checkout.ListShippingOptionsResp, err error)
+	ReviewOrder(ctx context.Context, Req *checkout.ReviewOrderReq, callOptions ...callopt.Option) (r *checkout.ReviewOrderResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -47,3 +51,23 @@ func (p *kCheckoutServiceClient) Checkout(ctx context.Context, Req *checkout.Che
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Checkout(ctx, Req)
 }
+
+func (p *kCheckoutServiceClient) GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCheckoutStatus(ctx, Req)
+}
+
+func (p *kCheckoutServiceClient) Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Quote(ctx, Req)
+}
+
+func (p *kCheckoutServiceClient) ListShippingOptions(ctx context.Context, Req *checkout.ListShippingOptionsReq, callOptions ...callopt.Option) (r *checkout.ListShippingOptionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListShippingOptions(ctx, Req)
+}
+
+func (p *kCheckoutServiceClient) ReviewOrder(ctx context.Context, Req *checkout.ReviewOrderReq, callOptions ...callopt.Option) (r *checkout.ReviewOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReviewOrder(ctx, Req)
+}
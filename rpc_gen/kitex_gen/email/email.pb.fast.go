@@ -12,7 +12,7 @@ var (
 	_ = fastpb.Skip
 )
 
-func (x *EmailReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *OrderLine) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
 		offset, err = x.fastReadField1(buf, _type)
@@ -29,13 +29,53 @@ func (x *EmailReq) FastRead(buf []byte, _type int8, number int32) (offset int, e
 		if err != nil {
 			goto ReadFieldError
 		}
-	case 4:
-		offset, err = x.fastReadField4(buf, _type)
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderLine[number], err)
+}
+
+func (x *OrderLine) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderLine) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *OrderLine) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Cost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderConfirmation) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
 		if err != nil {
 			goto ReadFieldError
 		}
-	case 5:
-		offset, err = x.fastReadField5(buf, _type)
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
 		if err != nil {
 			goto ReadFieldError
 		}
@@ -49,36 +89,56 @@ func (x *EmailReq) FastRead(buf []byte, _type int8, number int32) (offset int, e
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EmailReq[number], err)
-}
-
-func (x *EmailReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.From, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderConfirmation[number], err)
 }
 
-func (x *EmailReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.To, offset, err = fastpb.ReadString(buf, _type)
+func (x *OrderConfirmation) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *EmailReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
-	x.ContentType, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *OrderConfirmation) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v OrderLine
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Lines = append(x.Lines, &v)
+	return offset, nil
 }
 
-func (x *EmailReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
-	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+func (x *OrderConfirmation) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadFloat(buf, _type)
 	return offset, err
 }
 
-func (x *EmailReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
-	x.Content, offset, err = fastpb.ReadString(buf, _type)
+func (x *OrderConfirmation) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
 	return offset, err
 }
 
-func (x *EmailResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+func (x *ShippingNotice) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -88,132 +148,2755 @@ func (x *EmailResp) FastRead(buf []byte, _type int8, number int32) (offset int,
 	return offset, nil
 SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ShippingNotice[number], err)
 }
 
-func (x *EmailReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	return offset
+func (x *ShippingNotice) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *EmailReq) fastWriteField1(buf []byte) (offset int) {
-	if x.From == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetFrom())
-	return offset
+func (x *ShippingNotice) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Carrier, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *EmailReq) fastWriteField2(buf []byte) (offset int) {
-	if x.To == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetTo())
-	return offset
+func (x *ShippingNotice) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.TrackingNumber, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *EmailReq) fastWriteField3(buf []byte) (offset int) {
-	if x.ContentType == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetContentType())
-	return offset
+func (x *ShippingNotice) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.TrackingUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *EmailReq) fastWriteField4(buf []byte) (offset int) {
-	if x.Subject == "" {
-		return offset
+func (x *PasswordReset) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetSubject())
-	return offset
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PasswordReset[number], err)
 }
 
-func (x *EmailReq) fastWriteField5(buf []byte) (offset int) {
-	if x.Content == "" {
-		return offset
-	}
-	offset += fastpb.WriteString(buf[offset:], 5, x.GetContent())
-	return offset
+func (x *PasswordReset) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ResetUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *EmailResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
-	}
-	return offset
+func (x *PasswordReset) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ExpiresInMinutes, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
 }
 
-func (x *EmailReq) Size() (n int) {
-	if x == nil {
-		return n
+func (x *Welcome) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
-	n += x.sizeField5()
-	return n
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Welcome[number], err)
 }
 
-func (x *EmailReq) sizeField1() (n int) {
-	if x.From == "" {
-		return n
-	}
-	n += fastpb.SizeString(1, x.GetFrom())
-	return n
+func (x *Welcome) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *EmailReq) sizeField2() (n int) {
-	if x.To == "" {
-		return n
+func (x *EmailReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
 	}
-	n += fastpb.SizeString(2, x.GetTo())
-	return n
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EmailReq[number], err)
 }
 
-func (x *EmailReq) sizeField3() (n int) {
-	if x.ContentType == "" {
-		return n
-	}
-	n += fastpb.SizeString(3, x.GetContentType())
-	return n
+func (x *EmailReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.From, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
 }
 
-func (x *EmailReq) sizeField4() (n int) {
-	if x.Subject == "" {
-		return n
-	}
-	n += fastpb.SizeString(4, x.GetSubject())
-	return n
+func (x *EmailReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.To, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ContentType, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Content, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var ov EmailReq_OrderConfirmation
+	x.Template = &ov
+	var v OrderConfirmation
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	ov.OrderConfirmation = &v
+	return offset, nil
+}
+
+func (x *EmailReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	var ov EmailReq_ShippingNotice
+	x.Template = &ov
+	var v ShippingNotice
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	ov.ShippingNotice = &v
+	return offset, nil
+}
+
+func (x *EmailReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	var ov EmailReq_PasswordReset
+	x.Template = &ov
+	var v PasswordReset
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	ov.PasswordReset = &v
+	return offset, nil
+}
+
+func (x *EmailReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	var ov EmailReq_Welcome
+	x.Template = &ov
+	var v Welcome
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	ov.Welcome = &v
+	return offset, nil
+}
+
+func (x *EmailReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.Locale, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EmailResp[number], err)
+}
+
+func (x *EmailResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.EmailId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SmsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_SmsReq[number], err)
+}
+
+func (x *SmsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.To, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *SmsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Content, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *WebhookReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_WebhookReq[number], err)
+}
+
+func (x *WebhookReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Url, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *WebhookReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Event, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *WebhookReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Payload, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *NotifyReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_NotifyReq[number], err)
+}
+
+func (x *NotifyReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *NotifyReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Topic, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *NotifyReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var ov NotifyReq_Email
+	x.Intent = &ov
+	var v EmailReq
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	ov.Email = &v
+	return offset, nil
+}
+
+func (x *NotifyReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	var ov NotifyReq_Sms
+	x.Intent = &ov
+	var v SmsReq
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	ov.Sms = &v
+	return offset, nil
+}
+
+func (x *NotifyReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	var ov NotifyReq_Webhook
+	x.Intent = &ov
+	var v WebhookReq
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	ov.Webhook = &v
+	return offset, nil
+}
+
+func (x *Delivery) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Delivery[number], err)
+}
+
+func (x *Delivery) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Channel, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Delivery) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.DeliveryId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Delivery) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Delivery) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Error, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *NotifyResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_NotifyResp[number], err)
+}
+
+func (x *NotifyResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Delivery
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Deliveries = append(x.Deliveries, &v)
+	return offset, nil
+}
+
+func (x *DeadLetter) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeadLetter[number], err)
+}
+
+func (x *DeadLetter) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Sequence, offset, err = fastpb.ReadUint64(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Error, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Deliveries, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.FailedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *DeadLetter) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	var v EmailReq
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Email = &v
+	return offset, nil
+}
+
+func (x *DeadLetter) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v NotifyReq
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Notification = &v
+	return offset, nil
+}
+
+func (x *ListDeadLettersReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListDeadLettersReq[number], err)
+}
+
+func (x *ListDeadLettersReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Limit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListDeadLettersResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListDeadLettersResp[number], err)
+}
+
+func (x *ListDeadLettersResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v DeadLetter
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.DeadLetters = append(x.DeadLetters, &v)
+	return offset, nil
+}
+
+func (x *ReplayDeadLetterReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReplayDeadLetterReq[number], err)
+}
+
+func (x *ReplayDeadLetterReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Sequence, offset, err = fastpb.ReadUint64(buf, _type)
+	return offset, err
+}
+
+func (x *ReplayDeadLetterResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *EmailLog) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_EmailLog[number], err)
+}
+
+func (x *EmailLog) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.EmailId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Recipient, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Template, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Subject, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Attempts, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.ProviderMessageId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.Error, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.CreatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.UpdatedAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *EmailLog) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.Channel, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListEmailsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListEmailsReq[number], err)
+}
+
+func (x *ListEmailsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Recipient, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListEmailsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListEmailsReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Page, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListEmailsReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.PageSize, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *ListEmailsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListEmailsResp[number], err)
+}
+
+func (x *ListEmailsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v EmailLog
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Emails = append(x.Emails, &v)
+	return offset, nil
+}
+
+func (x *ListEmailsResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Total, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *GetEmailStatusReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetEmailStatusReq[number], err)
+}
+
+func (x *GetEmailStatusReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.EmailId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetEmailStatusReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Recipient, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetEmailStatusReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetEmailStatusReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Template, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetEmailStatusResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetEmailStatusResp[number], err)
+}
+
+func (x *GetEmailStatusResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v EmailLog
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Email = &v
+	return offset, nil
+}
+
+func (x *OrderLine) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *OrderLine) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductName == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetProductName())
+	return offset
+}
+
+func (x *OrderLine) fastWriteField2(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetQuantity())
+	return offset
+}
+
+func (x *OrderLine) fastWriteField3(buf []byte) (offset int) {
+	if x.Cost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetCost())
+	return offset
+}
+
+func (x *OrderConfirmation) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *OrderConfirmation) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *OrderConfirmation) fastWriteField2(buf []byte) (offset int) {
+	if x.Lines == nil {
+		return offset
+	}
+	for i := range x.GetLines() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetLines()[i])
+	}
+	return offset
+}
+
+func (x *OrderConfirmation) fastWriteField3(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetTotal())
+	return offset
+}
+
+func (x *OrderConfirmation) fastWriteField4(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCurrency())
+	return offset
+}
+
+func (x *ShippingNotice) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ShippingNotice) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *ShippingNotice) fastWriteField2(buf []byte) (offset int) {
+	if x.Carrier == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCarrier())
+	return offset
+}
+
+func (x *ShippingNotice) fastWriteField3(buf []byte) (offset int) {
+	if x.TrackingNumber == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetTrackingNumber())
+	return offset
+}
+
+func (x *ShippingNotice) fastWriteField4(buf []byte) (offset int) {
+	if x.TrackingUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetTrackingUrl())
+	return offset
+}
+
+func (x *PasswordReset) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *PasswordReset) fastWriteField1(buf []byte) (offset int) {
+	if x.ResetUrl == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetResetUrl())
+	return offset
+}
+
+func (x *PasswordReset) fastWriteField2(buf []byte) (offset int) {
+	if x.ExpiresInMinutes == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetExpiresInMinutes())
+	return offset
+}
+
+func (x *Welcome) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *Welcome) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *EmailReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	return offset
+}
+
+func (x *EmailReq) fastWriteField1(buf []byte) (offset int) {
+	if x.From == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetFrom())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField2(buf []byte) (offset int) {
+	if x.To == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTo())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ContentType == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetContentType())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetSubject())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Content == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetContent())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField6(buf []byte) (offset int) {
+	if x.GetOrderConfirmation() == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 6, x.GetOrderConfirmation())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField7(buf []byte) (offset int) {
+	if x.GetShippingNotice() == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 7, x.GetShippingNotice())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField8(buf []byte) (offset int) {
+	if x.GetPasswordReset() == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 8, x.GetPasswordReset())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField9(buf []byte) (offset int) {
+	if x.GetWelcome() == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 9, x.GetWelcome())
+	return offset
+}
+
+func (x *EmailReq) fastWriteField10(buf []byte) (offset int) {
+	if x.Locale == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 10, x.GetLocale())
+	return offset
+}
+
+func (x *EmailResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *EmailResp) fastWriteField1(buf []byte) (offset int) {
+	if x.EmailId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmailId())
+	return offset
+}
+
+func (x *SmsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *SmsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.To == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetTo())
+	return offset
+}
+
+func (x *SmsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Content == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetContent())
+	return offset
+}
+
+func (x *WebhookReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *WebhookReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Url == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetUrl())
+	return offset
+}
+
+func (x *WebhookReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Event == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetEvent())
+	return offset
+}
+
+func (x *WebhookReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Payload == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetPayload())
+	return offset
+}
+
+func (x *NotifyReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *NotifyReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *NotifyReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Topic == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetTopic())
+	return offset
+}
+
+func (x *NotifyReq) fastWriteField3(buf []byte) (offset int) {
+	if x.GetEmail() == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 3, x.GetEmail())
+	return offset
+}
+
+func (x *NotifyReq) fastWriteField4(buf []byte) (offset int) {
+	if x.GetSms() == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 4, x.GetSms())
+	return offset
+}
+
+func (x *NotifyReq) fastWriteField5(buf []byte) (offset int) {
+	if x.GetWebhook() == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetWebhook())
+	return offset
+}
+
+func (x *Delivery) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *Delivery) fastWriteField1(buf []byte) (offset int) {
+	if x.Channel == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetChannel())
+	return offset
+}
+
+func (x *Delivery) fastWriteField2(buf []byte) (offset int) {
+	if x.DeliveryId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetDeliveryId())
+	return offset
+}
+
+func (x *Delivery) fastWriteField3(buf []byte) (offset int) {
+	if x.Status == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetStatus())
+	return offset
+}
+
+func (x *Delivery) fastWriteField4(buf []byte) (offset int) {
+	if x.Error == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetError())
+	return offset
+}
+
+func (x *NotifyResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *NotifyResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Deliveries == nil {
+		return offset
+	}
+	for i := range x.GetDeliveries() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetDeliveries()[i])
+	}
+	return offset
+}
+
+func (x *DeadLetter) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField1(buf []byte) (offset int) {
+	if x.Sequence == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint64(buf[offset:], 1, x.GetSequence())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField2(buf []byte) (offset int) {
+	if x.Error == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetError())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField3(buf []byte) (offset int) {
+	if x.Deliveries == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetDeliveries())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField4(buf []byte) (offset int) {
+	if x.FailedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 4, x.GetFailedAt())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField5(buf []byte) (offset int) {
+	if x.Email == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetEmail())
+	return offset
+}
+
+func (x *DeadLetter) fastWriteField6(buf []byte) (offset int) {
+	if x.Notification == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 6, x.GetNotification())
+	return offset
+}
+
+func (x *ListDeadLettersReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListDeadLettersReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Limit == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetLimit())
+	return offset
+}
+
+func (x *ListDeadLettersResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListDeadLettersResp) fastWriteField1(buf []byte) (offset int) {
+	if x.DeadLetters == nil {
+		return offset
+	}
+	for i := range x.GetDeadLetters() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetDeadLetters()[i])
+	}
+	return offset
+}
+
+func (x *ReplayDeadLetterReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ReplayDeadLetterReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Sequence == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint64(buf[offset:], 1, x.GetSequence())
+	return offset
+}
+
+func (x *ReplayDeadLetterResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *EmailLog) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	return offset
+}
+
+func (x *EmailLog) fastWriteField1(buf []byte) (offset int) {
+	if x.EmailId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmailId())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField2(buf []byte) (offset int) {
+	if x.Recipient == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRecipient())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField3(buf []byte) (offset int) {
+	if x.Template == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetTemplate())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField4(buf []byte) (offset int) {
+	if x.Subject == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetSubject())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField5(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetOrderId())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField6(buf []byte) (offset int) {
+	if x.Status == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetStatus())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField7(buf []byte) (offset int) {
+	if x.Attempts == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 7, x.GetAttempts())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField8(buf []byte) (offset int) {
+	if x.ProviderMessageId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetProviderMessageId())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField9(buf []byte) (offset int) {
+	if x.Error == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 9, x.GetError())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField10(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 10, x.GetCreatedAt())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField11(buf []byte) (offset int) {
+	if x.UpdatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 11, x.GetUpdatedAt())
+	return offset
+}
+
+func (x *EmailLog) fastWriteField12(buf []byte) (offset int) {
+	if x.Channel == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 12, x.GetChannel())
+	return offset
+}
+
+func (x *ListEmailsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ListEmailsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Recipient == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetRecipient())
+	return offset
+}
+
+func (x *ListEmailsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *ListEmailsReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Page == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetPage())
+	return offset
+}
+
+func (x *ListEmailsReq) fastWriteField4(buf []byte) (offset int) {
+	if x.PageSize == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetPageSize())
+	return offset
+}
+
+func (x *ListEmailsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ListEmailsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Emails == nil {
+		return offset
+	}
+	for i := range x.GetEmails() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetEmails()[i])
+	}
+	return offset
+}
+
+func (x *ListEmailsResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Total == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 2, x.GetTotal())
+	return offset
+}
+
+func (x *GetEmailStatusReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *GetEmailStatusReq) fastWriteField1(buf []byte) (offset int) {
+	if x.EmailId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmailId())
+	return offset
+}
+
+func (x *GetEmailStatusReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Recipient == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRecipient())
+	return offset
+}
+
+func (x *GetEmailStatusReq) fastWriteField3(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetOrderId())
+	return offset
+}
+
+func (x *GetEmailStatusReq) fastWriteField4(buf []byte) (offset int) {
+	if x.Template == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetTemplate())
+	return offset
+}
+
+func (x *GetEmailStatusResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetEmailStatusResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *OrderLine) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *OrderLine) sizeField1() (n int) {
+	if x.ProductName == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetProductName())
+	return n
+}
+
+func (x *OrderLine) sizeField2() (n int) {
+	if x.Quantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetQuantity())
+	return n
+}
+
+func (x *OrderLine) sizeField3() (n int) {
+	if x.Cost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetCost())
+	return n
+}
+
+func (x *OrderConfirmation) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *OrderConfirmation) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *OrderConfirmation) sizeField2() (n int) {
+	if x.Lines == nil {
+		return n
+	}
+	for i := range x.GetLines() {
+		n += fastpb.SizeMessage(2, x.GetLines()[i])
+	}
+	return n
+}
+
+func (x *OrderConfirmation) sizeField3() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetTotal())
+	return n
+}
+
+func (x *OrderConfirmation) sizeField4() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCurrency())
+	return n
+}
+
+func (x *ShippingNotice) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *ShippingNotice) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *ShippingNotice) sizeField2() (n int) {
+	if x.Carrier == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCarrier())
+	return n
+}
+
+func (x *ShippingNotice) sizeField3() (n int) {
+	if x.TrackingNumber == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetTrackingNumber())
+	return n
+}
+
+func (x *ShippingNotice) sizeField4() (n int) {
+	if x.TrackingUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetTrackingUrl())
+	return n
+}
+
+func (x *PasswordReset) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *PasswordReset) sizeField1() (n int) {
+	if x.ResetUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetResetUrl())
+	return n
+}
+
+func (x *PasswordReset) sizeField2() (n int) {
+	if x.ExpiresInMinutes == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetExpiresInMinutes())
+	return n
+}
+
+func (x *Welcome) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *Welcome) sizeField1() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetName())
+	return n
+}
+
+func (x *EmailReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	return n
+}
+
+func (x *EmailReq) sizeField1() (n int) {
+	if x.From == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetFrom())
+	return n
+}
+
+func (x *EmailReq) sizeField2() (n int) {
+	if x.To == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetTo())
+	return n
+}
+
+func (x *EmailReq) sizeField3() (n int) {
+	if x.ContentType == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetContentType())
+	return n
+}
+
+func (x *EmailReq) sizeField4() (n int) {
+	if x.Subject == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetSubject())
+	return n
 }
 
 func (x *EmailReq) sizeField5() (n int) {
 	if x.Content == "" {
 		return n
 	}
-	n += fastpb.SizeString(5, x.GetContent())
+	n += fastpb.SizeString(5, x.GetContent())
+	return n
+}
+
+func (x *EmailReq) sizeField6() (n int) {
+	if x.GetOrderConfirmation() == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(6, x.GetOrderConfirmation())
+	return n
+}
+
+func (x *EmailReq) sizeField7() (n int) {
+	if x.GetShippingNotice() == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(7, x.GetShippingNotice())
+	return n
+}
+
+func (x *EmailReq) sizeField8() (n int) {
+	if x.GetPasswordReset() == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(8, x.GetPasswordReset())
+	return n
+}
+
+func (x *EmailReq) sizeField9() (n int) {
+	if x.GetWelcome() == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(9, x.GetWelcome())
+	return n
+}
+
+func (x *EmailReq) sizeField10() (n int) {
+	if x.Locale == "" {
+		return n
+	}
+	n += fastpb.SizeString(10, x.GetLocale())
+	return n
+}
+
+func (x *EmailResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *EmailResp) sizeField1() (n int) {
+	if x.EmailId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmailId())
+	return n
+}
+
+func (x *SmsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *SmsReq) sizeField1() (n int) {
+	if x.To == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetTo())
+	return n
+}
+
+func (x *SmsReq) sizeField2() (n int) {
+	if x.Content == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetContent())
+	return n
+}
+
+func (x *WebhookReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *WebhookReq) sizeField1() (n int) {
+	if x.Url == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetUrl())
+	return n
+}
+
+func (x *WebhookReq) sizeField2() (n int) {
+	if x.Event == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetEvent())
+	return n
+}
+
+func (x *WebhookReq) sizeField3() (n int) {
+	if x.Payload == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetPayload())
+	return n
+}
+
+func (x *NotifyReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *NotifyReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetUserId())
+	return n
+}
+
+func (x *NotifyReq) sizeField2() (n int) {
+	if x.Topic == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetTopic())
+	return n
+}
+
+func (x *NotifyReq) sizeField3() (n int) {
+	if x.GetEmail() == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.GetEmail())
+	return n
+}
+
+func (x *NotifyReq) sizeField4() (n int) {
+	if x.GetSms() == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(4, x.GetSms())
+	return n
+}
+
+func (x *NotifyReq) sizeField5() (n int) {
+	if x.GetWebhook() == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(5, x.GetWebhook())
+	return n
+}
+
+func (x *Delivery) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *Delivery) sizeField1() (n int) {
+	if x.Channel == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetChannel())
+	return n
+}
+
+func (x *Delivery) sizeField2() (n int) {
+	if x.DeliveryId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetDeliveryId())
+	return n
+}
+
+func (x *Delivery) sizeField3() (n int) {
+	if x.Status == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetStatus())
+	return n
+}
+
+func (x *Delivery) sizeField4() (n int) {
+	if x.Error == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetError())
+	return n
+}
+
+func (x *NotifyResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *NotifyResp) sizeField1() (n int) {
+	if x.Deliveries == nil {
+		return n
+	}
+	for i := range x.GetDeliveries() {
+		n += fastpb.SizeMessage(1, x.GetDeliveries()[i])
+	}
+	return n
+}
+
+func (x *DeadLetter) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	return n
+}
+
+func (x *DeadLetter) sizeField1() (n int) {
+	if x.Sequence == 0 {
+		return n
+	}
+	n += fastpb.SizeUint64(1, x.GetSequence())
+	return n
+}
+
+func (x *DeadLetter) sizeField2() (n int) {
+	if x.Error == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetError())
+	return n
+}
+
+func (x *DeadLetter) sizeField3() (n int) {
+	if x.Deliveries == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(3, x.GetDeliveries())
+	return n
+}
+
+func (x *DeadLetter) sizeField4() (n int) {
+	if x.FailedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(4, x.GetFailedAt())
 	return n
 }
 
-func (x *EmailResp) Size() (n int) {
+func (x *DeadLetter) sizeField5() (n int) {
+	if x.Email == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(5, x.GetEmail())
+	return n
+}
+
+func (x *DeadLetter) sizeField6() (n int) {
+	if x.Notification == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(6, x.GetNotification())
+	return n
+}
+
+func (x *ListDeadLettersReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListDeadLettersReq) sizeField1() (n int) {
+	if x.Limit == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetLimit())
+	return n
+}
+
+func (x *ListDeadLettersResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListDeadLettersResp) sizeField1() (n int) {
+	if x.DeadLetters == nil {
+		return n
+	}
+	for i := range x.GetDeadLetters() {
+		n += fastpb.SizeMessage(1, x.GetDeadLetters()[i])
+	}
+	return n
+}
+
+func (x *ReplayDeadLetterReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ReplayDeadLetterReq) sizeField1() (n int) {
+	if x.Sequence == 0 {
+		return n
+	}
+	n += fastpb.SizeUint64(1, x.GetSequence())
+	return n
+}
+
+func (x *ReplayDeadLetterResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *EmailLog) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	return n
+}
+
+func (x *EmailLog) sizeField1() (n int) {
+	if x.EmailId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmailId())
+	return n
+}
+
+func (x *EmailLog) sizeField2() (n int) {
+	if x.Recipient == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRecipient())
+	return n
+}
+
+func (x *EmailLog) sizeField3() (n int) {
+	if x.Template == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetTemplate())
+	return n
+}
+
+func (x *EmailLog) sizeField4() (n int) {
+	if x.Subject == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetSubject())
+	return n
+}
+
+func (x *EmailLog) sizeField5() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetOrderId())
+	return n
+}
+
+func (x *EmailLog) sizeField6() (n int) {
+	if x.Status == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetStatus())
+	return n
+}
+
+func (x *EmailLog) sizeField7() (n int) {
+	if x.Attempts == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(7, x.GetAttempts())
+	return n
+}
+
+func (x *EmailLog) sizeField8() (n int) {
+	if x.ProviderMessageId == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetProviderMessageId())
+	return n
+}
+
+func (x *EmailLog) sizeField9() (n int) {
+	if x.Error == "" {
+		return n
+	}
+	n += fastpb.SizeString(9, x.GetError())
+	return n
+}
+
+func (x *EmailLog) sizeField10() (n int) {
+	if x.CreatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(10, x.GetCreatedAt())
+	return n
+}
+
+func (x *EmailLog) sizeField11() (n int) {
+	if x.UpdatedAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(11, x.GetUpdatedAt())
+	return n
+}
+
+func (x *EmailLog) sizeField12() (n int) {
+	if x.Channel == "" {
+		return n
+	}
+	n += fastpb.SizeString(12, x.GetChannel())
+	return n
+}
+
+func (x *ListEmailsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *ListEmailsReq) sizeField1() (n int) {
+	if x.Recipient == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetRecipient())
+	return n
+}
+
+func (x *ListEmailsReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *ListEmailsReq) sizeField3() (n int) {
+	if x.Page == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetPage())
+	return n
+}
+
+func (x *ListEmailsReq) sizeField4() (n int) {
+	if x.PageSize == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetPageSize())
+	return n
+}
+
+func (x *ListEmailsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ListEmailsResp) sizeField1() (n int) {
+	if x.Emails == nil {
+		return n
+	}
+	for i := range x.GetEmails() {
+		n += fastpb.SizeMessage(1, x.GetEmails()[i])
+	}
+	return n
+}
+
+func (x *ListEmailsResp) sizeField2() (n int) {
+	if x.Total == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(2, x.GetTotal())
+	return n
+}
+
+func (x *GetEmailStatusReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *GetEmailStatusReq) sizeField1() (n int) {
+	if x.EmailId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmailId())
+	return n
+}
+
+func (x *GetEmailStatusReq) sizeField2() (n int) {
+	if x.Recipient == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRecipient())
+	return n
+}
+
+func (x *GetEmailStatusReq) sizeField3() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetOrderId())
+	return n
+}
+
+func (x *GetEmailStatusReq) sizeField4() (n int) {
+	if x.Template == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetTemplate())
+	return n
+}
+
+func (x *GetEmailStatusResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetEmailStatusResp) sizeField1() (n int) {
+	if x.Email == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetEmail())
 	return n
 }
 
+var fieldIDToName_OrderLine = map[int32]string{
+	1: "ProductName",
+	2: "Quantity",
+	3: "Cost",
+}
+
+var fieldIDToName_OrderConfirmation = map[int32]string{
+	1: "OrderId",
+	2: "Lines",
+	3: "Total",
+	4: "Currency",
+}
+
+var fieldIDToName_ShippingNotice = map[int32]string{
+	1: "OrderId",
+	2: "Carrier",
+	3: "TrackingNumber",
+	4: "TrackingUrl",
+}
+
+var fieldIDToName_PasswordReset = map[int32]string{
+	1: "ResetUrl",
+	2: "ExpiresInMinutes",
+}
+
+var fieldIDToName_Welcome = map[int32]string{
+	1: "Name",
+}
+
 var fieldIDToName_EmailReq = map[int32]string{
-	1: "From",
-	2: "To",
-	3: "ContentType",
-	4: "Subject",
-	5: "Content",
+	1:  "From",
+	2:  "To",
+	3:  "ContentType",
+	4:  "Subject",
+	5:  "Content",
+	6:  "OrderConfirmation",
+	7:  "ShippingNotice",
+	8:  "PasswordReset",
+	9:  "Welcome",
+	10: "Locale",
+}
+
+var fieldIDToName_EmailResp = map[int32]string{
+	1: "EmailId",
+}
+
+var fieldIDToName_SmsReq = map[int32]string{
+	1: "To",
+	2: "Content",
+}
+
+var fieldIDToName_WebhookReq = map[int32]string{
+	1: "Url",
+	2: "Event",
+	3: "Payload",
+}
+
+var fieldIDToName_NotifyReq = map[int32]string{
+	1: "UserId",
+	2: "Topic",
+	3: "Email",
+	4: "Sms",
+	5: "Webhook",
+}
+
+var fieldIDToName_Delivery = map[int32]string{
+	1: "Channel",
+	2: "DeliveryId",
+	3: "Status",
+	4: "Error",
+}
+
+var fieldIDToName_NotifyResp = map[int32]string{
+	1: "Deliveries",
+}
+
+var fieldIDToName_DeadLetter = map[int32]string{
+	1: "Sequence",
+	2: "Error",
+	3: "Deliveries",
+	4: "FailedAt",
+	5: "Email",
+	6: "Notification",
 }
 
-var fieldIDToName_EmailResp = map[int32]string{}
+var fieldIDToName_ListDeadLettersReq = map[int32]string{
+	1: "Limit",
+}
+
+var fieldIDToName_ListDeadLettersResp = map[int32]string{
+	1: "DeadLetters",
+}
+
+var fieldIDToName_ReplayDeadLetterReq = map[int32]string{
+	1: "Sequence",
+}
+
+var fieldIDToName_ReplayDeadLetterResp = map[int32]string{}
+
+var fieldIDToName_EmailLog = map[int32]string{
+	1:  "EmailId",
+	2:  "Recipient",
+	3:  "Template",
+	4:  "Subject",
+	5:  "OrderId",
+	6:  "Status",
+	7:  "Attempts",
+	8:  "ProviderMessageId",
+	9:  "Error",
+	10: "CreatedAt",
+	11: "UpdatedAt",
+	12: "Channel",
+}
+
+var fieldIDToName_ListEmailsReq = map[int32]string{
+	1: "Recipient",
+	2: "OrderId",
+	3: "Page",
+	4: "PageSize",
+}
+
+var fieldIDToName_ListEmailsResp = map[int32]string{
+	1: "Emails",
+	2: "Total",
+}
+
+var fieldIDToName_GetEmailStatusReq = map[int32]string{
+	1: "EmailId",
+	2: "Recipient",
+	3: "OrderId",
+	4: "Template",
+}
+
+var fieldIDToName_GetEmailStatusResp = map[int32]string{
+	1: "Email",
+}
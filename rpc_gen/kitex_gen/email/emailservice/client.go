@@ -12,6 +12,11 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Send(ctx context.Context, Req *email.EmailReq, callOptions ...callopt.Option) (r *email.EmailResp, err error)
+	Notify(ctx context.Context, Req *email.NotifyReq, callOptions ...callopt.Option) (r *email.NotifyResp, err error)
+	ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error)
+	ReplayDeadLetter(ctx context.Context, Req *email.ReplayDeadLetterReq, callOptions ...callopt.Option) (r *email.ReplayDeadLetterResp, err error)
+	ListEmails(ctx context.Context, Req *email.ListEmailsReq, callOptions ...callopt.Option) (r *email.ListEmailsResp, err error)
+	GetEmailStatus(ctx context.Context, Req *email.GetEmailStatusReq, callOptions ...callopt.Option) (r *email.GetEmailStatusResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -47,3 +52,28 @@ func (p *kEmailServiceClient) Send(ctx context.Context, Req *email.EmailReq, cal
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Send(ctx, Req)
 }
+
+func (p *kEmailServiceClient) Notify(ctx context.Context, Req *email.NotifyReq, callOptions ...callopt.Option) (r *email.NotifyResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Notify(ctx, Req)
+}
+
+func (p *kEmailServiceClient) ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDeadLetters(ctx, Req)
+}
+
+func (p *kEmailServiceClient) ReplayDeadLetter(ctx context.Context, Req *email.ReplayDeadLetterReq, callOptions ...callopt.Option) (r *email.ReplayDeadLetterResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReplayDeadLetter(ctx, Req)
+}
+
+func (p *kEmailServiceClient) ListEmails(ctx context.Context, Req *email.ListEmailsReq, callOptions ...callopt.Option) (r *email.ListEmailsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListEmails(ctx, Req)
+}
+
+func (p *kEmailServiceClient) GetEmailStatus(ctx context.Context, Req *email.GetEmailStatusReq, callOptions ...callopt.Option) (r *email.GetEmailStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetEmailStatus(ctx, Req)
+}
@@ -21,7 +21,12 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "EmailService"
 	handlerType := (*email.EmailService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Send": kitex.NewMethodInfo(sendHandler, newSendArgs, newSendResult, false),
+		"Send":             kitex.NewMethodInfo(sendHandler, newSendArgs, newSendResult, false),
+		"Notify":           kitex.NewMethodInfo(notifyHandler, newNotifyArgs, newNotifyResult, false),
+		"ListDeadLetters":  kitex.NewMethodInfo(listDeadLettersHandler, newListDeadLettersArgs, newListDeadLettersResult, false),
+		"ReplayDeadLetter": kitex.NewMethodInfo(replayDeadLetterHandler, newReplayDeadLetterArgs, newReplayDeadLetterResult, false),
+		"ListEmails":       kitex.NewMethodInfo(listEmailsHandler, newListEmailsArgs, newListEmailsResult, false),
+		"GetEmailStatus":   kitex.NewMethodInfo(getEmailStatusHandler, newGetEmailStatusArgs, newGetEmailStatusResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "email",
@@ -191,6 +196,771 @@ func (p *SendResult) GetResult() interface{} {
 	return p.Success
 }
 
+func notifyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.NotifyReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).Notify(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *NotifyArgs:
+		success, err := handler.(email.EmailService).Notify(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*NotifyResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newNotifyArgs() interface{} {
+	return &NotifyArgs{}
+}
+
+func newNotifyResult() interface{} {
+	return &NotifyResult{}
+}
+
+type NotifyArgs struct {
+	Req *email.NotifyReq
+}
+
+func (p *NotifyArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.NotifyReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *NotifyArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *NotifyArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *NotifyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *NotifyArgs) Unmarshal(in []byte) error {
+	msg := new(email.NotifyReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var NotifyArgs_Req_DEFAULT *email.NotifyReq
+
+func (p *NotifyArgs) GetReq() *email.NotifyReq {
+	if !p.IsSetReq() {
+		return NotifyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *NotifyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *NotifyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type NotifyResult struct {
+	Success *email.NotifyResp
+}
+
+var NotifyResult_Success_DEFAULT *email.NotifyResp
+
+func (p *NotifyResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.NotifyResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *NotifyResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *NotifyResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *NotifyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *NotifyResult) Unmarshal(in []byte) error {
+	msg := new(email.NotifyResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *NotifyResult) GetSuccess() *email.NotifyResp {
+	if !p.IsSetSuccess() {
+		return NotifyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *NotifyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.NotifyResp)
+}
+
+func (p *NotifyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *NotifyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listDeadLettersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.ListDeadLettersReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).ListDeadLetters(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListDeadLettersArgs:
+		success, err := handler.(email.EmailService).ListDeadLetters(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListDeadLettersResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListDeadLettersArgs() interface{} {
+	return &ListDeadLettersArgs{}
+}
+
+func newListDeadLettersResult() interface{} {
+	return &ListDeadLettersResult{}
+}
+
+type ListDeadLettersArgs struct {
+	Req *email.ListDeadLettersReq
+}
+
+func (p *ListDeadLettersArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.ListDeadLettersReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListDeadLettersArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListDeadLettersArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListDeadLettersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListDeadLettersArgs) Unmarshal(in []byte) error {
+	msg := new(email.ListDeadLettersReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListDeadLettersArgs_Req_DEFAULT *email.ListDeadLettersReq
+
+func (p *ListDeadLettersArgs) GetReq() *email.ListDeadLettersReq {
+	if !p.IsSetReq() {
+		return ListDeadLettersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListDeadLettersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListDeadLettersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListDeadLettersResult struct {
+	Success *email.ListDeadLettersResp
+}
+
+var ListDeadLettersResult_Success_DEFAULT *email.ListDeadLettersResp
+
+func (p *ListDeadLettersResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.ListDeadLettersResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListDeadLettersResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListDeadLettersResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListDeadLettersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListDeadLettersResult) Unmarshal(in []byte) error {
+	msg := new(email.ListDeadLettersResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListDeadLettersResult) GetSuccess() *email.ListDeadLettersResp {
+	if !p.IsSetSuccess() {
+		return ListDeadLettersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListDeadLettersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.ListDeadLettersResp)
+}
+
+func (p *ListDeadLettersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListDeadLettersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func replayDeadLetterHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.ReplayDeadLetterReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).ReplayDeadLetter(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ReplayDeadLetterArgs:
+		success, err := handler.(email.EmailService).ReplayDeadLetter(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReplayDeadLetterResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newReplayDeadLetterArgs() interface{} {
+	return &ReplayDeadLetterArgs{}
+}
+
+func newReplayDeadLetterResult() interface{} {
+	return &ReplayDeadLetterResult{}
+}
+
+type ReplayDeadLetterArgs struct {
+	Req *email.ReplayDeadLetterReq
+}
+
+func (p *ReplayDeadLetterArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.ReplayDeadLetterReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ReplayDeadLetterArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ReplayDeadLetterArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ReplayDeadLetterArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReplayDeadLetterArgs) Unmarshal(in []byte) error {
+	msg := new(email.ReplayDeadLetterReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReplayDeadLetterArgs_Req_DEFAULT *email.ReplayDeadLetterReq
+
+func (p *ReplayDeadLetterArgs) GetReq() *email.ReplayDeadLetterReq {
+	if !p.IsSetReq() {
+		return ReplayDeadLetterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReplayDeadLetterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReplayDeadLetterArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReplayDeadLetterResult struct {
+	Success *email.ReplayDeadLetterResp
+}
+
+var ReplayDeadLetterResult_Success_DEFAULT *email.ReplayDeadLetterResp
+
+func (p *ReplayDeadLetterResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.ReplayDeadLetterResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReplayDeadLetterResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReplayDeadLetterResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReplayDeadLetterResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReplayDeadLetterResult) Unmarshal(in []byte) error {
+	msg := new(email.ReplayDeadLetterResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReplayDeadLetterResult) GetSuccess() *email.ReplayDeadLetterResp {
+	if !p.IsSetSuccess() {
+		return ReplayDeadLetterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReplayDeadLetterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.ReplayDeadLetterResp)
+}
+
+func (p *ReplayDeadLetterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReplayDeadLetterResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listEmailsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.ListEmailsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).ListEmails(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListEmailsArgs:
+		success, err := handler.(email.EmailService).ListEmails(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListEmailsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListEmailsArgs() interface{} {
+	return &ListEmailsArgs{}
+}
+
+func newListEmailsResult() interface{} {
+	return &ListEmailsResult{}
+}
+
+type ListEmailsArgs struct {
+	Req *email.ListEmailsReq
+}
+
+func (p *ListEmailsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.ListEmailsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListEmailsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListEmailsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListEmailsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListEmailsArgs) Unmarshal(in []byte) error {
+	msg := new(email.ListEmailsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListEmailsArgs_Req_DEFAULT *email.ListEmailsReq
+
+func (p *ListEmailsArgs) GetReq() *email.ListEmailsReq {
+	if !p.IsSetReq() {
+		return ListEmailsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListEmailsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListEmailsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListEmailsResult struct {
+	Success *email.ListEmailsResp
+}
+
+var ListEmailsResult_Success_DEFAULT *email.ListEmailsResp
+
+func (p *ListEmailsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.ListEmailsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListEmailsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListEmailsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListEmailsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListEmailsResult) Unmarshal(in []byte) error {
+	msg := new(email.ListEmailsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListEmailsResult) GetSuccess() *email.ListEmailsResp {
+	if !p.IsSetSuccess() {
+		return ListEmailsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListEmailsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.ListEmailsResp)
+}
+
+func (p *ListEmailsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListEmailsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getEmailStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(email.GetEmailStatusReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(email.EmailService).GetEmailStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetEmailStatusArgs:
+		success, err := handler.(email.EmailService).GetEmailStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetEmailStatusResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetEmailStatusArgs() interface{} {
+	return &GetEmailStatusArgs{}
+}
+
+func newGetEmailStatusResult() interface{} {
+	return &GetEmailStatusResult{}
+}
+
+type GetEmailStatusArgs struct {
+	Req *email.GetEmailStatusReq
+}
+
+func (p *GetEmailStatusArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(email.GetEmailStatusReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetEmailStatusArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetEmailStatusArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetEmailStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetEmailStatusArgs) Unmarshal(in []byte) error {
+	msg := new(email.GetEmailStatusReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetEmailStatusArgs_Req_DEFAULT *email.GetEmailStatusReq
+
+func (p *GetEmailStatusArgs) GetReq() *email.GetEmailStatusReq {
+	if !p.IsSetReq() {
+		return GetEmailStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetEmailStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetEmailStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetEmailStatusResult struct {
+	Success *email.GetEmailStatusResp
+}
+
+var GetEmailStatusResult_Success_DEFAULT *email.GetEmailStatusResp
+
+func (p *GetEmailStatusResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(email.GetEmailStatusResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetEmailStatusResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetEmailStatusResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetEmailStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetEmailStatusResult) Unmarshal(in []byte) error {
+	msg := new(email.GetEmailStatusResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetEmailStatusResult) GetSuccess() *email.GetEmailStatusResp {
+	if !p.IsSetSuccess() {
+		return GetEmailStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetEmailStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*email.GetEmailStatusResp)
+}
+
+func (p *GetEmailStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetEmailStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -210,3 +980,53 @@ func (p *kClient) Send(ctx context.Context, Req *email.EmailReq) (r *email.Email
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) Notify(ctx context.Context, Req *email.NotifyReq) (r *email.NotifyResp, err error) {
+	var _args NotifyArgs
+	_args.Req = Req
+	var _result NotifyResult
+	if err = p.c.Call(ctx, "Notify", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq) (r *email.ListDeadLettersResp, err error) {
+	var _args ListDeadLettersArgs
+	_args.Req = Req
+	var _result ListDeadLettersResult
+	if err = p.c.Call(ctx, "ListDeadLetters", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReplayDeadLetter(ctx context.Context, Req *email.ReplayDeadLetterReq) (r *email.ReplayDeadLetterResp, err error) {
+	var _args ReplayDeadLetterArgs
+	_args.Req = Req
+	var _result ReplayDeadLetterResult
+	if err = p.c.Call(ctx, "ReplayDeadLetter", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListEmails(ctx context.Context, Req *email.ListEmailsReq) (r *email.ListEmailsResp, err error) {
+	var _args ListEmailsArgs
+	_args.Req = Req
+	var _result ListEmailsResult
+	if err = p.c.Call(ctx, "ListEmails", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetEmailStatus(ctx context.Context, Req *email.GetEmailStatusReq) (r *email.GetEmailStatusResp, err error) {
+	var _args GetEmailStatusArgs
+	_args.Req = Req
+	var _result GetEmailStatusResult
+	if err = p.c.Call(ctx, "GetEmailStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
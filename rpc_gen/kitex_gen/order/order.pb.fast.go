@@ -105,6 +105,56 @@ func (x *PlaceOrderReq) FastRead(buf []byte, _type int8, number int32) (offset i
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -153,6 +203,66 @@ func (x *PlaceOrderReq) fastReadField5(buf []byte, _type int8) (offset int, err
 	return offset, nil
 }
 
+func (x *PlaceOrderReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v OrderPromotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Promotions = append(x.Promotions, &v)
+	return offset, nil
+}
+
+func (x *PlaceOrderReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	var v OrderTax
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Taxes = append(x.Taxes, &v)
+	return offset, nil
+}
+
+func (x *PlaceOrderReq) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.TaxInclusive, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingCost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.ExchangeRate, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.Hold, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *PlaceOrderReq) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
 func (x *OrderItem) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -165,6 +275,36 @@ func (x *OrderItem) FastRead(buf []byte, _type int8, number int32) (offset int,
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -193,6 +333,136 @@ func (x *OrderItem) fastReadField2(buf []byte, _type int8) (offset int, err erro
 	return offset, err
 }
 
+func (x *OrderItem) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.ProductName, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Picture, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.SkuCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.Variant, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.UnitPrice, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderItem) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPromotion) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderPromotion[number], err)
+}
+
+func (x *OrderPromotion) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.PromotionId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPromotion) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPromotion) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderPromotion) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderTax) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_OrderTax[number], err)
+}
+
+func (x *OrderTax) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *OrderTax) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Rate, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *OrderTax) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
 func (x *OrderResult) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -310,6 +580,51 @@ func (x *Order) FastRead(buf []byte, _type int8, number int32) (offset int, err
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 16:
+		offset, err = x.fastReadField16(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -368,39 +683,94 @@ func (x *Order) fastReadField7(buf []byte, _type int8) (offset int, err error) {
 	return offset, err
 }
 
-func (x *ListOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
-	switch number {
-	case 1:
-		offset, err = x.fastReadField1(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
-	default:
-		offset, err = fastpb.Skip(buf, _type, number)
-		if err != nil {
-			goto SkipFieldError
-		}
+func (x *Order) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	var v OrderPromotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
 	}
+	x.Promotions = append(x.Promotions, &v)
 	return offset, nil
-SkipFieldError:
-	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
-ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListOrderResp[number], err)
 }
 
-func (x *ListOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	var v Order
+func (x *Order) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	var v OrderTax
 	offset, err = fastpb.ReadMessage(buf, _type, &v)
 	if err != nil {
 		return offset, err
 	}
-	x.Orders = append(x.Orders, &v)
+	x.Taxes = append(x.Taxes, &v)
 	return offset, nil
 }
 
-func (x *MarkOrderPaidReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
-	switch number {
-	case 1:
+func (x *Order) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.Tax, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.TaxInclusive, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingMethod, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.ShippingCost, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	x.ExchangeRate, offset, err = fastpb.ReadDouble(buf, _type)
+	return offset, err
+}
+
+func (x *Order) fastReadField16(buf []byte, _type int8) (offset int, err error) {
+	x.OrderState, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ListOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListOrderResp[number], err)
+}
+
+func (x *ListOrderResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Order
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Orders = append(x.Orders, &v)
+	return offset, nil
+}
+
+func (x *MarkOrderPaidReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
 		offset, err = x.fastReadField1(buf, _type)
 		if err != nil {
 			goto ReadFieldError
@@ -446,6 +816,114 @@ SkipFieldError:
 	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
 }
 
+func (x *CancelOrderReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CancelOrderReq[number], err)
+}
+
+func (x *CancelOrderReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *CancelOrderReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CancelOrderResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *HasPurchasedReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_HasPurchasedReq[number], err)
+}
+
+func (x *HasPurchasedReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *HasPurchasedReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *HasPurchasedResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_HasPurchasedResp[number], err)
+}
+
+func (x *HasPurchasedResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Purchased, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
 func (x *Address) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
@@ -507,6 +985,16 @@ func (x *PlaceOrderReq) FastWrite(buf []byte) (offset int) {
 	offset += x.fastWriteField3(buf[offset:])
 	offset += x.fastWriteField4(buf[offset:])
 	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
 	return offset
 }
 
@@ -552,12 +1040,102 @@ func (x *PlaceOrderReq) fastWriteField5(buf []byte) (offset int) {
 	return offset
 }
 
+func (x *PlaceOrderReq) fastWriteField6(buf []byte) (offset int) {
+	if x.Promotions == nil {
+		return offset
+	}
+	for i := range x.GetPromotions() {
+		offset += fastpb.WriteMessage(buf[offset:], 6, x.GetPromotions()[i])
+	}
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField7(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetDiscount())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField8(buf []byte) (offset int) {
+	if x.Taxes == nil {
+		return offset
+	}
+	for i := range x.GetTaxes() {
+		offset += fastpb.WriteMessage(buf[offset:], 8, x.GetTaxes()[i])
+	}
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField9(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 9, x.GetTax())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField10(buf []byte) (offset int) {
+	if !x.TaxInclusive {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 10, x.GetTaxInclusive())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField11(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 11, x.GetShippingMethod())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField12(buf []byte) (offset int) {
+	if x.ShippingCost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 12, x.GetShippingCost())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField13(buf []byte) (offset int) {
+	if x.ExchangeRate == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 13, x.GetExchangeRate())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField14(buf []byte) (offset int) {
+	if !x.Hold {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 14, x.GetHold())
+	return offset
+}
+
+func (x *PlaceOrderReq) fastWriteField15(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 15, x.GetOrderId())
+	return offset
+}
+
 func (x *OrderItem) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
 	offset += x.fastWriteField1(buf[offset:])
 	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
 	return offset
 }
 
@@ -577,55 +1155,55 @@ func (x *OrderItem) fastWriteField2(buf []byte) (offset int) {
 	return offset
 }
 
-func (x *OrderResult) FastWrite(buf []byte) (offset int) {
-	if x == nil {
+func (x *OrderItem) fastWriteField3(buf []byte) (offset int) {
+	if x.ProductName == "" {
 		return offset
 	}
-	offset += x.fastWriteField1(buf[offset:])
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetProductName())
 	return offset
 }
 
-func (x *OrderResult) fastWriteField1(buf []byte) (offset int) {
-	if x.OrderId == "" {
+func (x *OrderItem) fastWriteField4(buf []byte) (offset int) {
+	if x.Picture == "" {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPicture())
 	return offset
 }
 
-func (x *PlaceOrderResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
+func (x *OrderItem) fastWriteField5(buf []byte) (offset int) {
+	if x.SkuCode == "" {
 		return offset
 	}
-	offset += x.fastWriteField1(buf[offset:])
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetSkuCode())
 	return offset
 }
 
-func (x *PlaceOrderResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Order == nil {
+func (x *OrderItem) fastWriteField6(buf []byte) (offset int) {
+	if x.Variant == "" {
 		return offset
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrder())
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetVariant())
 	return offset
 }
 
-func (x *ListOrderReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
+func (x *OrderItem) fastWriteField7(buf []byte) (offset int) {
+	if x.UnitPrice == 0 {
 		return offset
 	}
-	offset += x.fastWriteField1(buf[offset:])
+	offset += fastpb.WriteFloat(buf[offset:], 7, x.GetUnitPrice())
 	return offset
 }
 
-func (x *ListOrderReq) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
+func (x *OrderItem) fastWriteField8(buf []byte) (offset int) {
+	if x.Currency == "" {
 		return offset
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCurrency())
 	return offset
 }
 
-func (x *Order) FastWrite(buf []byte) (offset int) {
+func (x *OrderPromotion) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
@@ -633,121 +1211,604 @@ func (x *Order) FastWrite(buf []byte) (offset int) {
 	offset += x.fastWriteField2(buf[offset:])
 	offset += x.fastWriteField3(buf[offset:])
 	offset += x.fastWriteField4(buf[offset:])
-	offset += x.fastWriteField5(buf[offset:])
-	offset += x.fastWriteField6(buf[offset:])
-	offset += x.fastWriteField7(buf[offset:])
 	return offset
 }
 
-func (x *Order) fastWriteField1(buf []byte) (offset int) {
-	if x.OrderItems == nil {
+func (x *OrderPromotion) fastWriteField1(buf []byte) (offset int) {
+	if x.PromotionId == 0 {
 		return offset
 	}
-	for i := range x.GetOrderItems() {
-		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrderItems()[i])
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetPromotionId())
+	return offset
+}
+
+func (x *OrderPromotion) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
 	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
 	return offset
 }
 
-func (x *Order) fastWriteField2(buf []byte) (offset int) {
-	if x.OrderId == "" {
+func (x *OrderPromotion) fastWriteField3(buf []byte) (offset int) {
+	if x.Code == "" {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCode())
 	return offset
 }
 
-func (x *Order) fastWriteField3(buf []byte) (offset int) {
-	if x.UserId == 0 {
+func (x *OrderPromotion) fastWriteField4(buf []byte) (offset int) {
+	if x.Discount == 0 {
 		return offset
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetUserId())
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetDiscount())
 	return offset
 }
 
-func (x *Order) fastWriteField4(buf []byte) (offset int) {
-	if x.UserCurrency == "" {
+func (x *OrderTax) FastWrite(buf []byte) (offset int) {
+	if x == nil {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 4, x.GetUserCurrency())
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
 	return offset
 }
 
-func (x *Order) fastWriteField5(buf []byte) (offset int) {
-	if x.Address == nil {
+func (x *OrderTax) fastWriteField1(buf []byte) (offset int) {
+	if x.Name == "" {
 		return offset
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetName())
+	return offset
+}
+
+func (x *OrderTax) fastWriteField2(buf []byte) (offset int) {
+	if x.Rate == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetRate())
 	return offset
 }
 
-func (x *Order) fastWriteField6(buf []byte) (offset int) {
-	if x.Email == "" {
-		return offset
+func (x *OrderTax) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *OrderResult) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *OrderResult) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *PlaceOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *PlaceOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Order == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrder())
+	return offset
+}
+
+func (x *ListOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *Order) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	offset += x.fastWriteField16(buf[offset:])
+	return offset
+}
+
+func (x *Order) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderItems == nil {
+		return offset
+	}
+	for i := range x.GetOrderItems() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrderItems()[i])
+	}
+	return offset
+}
+
+func (x *Order) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *Order) fastWriteField3(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 3, x.GetUserId())
+	return offset
+}
+
+func (x *Order) fastWriteField4(buf []byte) (offset int) {
+	if x.UserCurrency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetUserCurrency())
+	return offset
+}
+
+func (x *Order) fastWriteField5(buf []byte) (offset int) {
+	if x.Address == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 5, x.GetAddress())
+	return offset
+}
+
+func (x *Order) fastWriteField6(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 6, x.GetEmail())
+	return offset
+}
+
+func (x *Order) fastWriteField7(buf []byte) (offset int) {
+	if x.CreatedAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 7, x.GetCreatedAt())
+	return offset
+}
+
+func (x *Order) fastWriteField8(buf []byte) (offset int) {
+	if x.Promotions == nil {
+		return offset
+	}
+	for i := range x.GetPromotions() {
+		offset += fastpb.WriteMessage(buf[offset:], 8, x.GetPromotions()[i])
+	}
+	return offset
+}
+
+func (x *Order) fastWriteField9(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 9, x.GetDiscount())
+	return offset
+}
+
+func (x *Order) fastWriteField10(buf []byte) (offset int) {
+	if x.Taxes == nil {
+		return offset
+	}
+	for i := range x.GetTaxes() {
+		offset += fastpb.WriteMessage(buf[offset:], 10, x.GetTaxes()[i])
+	}
+	return offset
+}
+
+func (x *Order) fastWriteField11(buf []byte) (offset int) {
+	if x.Tax == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 11, x.GetTax())
+	return offset
+}
+
+func (x *Order) fastWriteField12(buf []byte) (offset int) {
+	if !x.TaxInclusive {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 12, x.GetTaxInclusive())
+	return offset
+}
+
+func (x *Order) fastWriteField13(buf []byte) (offset int) {
+	if x.ShippingMethod == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 13, x.GetShippingMethod())
+	return offset
+}
+
+func (x *Order) fastWriteField14(buf []byte) (offset int) {
+	if x.ShippingCost == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 14, x.GetShippingCost())
+	return offset
+}
+
+func (x *Order) fastWriteField15(buf []byte) (offset int) {
+	if x.ExchangeRate == 0 {
+		return offset
+	}
+	offset += fastpb.WriteDouble(buf[offset:], 15, x.GetExchangeRate())
+	return offset
+}
+
+func (x *Order) fastWriteField16(buf []byte) (offset int) {
+	if x.OrderState == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 16, x.GetOrderState())
+	return offset
+}
+
+func (x *ListOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListOrderResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Orders == nil {
+		return offset
+	}
+	for i := range x.GetOrders() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrders()[i])
+	}
+	return offset
+}
+
+func (x *MarkOrderPaidReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *MarkOrderPaidReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *MarkOrderPaidResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *CancelOrderReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *CancelOrderReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *CancelOrderReq) fastWriteField2(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
+	return offset
+}
+
+func (x *CancelOrderResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *HasPurchasedReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *HasPurchasedReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *HasPurchasedReq) fastWriteField2(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetProductId())
+	return offset
+}
+
+func (x *HasPurchasedResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *HasPurchasedResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Purchased {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetPurchased())
+	return offset
+}
+
+func (x *Address) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Address) sizeField1() (n int) {
+	if x.StreetAddress == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetStreetAddress())
+	return n
+}
+
+func (x *Address) sizeField2() (n int) {
+	if x.City == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCity())
+	return n
+}
+
+func (x *Address) sizeField3() (n int) {
+	if x.State == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetState())
+	return n
+}
+
+func (x *Address) sizeField4() (n int) {
+	if x.Country == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCountry())
+	return n
+}
+
+func (x *Address) sizeField5() (n int) {
+	if x.ZipCode == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetZipCode())
+	return n
+}
+
+func (x *PlaceOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField2() (n int) {
+	if x.UserCurrency == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetUserCurrency())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField3() (n int) {
+	if x.Address == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(3, x.GetAddress())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField4() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetEmail())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField5() (n int) {
+	if x.OrderItems == nil {
+		return n
+	}
+	for i := range x.GetOrderItems() {
+		n += fastpb.SizeMessage(5, x.GetOrderItems()[i])
+	}
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField6() (n int) {
+	if x.Promotions == nil {
+		return n
+	}
+	for i := range x.GetPromotions() {
+		n += fastpb.SizeMessage(6, x.GetPromotions()[i])
+	}
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField7() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(7, x.GetDiscount())
+	return n
+}
+
+func (x *PlaceOrderReq) sizeField8() (n int) {
+	if x.Taxes == nil {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 6, x.GetEmail())
-	return offset
+	for i := range x.GetTaxes() {
+		n += fastpb.SizeMessage(8, x.GetTaxes()[i])
+	}
+	return n
 }
 
-func (x *Order) fastWriteField7(buf []byte) (offset int) {
-	if x.CreatedAt == 0 {
-		return offset
+func (x *PlaceOrderReq) sizeField9() (n int) {
+	if x.Tax == 0 {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 7, x.GetCreatedAt())
-	return offset
+	n += fastpb.SizeFloat(9, x.GetTax())
+	return n
 }
 
-func (x *ListOrderResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *PlaceOrderReq) sizeField10() (n int) {
+	if !x.TaxInclusive {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += fastpb.SizeBool(10, x.GetTaxInclusive())
+	return n
 }
 
-func (x *ListOrderResp) fastWriteField1(buf []byte) (offset int) {
-	if x.Orders == nil {
-		return offset
-	}
-	for i := range x.GetOrders() {
-		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetOrders()[i])
+func (x *PlaceOrderReq) sizeField11() (n int) {
+	if x.ShippingMethod == "" {
+		return n
 	}
-	return offset
+	n += fastpb.SizeString(11, x.GetShippingMethod())
+	return n
 }
 
-func (x *MarkOrderPaidReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *PlaceOrderReq) sizeField12() (n int) {
+	if x.ShippingCost == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	return offset
+	n += fastpb.SizeFloat(12, x.GetShippingCost())
+	return n
 }
 
-func (x *MarkOrderPaidReq) fastWriteField1(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *PlaceOrderReq) sizeField13() (n int) {
+	if x.ExchangeRate == 0 {
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
-	return offset
+	n += fastpb.SizeDouble(13, x.GetExchangeRate())
+	return n
 }
 
-func (x *MarkOrderPaidReq) fastWriteField2(buf []byte) (offset int) {
-	if x.OrderId == "" {
-		return offset
+func (x *PlaceOrderReq) sizeField14() (n int) {
+	if !x.Hold {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetOrderId())
-	return offset
+	n += fastpb.SizeBool(14, x.GetHold())
+	return n
 }
 
-func (x *MarkOrderPaidResp) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *PlaceOrderReq) sizeField15() (n int) {
+	if x.OrderId == "" {
+		return n
 	}
-	return offset
+	n += fastpb.SizeString(15, x.GetOrderId())
+	return n
 }
 
-func (x *Address) Size() (n int) {
+func (x *OrderItem) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -756,50 +1817,77 @@ func (x *Address) Size() (n int) {
 	n += x.sizeField3()
 	n += x.sizeField4()
 	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
 	return n
 }
 
-func (x *Address) sizeField1() (n int) {
-	if x.StreetAddress == "" {
+func (x *OrderItem) sizeField1() (n int) {
+	if x.Item == nil {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetStreetAddress())
+	n += fastpb.SizeMessage(1, x.GetItem())
 	return n
 }
 
-func (x *Address) sizeField2() (n int) {
-	if x.City == "" {
+func (x *OrderItem) sizeField2() (n int) {
+	if x.Cost == 0 {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetCity())
+	n += fastpb.SizeFloat(2, x.GetCost())
 	return n
 }
 
-func (x *Address) sizeField3() (n int) {
-	if x.State == "" {
+func (x *OrderItem) sizeField3() (n int) {
+	if x.ProductName == "" {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetState())
+	n += fastpb.SizeString(3, x.GetProductName())
 	return n
 }
 
-func (x *Address) sizeField4() (n int) {
-	if x.Country == "" {
+func (x *OrderItem) sizeField4() (n int) {
+	if x.Picture == "" {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetCountry())
+	n += fastpb.SizeString(4, x.GetPicture())
 	return n
 }
 
-func (x *Address) sizeField5() (n int) {
-	if x.ZipCode == 0 {
+func (x *OrderItem) sizeField5() (n int) {
+	if x.SkuCode == "" {
 		return n
 	}
-	n += fastpb.SizeInt32(5, x.GetZipCode())
+	n += fastpb.SizeString(5, x.GetSkuCode())
 	return n
 }
 
-func (x *PlaceOrderReq) Size() (n int) {
+func (x *OrderItem) sizeField6() (n int) {
+	if x.Variant == "" {
+		return n
+	}
+	n += fastpb.SizeString(6, x.GetVariant())
+	return n
+}
+
+func (x *OrderItem) sizeField7() (n int) {
+	if x.UnitPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(7, x.GetUnitPrice())
+	return n
+}
+
+func (x *OrderItem) sizeField8() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCurrency())
+	return n
+}
+
+func (x *OrderPromotion) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -807,74 +1895,72 @@ func (x *PlaceOrderReq) Size() (n int) {
 	n += x.sizeField2()
 	n += x.sizeField3()
 	n += x.sizeField4()
-	n += x.sizeField5()
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField1() (n int) {
-	if x.UserId == 0 {
+func (x *OrderPromotion) sizeField1() (n int) {
+	if x.PromotionId == 0 {
 		return n
 	}
-	n += fastpb.SizeUint32(1, x.GetUserId())
+	n += fastpb.SizeUint32(1, x.GetPromotionId())
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField2() (n int) {
-	if x.UserCurrency == "" {
+func (x *OrderPromotion) sizeField2() (n int) {
+	if x.Name == "" {
 		return n
 	}
-	n += fastpb.SizeString(2, x.GetUserCurrency())
+	n += fastpb.SizeString(2, x.GetName())
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField3() (n int) {
-	if x.Address == nil {
+func (x *OrderPromotion) sizeField3() (n int) {
+	if x.Code == "" {
 		return n
 	}
-	n += fastpb.SizeMessage(3, x.GetAddress())
+	n += fastpb.SizeString(3, x.GetCode())
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField4() (n int) {
-	if x.Email == "" {
+func (x *OrderPromotion) sizeField4() (n int) {
+	if x.Discount == 0 {
 		return n
 	}
-	n += fastpb.SizeString(4, x.GetEmail())
+	n += fastpb.SizeFloat(4, x.GetDiscount())
 	return n
 }
 
-func (x *PlaceOrderReq) sizeField5() (n int) {
-	if x.OrderItems == nil {
+func (x *OrderTax) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	for i := range x.GetOrderItems() {
-		n += fastpb.SizeMessage(5, x.GetOrderItems()[i])
-	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
 	return n
 }
 
-func (x *OrderItem) Size() (n int) {
-	if x == nil {
+func (x *OrderTax) sizeField1() (n int) {
+	if x.Name == "" {
 		return n
 	}
-	n += x.sizeField1()
-	n += x.sizeField2()
+	n += fastpb.SizeString(1, x.GetName())
 	return n
 }
 
-func (x *OrderItem) sizeField1() (n int) {
-	if x.Item == nil {
+func (x *OrderTax) sizeField2() (n int) {
+	if x.Rate == 0 {
 		return n
 	}
-	n += fastpb.SizeMessage(1, x.GetItem())
+	n += fastpb.SizeFloat(2, x.GetRate())
 	return n
 }
 
-func (x *OrderItem) sizeField2() (n int) {
-	if x.Cost == 0 {
+func (x *OrderTax) sizeField3() (n int) {
+	if x.Amount == 0 {
 		return n
 	}
-	n += fastpb.SizeFloat(2, x.GetCost())
+	n += fastpb.SizeFloat(3, x.GetAmount())
 	return n
 }
 
@@ -937,6 +2023,15 @@ func (x *Order) Size() (n int) {
 	n += x.sizeField5()
 	n += x.sizeField6()
 	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	n += x.sizeField16()
 	return n
 }
 
@@ -998,6 +2093,82 @@ func (x *Order) sizeField7() (n int) {
 	return n
 }
 
+func (x *Order) sizeField8() (n int) {
+	if x.Promotions == nil {
+		return n
+	}
+	for i := range x.GetPromotions() {
+		n += fastpb.SizeMessage(8, x.GetPromotions()[i])
+	}
+	return n
+}
+
+func (x *Order) sizeField9() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(9, x.GetDiscount())
+	return n
+}
+
+func (x *Order) sizeField10() (n int) {
+	if x.Taxes == nil {
+		return n
+	}
+	for i := range x.GetTaxes() {
+		n += fastpb.SizeMessage(10, x.GetTaxes()[i])
+	}
+	return n
+}
+
+func (x *Order) sizeField11() (n int) {
+	if x.Tax == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(11, x.GetTax())
+	return n
+}
+
+func (x *Order) sizeField12() (n int) {
+	if !x.TaxInclusive {
+		return n
+	}
+	n += fastpb.SizeBool(12, x.GetTaxInclusive())
+	return n
+}
+
+func (x *Order) sizeField13() (n int) {
+	if x.ShippingMethod == "" {
+		return n
+	}
+	n += fastpb.SizeString(13, x.GetShippingMethod())
+	return n
+}
+
+func (x *Order) sizeField14() (n int) {
+	if x.ShippingCost == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(14, x.GetShippingCost())
+	return n
+}
+
+func (x *Order) sizeField15() (n int) {
+	if x.ExchangeRate == 0 {
+		return n
+	}
+	n += fastpb.SizeDouble(15, x.GetExchangeRate())
+	return n
+}
+
+func (x *Order) sizeField16() (n int) {
+	if x.OrderState == "" {
+		return n
+	}
+	n += fastpb.SizeString(16, x.GetOrderState())
+	return n
+}
+
 func (x *ListOrderResp) Size() (n int) {
 	if x == nil {
 		return n
@@ -1048,6 +2219,79 @@ func (x *MarkOrderPaidResp) Size() (n int) {
 	return n
 }
 
+func (x *CancelOrderReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *CancelOrderReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *CancelOrderReq) sizeField2() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetOrderId())
+	return n
+}
+
+func (x *CancelOrderResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *HasPurchasedReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *HasPurchasedReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *HasPurchasedReq) sizeField2() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(2, x.GetProductId())
+	return n
+}
+
+func (x *HasPurchasedResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *HasPurchasedResp) sizeField1() (n int) {
+	if !x.Purchased {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetPurchased())
+	return n
+}
+
 var fieldIDToName_Address = map[int32]string{
 	1: "StreetAddress",
 	2: "City",
@@ -1057,16 +2301,45 @@ var fieldIDToName_Address = map[int32]string{
 }
 
 var fieldIDToName_PlaceOrderReq = map[int32]string{
-	1: "UserId",
-	2: "UserCurrency",
-	3: "Address",
-	4: "Email",
-	5: "OrderItems",
+	1:  "UserId",
+	2:  "UserCurrency",
+	3:  "Address",
+	4:  "Email",
+	5:  "OrderItems",
+	6:  "Promotions",
+	7:  "Discount",
+	8:  "Taxes",
+	9:  "Tax",
+	10: "TaxInclusive",
+	11: "ShippingMethod",
+	12: "ShippingCost",
+	13: "ExchangeRate",
+	14: "Hold",
+	15: "OrderId",
 }
 
 var fieldIDToName_OrderItem = map[int32]string{
 	1: "Item",
 	2: "Cost",
+	3: "ProductName",
+	4: "Picture",
+	5: "SkuCode",
+	6: "Variant",
+	7: "UnitPrice",
+	8: "Currency",
+}
+
+var fieldIDToName_OrderPromotion = map[int32]string{
+	1: "PromotionId",
+	2: "Name",
+	3: "Code",
+	4: "Discount",
+}
+
+var fieldIDToName_OrderTax = map[int32]string{
+	1: "Name",
+	2: "Rate",
+	3: "Amount",
 }
 
 var fieldIDToName_OrderResult = map[int32]string{
@@ -1082,13 +2355,22 @@ var fieldIDToName_ListOrderReq = map[int32]string{
 }
 
 var fieldIDToName_Order = map[int32]string{
-	1: "OrderItems",
-	2: "OrderId",
-	3: "UserId",
-	4: "UserCurrency",
-	5: "Address",
-	6: "Email",
-	7: "CreatedAt",
+	1:  "OrderItems",
+	2:  "OrderId",
+	3:  "UserId",
+	4:  "UserCurrency",
+	5:  "Address",
+	6:  "Email",
+	7:  "CreatedAt",
+	8:  "Promotions",
+	9:  "Discount",
+	10: "Taxes",
+	11: "Tax",
+	12: "TaxInclusive",
+	13: "ShippingMethod",
+	14: "ShippingCost",
+	15: "ExchangeRate",
+	16: "OrderState",
 }
 
 var fieldIDToName_ListOrderResp = map[int32]string{
@@ -1102,4 +2384,20 @@ var fieldIDToName_MarkOrderPaidReq = map[int32]string{
 
 var fieldIDToName_MarkOrderPaidResp = map[int32]string{}
 
+var fieldIDToName_CancelOrderReq = map[int32]string{
+	1: "UserId",
+	2: "OrderId",
+}
+
+var fieldIDToName_CancelOrderResp = map[int32]string{}
+
+var fieldIDToName_HasPurchasedReq = map[int32]string{
+	1: "UserId",
+	2: "ProductId",
+}
+
+var fieldIDToName_HasPurchasedResp = map[int32]string{
+	1: "Purchased",
+}
+
 var _ = cart.File_cart_proto
@@ -14,6 +14,8 @@ type Client interface {
 	PlaceOrder(ctx context.Context, Req *order.PlaceOrderReq, callOptions ...callopt.Option) (r *order.PlaceOrderResp, err error)
 	ListOrder(ctx context.Context, Req *order.ListOrderReq, callOptions ...callopt.Option) (r *order.ListOrderResp, err error)
 	MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error)
+	HasPurchased(ctx context.Context, Req *order.HasPurchasedReq, callOptions ...callopt.Option) (r *order.HasPurchasedResp, err error)
+	CancelOrder(ctx context.Context, Req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +61,13 @@ func (p *kOrderServiceClient) MarkOrderPaid(ctx context.Context, Req *order.Mark
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MarkOrderPaid(ctx, Req)
 }
+
+func (p *kOrderServiceClient) HasPurchased(ctx context.Context, Req *order.HasPurchasedReq, callOptions ...callopt.Option) (r *order.HasPurchasedResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.HasPurchased(ctx, Req)
+}
+
+func (p *kOrderServiceClient) CancelOrder(ctx context.Context, Req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelOrder(ctx, Req)
+}
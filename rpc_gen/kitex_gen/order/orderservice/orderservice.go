@@ -24,6 +24,8 @@ func NewServiceInfo() *kitex.ServiceInfo {
 		"PlaceOrder":    kitex.NewMethodInfo(placeOrderHandler, newPlaceOrderArgs, newPlaceOrderResult, false),
 		"ListOrder":     kitex.NewMethodInfo(listOrderHandler, newListOrderArgs, newListOrderResult, false),
 		"MarkOrderPaid": kitex.NewMethodInfo(markOrderPaidHandler, newMarkOrderPaidArgs, newMarkOrderPaidResult, false),
+		"HasPurchased":  kitex.NewMethodInfo(hasPurchasedHandler, newHasPurchasedArgs, newHasPurchasedResult, false),
+		"CancelOrder":   kitex.NewMethodInfo(cancelOrderHandler, newCancelOrderArgs, newCancelOrderResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "order",
@@ -499,6 +501,312 @@ func (p *MarkOrderPaidResult) GetResult() interface{} {
 	return p.Success
 }
 
+func hasPurchasedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.HasPurchasedReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).HasPurchased(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *HasPurchasedArgs:
+		success, err := handler.(order.OrderService).HasPurchased(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*HasPurchasedResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newHasPurchasedArgs() interface{} {
+	return &HasPurchasedArgs{}
+}
+
+func newHasPurchasedResult() interface{} {
+	return &HasPurchasedResult{}
+}
+
+type HasPurchasedArgs struct {
+	Req *order.HasPurchasedReq
+}
+
+func (p *HasPurchasedArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.HasPurchasedReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *HasPurchasedArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *HasPurchasedArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *HasPurchasedArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *HasPurchasedArgs) Unmarshal(in []byte) error {
+	msg := new(order.HasPurchasedReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var HasPurchasedArgs_Req_DEFAULT *order.HasPurchasedReq
+
+func (p *HasPurchasedArgs) GetReq() *order.HasPurchasedReq {
+	if !p.IsSetReq() {
+		return HasPurchasedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *HasPurchasedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *HasPurchasedArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type HasPurchasedResult struct {
+	Success *order.HasPurchasedResp
+}
+
+var HasPurchasedResult_Success_DEFAULT *order.HasPurchasedResp
+
+func (p *HasPurchasedResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.HasPurchasedResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *HasPurchasedResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *HasPurchasedResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *HasPurchasedResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *HasPurchasedResult) Unmarshal(in []byte) error {
+	msg := new(order.HasPurchasedResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *HasPurchasedResult) GetSuccess() *order.HasPurchasedResp {
+	if !p.IsSetSuccess() {
+		return HasPurchasedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *HasPurchasedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.HasPurchasedResp)
+}
+
+func (p *HasPurchasedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *HasPurchasedResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.CancelOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).CancelOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *CancelOrderArgs:
+		success, err := handler.(order.OrderService).CancelOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelOrderResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newCancelOrderArgs() interface{} {
+	return &CancelOrderArgs{}
+}
+
+func newCancelOrderResult() interface{} {
+	return &CancelOrderResult{}
+}
+
+type CancelOrderArgs struct {
+	Req *order.CancelOrderReq
+}
+
+func (p *CancelOrderArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(order.CancelOrderReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CancelOrderArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CancelOrderArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CancelOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelOrderArgs) Unmarshal(in []byte) error {
+	msg := new(order.CancelOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelOrderArgs_Req_DEFAULT *order.CancelOrderReq
+
+func (p *CancelOrderArgs) GetReq() *order.CancelOrderReq {
+	if !p.IsSetReq() {
+		return CancelOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelOrderResult struct {
+	Success *order.CancelOrderResp
+}
+
+var CancelOrderResult_Success_DEFAULT *order.CancelOrderResp
+
+func (p *CancelOrderResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(order.CancelOrderResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CancelOrderResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CancelOrderResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CancelOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelOrderResult) Unmarshal(in []byte) error {
+	msg := new(order.CancelOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelOrderResult) GetSuccess() *order.CancelOrderResp {
+	if !p.IsSetSuccess() {
+		return CancelOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.CancelOrderResp)
+}
+
+func (p *CancelOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -538,3 +846,23 @@ func (p *kClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) HasPurchased(ctx context.Context, Req *order.HasPurchasedReq) (r *order.HasPurchasedResp, err error) {
+	var _args HasPurchasedArgs
+	_args.Req = Req
+	var _result HasPurchasedResult
+	if err = p.c.Call(ctx, "HasPurchased", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelOrder(ctx context.Context, Req *order.CancelOrderReq) (r *order.CancelOrderResp, err error) {
+	var _args CancelOrderArgs
+	_args.Req = Req
+	var _result CancelOrderResult
+	if err = p.c.Call(ctx, "CancelOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
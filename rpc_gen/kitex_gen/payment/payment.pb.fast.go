@@ -89,6 +89,26 @@ func (x *ChargeReq) FastRead(buf []byte, _type int8, number int32) (offset int,
 		if err != nil {
 			goto ReadFieldError
 		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
 	default:
 		offset, err = fastpb.Skip(buf, _type, number)
 		if err != nil {
@@ -107,240 +127,1936 @@ func (x *ChargeReq) fastReadField1(buf []byte, _type int8) (offset int, err erro
 	return offset, err
 }
 
-func (x *ChargeReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	var v CreditCardInfo
-	offset, err = fastpb.ReadMessage(buf, _type, &v)
-	if err != nil {
-		return offset, err
+func (x *ChargeReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v CreditCardInfo
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.CreditCard = &v
+	return offset, nil
+}
+
+func (x *ChargeReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeReq) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeReq) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.AuthorizeOnly, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeReq) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.GiftCardCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeReq) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.UseWallet, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *Tender) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Tender[number], err)
+}
+
+func (x *Tender) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Type, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Tender) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ChargeResp[number], err)
+}
+
+func (x *ChargeResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ChargeResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v Tender
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Tenders = append(x.Tenders, &v)
+	return offset, nil
+}
+
+func (x *CaptureReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CaptureReq[number], err)
+}
+
+func (x *CaptureReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *CaptureResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CaptureResp[number], err)
+}
+
+func (x *CaptureResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VoidReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_VoidReq[number], err)
+}
+
+func (x *VoidReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *VoidResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *RefundReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefundReq[number], err)
+}
+
+func (x *RefundReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *RefundReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *RefundResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RefundResp[number], err)
+}
+
+func (x *RefundResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Tender
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Tenders = append(x.Tenders, &v)
+	return offset, nil
+}
+
+func (x *GetPaymentReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetPaymentReq[number], err)
+}
+
+func (x *GetPaymentReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetPaymentResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetPaymentResp[number], err)
+}
+
+func (x *GetPaymentResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetPaymentResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Status, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetPaymentResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *GetPaymentResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GiftCard) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GiftCard[number], err)
+}
+
+func (x *GiftCard) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GiftCard) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GiftCard) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Balance, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *IssueGiftCardReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_IssueGiftCardReq[number], err)
+}
+
+func (x *IssueGiftCardReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *IssueGiftCardReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *IssueGiftCardReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *IssueGiftCardResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_IssueGiftCardResp[number], err)
+}
+
+func (x *IssueGiftCardResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v GiftCard
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.GiftCard = &v
+	return offset, nil
+}
+
+func (x *IssueStoreGiftCardReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_IssueStoreGiftCardReq[number], err)
+}
+
+func (x *IssueStoreGiftCardReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Amount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *IssueStoreGiftCardReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *IssueStoreGiftCardReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Issuer, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *IssueStoreGiftCardResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_IssueStoreGiftCardResp[number], err)
+}
+
+func (x *IssueStoreGiftCardResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v GiftCard
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.GiftCard = &v
+	return offset, nil
+}
+
+func (x *GetGiftCardReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetGiftCardReq[number], err)
+}
+
+func (x *GetGiftCardReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetGiftCardResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetGiftCardResp[number], err)
+}
+
+func (x *GetGiftCardResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v GiftCard
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.GiftCard = &v
+	return offset, nil
+}
+
+func (x *RedeemGiftCardReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RedeemGiftCardReq[number], err)
+}
+
+func (x *RedeemGiftCardReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemGiftCardReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Wallet) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Wallet[number], err)
+}
+
+func (x *Wallet) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Wallet) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Balance, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *RedeemGiftCardResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_RedeemGiftCardResp[number], err)
+}
+
+func (x *RedeemGiftCardResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Wallet
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Wallet = &v
+	return offset, nil
+}
+
+func (x *GetWalletsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetWalletsReq[number], err)
+}
+
+func (x *GetWalletsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *GetWalletsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetWalletsResp[number], err)
+}
+
+func (x *GetWalletsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Wallet
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Wallets = append(x.Wallets, &v)
+	return offset, nil
+}
+
+func (x *CreditCardInfo) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField1(buf []byte) (offset int) {
+	if x.CreditCardNumber == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCreditCardNumber())
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField2(buf []byte) (offset int) {
+	if x.CreditCardCvv == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetCreditCardCvv())
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField3(buf []byte) (offset int) {
+	if x.CreditCardExpirationYear == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetCreditCardExpirationYear())
+	return offset
+}
+
+func (x *CreditCardInfo) fastWriteField4(buf []byte) (offset int) {
+	if x.CreditCardExpirationMonth == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetCreditCardExpirationMonth())
+	return offset
+}
+
+func (x *ChargeReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 1, x.GetAmount())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CreditCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetCreditCard())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField3(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetOrderId())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField4(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetUserId())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField5(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetCurrency())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField6(buf []byte) (offset int) {
+	if !x.AuthorizeOnly {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 6, x.GetAuthorizeOnly())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField7(buf []byte) (offset int) {
+	if x.GiftCardCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetGiftCardCode())
+	return offset
+}
+
+func (x *ChargeReq) fastWriteField8(buf []byte) (offset int) {
+	if !x.UseWallet {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 8, x.GetUseWallet())
+	return offset
+}
+
+func (x *Tender) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *Tender) fastWriteField1(buf []byte) (offset int) {
+	if x.Type == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetType())
+	return offset
+}
+
+func (x *Tender) fastWriteField2(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetAmount())
+	return offset
+}
+
+func (x *ChargeResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ChargeResp) fastWriteField1(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetTransactionId())
+	return offset
+}
+
+func (x *ChargeResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Tenders == nil {
+		return offset
+	}
+	for i := range x.GetTenders() {
+		offset += fastpb.WriteMessage(buf[offset:], 2, x.GetTenders()[i])
+	}
+	return offset
+}
+
+func (x *CaptureReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CaptureReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *CaptureResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CaptureResp) fastWriteField1(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetTransactionId())
+	return offset
+}
+
+func (x *VoidReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *VoidReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *VoidResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *RefundReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RefundReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *RefundReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetAmount())
+	return offset
+}
+
+func (x *RefundResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RefundResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Tenders == nil {
+		return offset
+	}
+	for i := range x.GetTenders() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetTenders()[i])
+	}
+	return offset
+}
+
+func (x *GetPaymentReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetPaymentReq) fastWriteField1(buf []byte) (offset int) {
+	if x.OrderId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetOrderId())
+	return offset
+}
+
+func (x *GetPaymentResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *GetPaymentResp) fastWriteField1(buf []byte) (offset int) {
+	if x.TransactionId == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetTransactionId())
+	return offset
+}
+
+func (x *GetPaymentResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Status == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetStatus())
+	return offset
+}
+
+func (x *GetPaymentResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetAmount())
+	return offset
+}
+
+func (x *GetPaymentResp) fastWriteField4(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetCurrency())
+	return offset
+}
+
+func (x *GiftCard) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *GiftCard) fastWriteField1(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCode())
+	return offset
+}
+
+func (x *GiftCard) fastWriteField2(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCurrency())
+	return offset
+}
+
+func (x *GiftCard) fastWriteField3(buf []byte) (offset int) {
+	if x.Balance == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetBalance())
+	return offset
+}
+
+func (x *IssueGiftCardReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *IssueGiftCardReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *IssueGiftCardReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetAmount())
+	return offset
+}
+
+func (x *IssueGiftCardReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCurrency())
+	return offset
+}
+
+func (x *IssueGiftCardResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *IssueGiftCardResp) fastWriteField1(buf []byte) (offset int) {
+	if x.GiftCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetGiftCard())
+	return offset
+}
+
+func (x *IssueStoreGiftCardReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *IssueStoreGiftCardReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Amount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 1, x.GetAmount())
+	return offset
+}
+
+func (x *IssueStoreGiftCardReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCurrency())
+	return offset
+}
+
+func (x *IssueStoreGiftCardReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Issuer == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetIssuer())
+	return offset
+}
+
+func (x *IssueStoreGiftCardResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *IssueStoreGiftCardResp) fastWriteField1(buf []byte) (offset int) {
+	if x.GiftCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetGiftCard())
+	return offset
+}
+
+func (x *GetGiftCardReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetGiftCardReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCode())
+	return offset
+}
+
+func (x *GetGiftCardResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetGiftCardResp) fastWriteField1(buf []byte) (offset int) {
+	if x.GiftCard == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetGiftCard())
+	return offset
+}
+
+func (x *RedeemGiftCardReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *RedeemGiftCardReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *RedeemGiftCardReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCode())
+	return offset
+}
+
+func (x *Wallet) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *Wallet) fastWriteField1(buf []byte) (offset int) {
+	if x.Currency == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetCurrency())
+	return offset
+}
+
+func (x *Wallet) fastWriteField2(buf []byte) (offset int) {
+	if x.Balance == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 2, x.GetBalance())
+	return offset
+}
+
+func (x *RedeemGiftCardResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RedeemGiftCardResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Wallet == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetWallet())
+	return offset
+}
+
+func (x *GetWalletsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetWalletsReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *GetWalletsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *GetWalletsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Wallets == nil {
+		return offset
+	}
+	for i := range x.GetWallets() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetWallets()[i])
+	}
+	return offset
+}
+
+func (x *CreditCardInfo) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *CreditCardInfo) sizeField1() (n int) {
+	if x.CreditCardNumber == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCreditCardNumber())
+	return n
+}
+
+func (x *CreditCardInfo) sizeField2() (n int) {
+	if x.CreditCardCvv == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(2, x.GetCreditCardCvv())
+	return n
+}
+
+func (x *CreditCardInfo) sizeField3() (n int) {
+	if x.CreditCardExpirationYear == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(3, x.GetCreditCardExpirationYear())
+	return n
+}
+
+func (x *CreditCardInfo) sizeField4() (n int) {
+	if x.CreditCardExpirationMonth == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(4, x.GetCreditCardExpirationMonth())
+	return n
+}
+
+func (x *ChargeReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *ChargeReq) sizeField1() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(1, x.GetAmount())
+	return n
+}
+
+func (x *ChargeReq) sizeField2() (n int) {
+	if x.CreditCard == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetCreditCard())
+	return n
+}
+
+func (x *ChargeReq) sizeField3() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetOrderId())
+	return n
+}
+
+func (x *ChargeReq) sizeField4() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(4, x.GetUserId())
+	return n
+}
+
+func (x *ChargeReq) sizeField5() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetCurrency())
+	return n
+}
+
+func (x *ChargeReq) sizeField6() (n int) {
+	if !x.AuthorizeOnly {
+		return n
+	}
+	n += fastpb.SizeBool(6, x.GetAuthorizeOnly())
+	return n
+}
+
+func (x *ChargeReq) sizeField7() (n int) {
+	if x.GiftCardCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetGiftCardCode())
+	return n
+}
+
+func (x *ChargeReq) sizeField8() (n int) {
+	if !x.UseWallet {
+		return n
+	}
+	n += fastpb.SizeBool(8, x.GetUseWallet())
+	return n
+}
+
+func (x *Tender) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *Tender) sizeField1() (n int) {
+	if x.Type == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetType())
+	return n
+}
+
+func (x *Tender) sizeField2() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetAmount())
+	return n
+}
+
+func (x *ChargeResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ChargeResp) sizeField1() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetTransactionId())
+	return n
+}
+
+func (x *ChargeResp) sizeField2() (n int) {
+	if x.Tenders == nil {
+		return n
+	}
+	for i := range x.GetTenders() {
+		n += fastpb.SizeMessage(2, x.GetTenders()[i])
+	}
+	return n
+}
+
+func (x *CaptureReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CaptureReq) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *CaptureResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CaptureResp) sizeField1() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetTransactionId())
+	return n
+}
+
+func (x *VoidReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *VoidReq) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *VoidResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+func (x *RefundReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *RefundReq) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *RefundReq) sizeField2() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(2, x.GetAmount())
+	return n
+}
+
+func (x *RefundResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *RefundResp) sizeField1() (n int) {
+	if x.Tenders == nil {
+		return n
+	}
+	for i := range x.GetTenders() {
+		n += fastpb.SizeMessage(1, x.GetTenders()[i])
+	}
+	return n
+}
+
+func (x *GetPaymentReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetPaymentReq) sizeField1() (n int) {
+	if x.OrderId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetOrderId())
+	return n
+}
+
+func (x *GetPaymentResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *GetPaymentResp) sizeField1() (n int) {
+	if x.TransactionId == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetTransactionId())
+	return n
+}
+
+func (x *GetPaymentResp) sizeField2() (n int) {
+	if x.Status == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetStatus())
+	return n
+}
+
+func (x *GetPaymentResp) sizeField3() (n int) {
+	if x.Amount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetAmount())
+	return n
+}
+
+func (x *GetPaymentResp) sizeField4() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetCurrency())
+	return n
+}
+
+func (x *GiftCard) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	x.CreditCard = &v
-	return offset, nil
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *ChargeReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
-	x.OrderId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *GiftCard) sizeField1() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetCode())
+	return n
 }
 
-func (x *ChargeReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
-	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
-	return offset, err
+func (x *GiftCard) sizeField2() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCurrency())
+	return n
 }
 
-func (x *ChargeResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
-	switch number {
-	case 1:
-		offset, err = x.fastReadField1(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
-	default:
-		offset, err = fastpb.Skip(buf, _type, number)
-		if err != nil {
-			goto SkipFieldError
-		}
+func (x *GiftCard) sizeField3() (n int) {
+	if x.Balance == 0 {
+		return n
 	}
-	return offset, nil
-SkipFieldError:
-	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
-ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ChargeResp[number], err)
+	n += fastpb.SizeFloat(3, x.GetBalance())
+	return n
 }
 
-func (x *ChargeResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.TransactionId, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *IssueGiftCardReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *CreditCardInfo) FastWrite(buf []byte) (offset int) {
-	if x == nil {
-		return offset
+func (x *IssueGiftCardReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
 }
 
-func (x *CreditCardInfo) fastWriteField1(buf []byte) (offset int) {
-	if x.CreditCardNumber == "" {
-		return offset
+func (x *IssueGiftCardReq) sizeField2() (n int) {
+	if x.Amount == 0 {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetCreditCardNumber())
-	return offset
+	n += fastpb.SizeFloat(2, x.GetAmount())
+	return n
 }
 
-func (x *CreditCardInfo) fastWriteField2(buf []byte) (offset int) {
-	if x.CreditCardCvv == 0 {
-		return offset
+func (x *IssueGiftCardReq) sizeField3() (n int) {
+	if x.Currency == "" {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 2, x.GetCreditCardCvv())
-	return offset
+	n += fastpb.SizeString(3, x.GetCurrency())
+	return n
 }
 
-func (x *CreditCardInfo) fastWriteField3(buf []byte) (offset int) {
-	if x.CreditCardExpirationYear == 0 {
-		return offset
+func (x *IssueGiftCardResp) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 3, x.GetCreditCardExpirationYear())
-	return offset
+	n += x.sizeField1()
+	return n
 }
 
-func (x *CreditCardInfo) fastWriteField4(buf []byte) (offset int) {
-	if x.CreditCardExpirationMonth == 0 {
-		return offset
+func (x *IssueGiftCardResp) sizeField1() (n int) {
+	if x.GiftCard == nil {
+		return n
 	}
-	offset += fastpb.WriteInt32(buf[offset:], 4, x.GetCreditCardExpirationMonth())
-	return offset
+	n += fastpb.SizeMessage(1, x.GetGiftCard())
+	return n
 }
 
-func (x *ChargeReq) FastWrite(buf []byte) (offset int) {
+func (x *IssueStoreGiftCardReq) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
-	offset += x.fastWriteField4(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
 }
 
-func (x *ChargeReq) fastWriteField1(buf []byte) (offset int) {
+func (x *IssueStoreGiftCardReq) sizeField1() (n int) {
 	if x.Amount == 0 {
-		return offset
+		return n
 	}
-	offset += fastpb.WriteFloat(buf[offset:], 1, x.GetAmount())
-	return offset
+	n += fastpb.SizeFloat(1, x.GetAmount())
+	return n
 }
 
-func (x *ChargeReq) fastWriteField2(buf []byte) (offset int) {
-	if x.CreditCard == nil {
-		return offset
+func (x *IssueStoreGiftCardReq) sizeField2() (n int) {
+	if x.Currency == "" {
+		return n
 	}
-	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetCreditCard())
-	return offset
+	n += fastpb.SizeString(2, x.GetCurrency())
+	return n
 }
 
-func (x *ChargeReq) fastWriteField3(buf []byte) (offset int) {
-	if x.OrderId == "" {
-		return offset
+func (x *IssueStoreGiftCardReq) sizeField3() (n int) {
+	if x.Issuer == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetOrderId())
-	return offset
+	n += fastpb.SizeString(3, x.GetIssuer())
+	return n
 }
 
-func (x *ChargeReq) fastWriteField4(buf []byte) (offset int) {
-	if x.UserId == 0 {
-		return offset
+func (x *IssueStoreGiftCardResp) Size() (n int) {
+	if x == nil {
+		return n
 	}
-	offset += fastpb.WriteUint32(buf[offset:], 4, x.GetUserId())
-	return offset
+	n += x.sizeField1()
+	return n
 }
 
-func (x *ChargeResp) FastWrite(buf []byte) (offset int) {
+func (x *IssueStoreGiftCardResp) sizeField1() (n int) {
+	if x.GiftCard == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetGiftCard())
+	return n
+}
+
+func (x *GetGiftCardReq) Size() (n int) {
 	if x == nil {
-		return offset
+		return n
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	return offset
+	n += x.sizeField1()
+	return n
 }
 
-func (x *ChargeResp) fastWriteField1(buf []byte) (offset int) {
-	if x.TransactionId == "" {
-		return offset
+func (x *GetGiftCardReq) sizeField1() (n int) {
+	if x.Code == "" {
+		return n
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetTransactionId())
-	return offset
+	n += fastpb.SizeString(1, x.GetCode())
+	return n
 }
 
-func (x *CreditCardInfo) Size() (n int) {
+func (x *GetGiftCardResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
-	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
 	return n
 }
 
-func (x *CreditCardInfo) sizeField1() (n int) {
-	if x.CreditCardNumber == "" {
+func (x *GetGiftCardResp) sizeField1() (n int) {
+	if x.GiftCard == nil {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetCreditCardNumber())
+	n += fastpb.SizeMessage(1, x.GetGiftCard())
 	return n
 }
 
-func (x *CreditCardInfo) sizeField2() (n int) {
-	if x.CreditCardCvv == 0 {
+func (x *RedeemGiftCardReq) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeInt32(2, x.GetCreditCardCvv())
+	n += x.sizeField1()
+	n += x.sizeField2()
 	return n
 }
 
-func (x *CreditCardInfo) sizeField3() (n int) {
-	if x.CreditCardExpirationYear == 0 {
+func (x *RedeemGiftCardReq) sizeField1() (n int) {
+	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeInt32(3, x.GetCreditCardExpirationYear())
+	n += fastpb.SizeUint32(1, x.GetUserId())
 	return n
 }
 
-func (x *CreditCardInfo) sizeField4() (n int) {
-	if x.CreditCardExpirationMonth == 0 {
+func (x *RedeemGiftCardReq) sizeField2() (n int) {
+	if x.Code == "" {
 		return n
 	}
-	n += fastpb.SizeInt32(4, x.GetCreditCardExpirationMonth())
+	n += fastpb.SizeString(2, x.GetCode())
 	return n
 }
 
-func (x *ChargeReq) Size() (n int) {
+func (x *Wallet) Size() (n int) {
 	if x == nil {
 		return n
 	}
 	n += x.sizeField1()
 	n += x.sizeField2()
-	n += x.sizeField3()
-	n += x.sizeField4()
 	return n
 }
 
-func (x *ChargeReq) sizeField1() (n int) {
-	if x.Amount == 0 {
+func (x *Wallet) sizeField1() (n int) {
+	if x.Currency == "" {
 		return n
 	}
-	n += fastpb.SizeFloat(1, x.GetAmount())
+	n += fastpb.SizeString(1, x.GetCurrency())
 	return n
 }
 
-func (x *ChargeReq) sizeField2() (n int) {
-	if x.CreditCard == nil {
+func (x *Wallet) sizeField2() (n int) {
+	if x.Balance == 0 {
 		return n
 	}
-	n += fastpb.SizeMessage(2, x.GetCreditCard())
+	n += fastpb.SizeFloat(2, x.GetBalance())
 	return n
 }
 
-func (x *ChargeReq) sizeField3() (n int) {
-	if x.OrderId == "" {
+func (x *RedeemGiftCardResp) Size() (n int) {
+	if x == nil {
 		return n
 	}
-	n += fastpb.SizeString(3, x.GetOrderId())
+	n += x.sizeField1()
 	return n
 }
 
-func (x *ChargeReq) sizeField4() (n int) {
+func (x *RedeemGiftCardResp) sizeField1() (n int) {
+	if x.Wallet == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetWallet())
+	return n
+}
+
+func (x *GetWalletsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetWalletsReq) sizeField1() (n int) {
 	if x.UserId == 0 {
 		return n
 	}
-	n += fastpb.SizeUint32(4, x.GetUserId())
+	n += fastpb.SizeUint32(1, x.GetUserId())
 	return n
 }
 
-func (x *ChargeResp) Size() (n int) {
+func (x *GetWalletsResp) Size() (n int) {
 	if x == nil {
 		return n
 	}
@@ -348,11 +2064,13 @@ func (x *ChargeResp) Size() (n int) {
 	return n
 }
 
-func (x *ChargeResp) sizeField1() (n int) {
-	if x.TransactionId == "" {
+func (x *GetWalletsResp) sizeField1() (n int) {
+	if x.Wallets == nil {
 		return n
 	}
-	n += fastpb.SizeString(1, x.GetTransactionId())
+	for i := range x.GetWallets() {
+		n += fastpb.SizeMessage(1, x.GetWallets()[i])
+	}
 	return n
 }
 
@@ -368,8 +2086,108 @@ var fieldIDToName_ChargeReq = map[int32]string{
 	2: "CreditCard",
 	3: "OrderId",
 	4: "UserId",
+	5: "Currency",
+	6: "AuthorizeOnly",
+	7: "GiftCardCode",
+	8: "UseWallet",
+}
+
+var fieldIDToName_Tender = map[int32]string{
+	1: "Type",
+	2: "Amount",
 }
 
 var fieldIDToName_ChargeResp = map[int32]string{
 	1: "TransactionId",
+	2: "Tenders",
+}
+
+var fieldIDToName_CaptureReq = map[int32]string{
+	1: "OrderId",
+}
+
+var fieldIDToName_CaptureResp = map[int32]string{
+	1: "TransactionId",
+}
+
+var fieldIDToName_VoidReq = map[int32]string{
+	1: "OrderId",
+}
+
+var fieldIDToName_VoidResp = map[int32]string{}
+
+var fieldIDToName_RefundReq = map[int32]string{
+	1: "OrderId",
+	2: "Amount",
+}
+
+var fieldIDToName_RefundResp = map[int32]string{
+	1: "Tenders",
+}
+
+var fieldIDToName_GetPaymentReq = map[int32]string{
+	1: "OrderId",
+}
+
+var fieldIDToName_GetPaymentResp = map[int32]string{
+	1: "TransactionId",
+	2: "Status",
+	3: "Amount",
+	4: "Currency",
+}
+
+var fieldIDToName_GiftCard = map[int32]string{
+	1: "Code",
+	2: "Currency",
+	3: "Balance",
+}
+
+var fieldIDToName_IssueGiftCardReq = map[int32]string{
+	1: "UserId",
+	2: "Amount",
+	3: "Currency",
+}
+
+var fieldIDToName_IssueGiftCardResp = map[int32]string{
+	1: "GiftCard",
+}
+
+var fieldIDToName_IssueStoreGiftCardReq = map[int32]string{
+	1: "Amount",
+	2: "Currency",
+	3: "Issuer",
+}
+
+var fieldIDToName_IssueStoreGiftCardResp = map[int32]string{
+	1: "GiftCard",
+}
+
+var fieldIDToName_GetGiftCardReq = map[int32]string{
+	1: "Code",
+}
+
+var fieldIDToName_GetGiftCardResp = map[int32]string{
+	1: "GiftCard",
+}
+
+var fieldIDToName_RedeemGiftCardReq = map[int32]string{
+	1: "UserId",
+	2: "Code",
+}
+
+var fieldIDToName_Wallet = map[int32]string{
+	1: "Currency",
+	2: "Balance",
+}
+
+var fieldIDToName_RedeemGiftCardResp = map[int32]string{
+	1: "Wallet",
+}
+
+var fieldIDToName_GetWalletsReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_GetWalletsResp = map[int32]string{
+	1: "Wallets",
 }
@@ -12,6 +12,15 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Charge(ctx context.Context, Req *payment.ChargeReq, callOptions ...callopt.Option) (r *payment.ChargeResp, err error)
+	Capture(ctx context.Context, Req *payment.CaptureReq, callOptions ...callopt.Option) (r *payment.CaptureResp, err error)
+	Void(ctx context.Context, Req *payment.VoidReq, callOptions ...callopt.Option) (r *payment.VoidResp, err error)
+	Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error)
+	GetPayment(ctx context.Context, Req *payment.GetPaymentReq, callOptions ...callopt.Option) (r *payment.GetPaymentResp, err error)
+	IssueGiftCard(ctx context.Context, Req *payment.IssueGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueGiftCardResp, err error)
+	IssueStoreGiftCard(ctx context.Context, Req *payment.IssueStoreGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueStoreGiftCardResp, err error)
+	GetGiftCard(ctx context.Context, Req *payment.GetGiftCardReq, callOptions ...callopt.Option) (r *payment.GetGiftCardResp, err error)
+	RedeemGiftCard(ctx context.Context, Req *payment.RedeemGiftCardReq, callOptions ...callopt.Option) (r *payment.RedeemGiftCardResp, err error)
+	GetWallets(ctx context.Context, Req *payment.GetWalletsReq, callOptions ...callopt.Option) (r *payment.GetWalletsResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -47,3 +56,48 @@ func (p *kPaymentServiceClient) Charge(ctx context.Context, Req *payment.ChargeR
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Charge(ctx, Req)
 }
+
+func (p *kPaymentServiceClient) Capture(ctx context.Context, Req *payment.CaptureReq, callOptions ...callopt.Option) (r *payment.CaptureResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Capture(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) Void(ctx context.Context, Req *payment.VoidReq, callOptions ...callopt.Option) (r *payment.VoidResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Void(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Refund(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) GetPayment(ctx context.Context, Req *payment.GetPaymentReq, callOptions ...callopt.Option) (r *payment.GetPaymentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPayment(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) IssueGiftCard(ctx context.Context, Req *payment.IssueGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueGiftCardResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.IssueGiftCard(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) IssueStoreGiftCard(ctx context.Context, Req *payment.IssueStoreGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueStoreGiftCardResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.IssueStoreGiftCard(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) GetGiftCard(ctx context.Context, Req *payment.GetGiftCardReq, callOptions ...callopt.Option) (r *payment.GetGiftCardResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetGiftCard(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) RedeemGiftCard(ctx context.Context, Req *payment.RedeemGiftCardReq, callOptions ...callopt.Option) (r *payment.RedeemGiftCardResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RedeemGiftCard(ctx, Req)
+}
+
+func (p *kPaymentServiceClient) GetWallets(ctx context.Context, Req *payment.GetWalletsReq, callOptions ...callopt.Option) (r *payment.GetWalletsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetWallets(ctx, Req)
+}
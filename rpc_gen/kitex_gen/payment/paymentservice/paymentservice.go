@@ -21,7 +21,16 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "PaymentService"
 	handlerType := (*payment.PaymentService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Charge": kitex.NewMethodInfo(chargeHandler, newChargeArgs, newChargeResult, false),
+		"Charge":             kitex.NewMethodInfo(chargeHandler, newChargeArgs, newChargeResult, false),
+		"Capture":            kitex.NewMethodInfo(captureHandler, newCaptureArgs, newCaptureResult, false),
+		"Void":               kitex.NewMethodInfo(voidHandler, newVoidArgs, newVoidResult, false),
+		"Refund":             kitex.NewMethodInfo(refundHandler, newRefundArgs, newRefundResult, false),
+		"GetPayment":         kitex.NewMethodInfo(getPaymentHandler, newGetPaymentArgs, newGetPaymentResult, false),
+		"IssueGiftCard":      kitex.NewMethodInfo(issueGiftCardHandler, newIssueGiftCardArgs, newIssueGiftCardResult, false),
+		"IssueStoreGiftCard": kitex.NewMethodInfo(issueStoreGiftCardHandler, newIssueStoreGiftCardArgs, newIssueStoreGiftCardResult, false),
+		"GetGiftCard":        kitex.NewMethodInfo(getGiftCardHandler, newGetGiftCardArgs, newGetGiftCardResult, false),
+		"RedeemGiftCard":     kitex.NewMethodInfo(redeemGiftCardHandler, newRedeemGiftCardArgs, newRedeemGiftCardResult, false),
+		"GetWallets":         kitex.NewMethodInfo(getWalletsHandler, newGetWalletsArgs, newGetWalletsResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "payment",
@@ -191,6 +200,1383 @@ func (p *ChargeResult) GetResult() interface{} {
 	return p.Success
 }
 
+func captureHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.CaptureReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).Capture(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *CaptureArgs:
+		success, err := handler.(payment.PaymentService).Capture(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CaptureResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newCaptureArgs() interface{} {
+	return &CaptureArgs{}
+}
+
+func newCaptureResult() interface{} {
+	return &CaptureResult{}
+}
+
+type CaptureArgs struct {
+	Req *payment.CaptureReq
+}
+
+func (p *CaptureArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.CaptureReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CaptureArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CaptureArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CaptureArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CaptureArgs) Unmarshal(in []byte) error {
+	msg := new(payment.CaptureReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CaptureArgs_Req_DEFAULT *payment.CaptureReq
+
+func (p *CaptureArgs) GetReq() *payment.CaptureReq {
+	if !p.IsSetReq() {
+		return CaptureArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CaptureArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CaptureArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CaptureResult struct {
+	Success *payment.CaptureResp
+}
+
+var CaptureResult_Success_DEFAULT *payment.CaptureResp
+
+func (p *CaptureResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.CaptureResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CaptureResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CaptureResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CaptureResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CaptureResult) Unmarshal(in []byte) error {
+	msg := new(payment.CaptureResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CaptureResult) GetSuccess() *payment.CaptureResp {
+	if !p.IsSetSuccess() {
+		return CaptureResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CaptureResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.CaptureResp)
+}
+
+func (p *CaptureResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CaptureResult) GetResult() interface{} {
+	return p.Success
+}
+
+func voidHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.VoidReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).Void(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *VoidArgs:
+		success, err := handler.(payment.PaymentService).Void(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*VoidResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newVoidArgs() interface{} {
+	return &VoidArgs{}
+}
+
+func newVoidResult() interface{} {
+	return &VoidResult{}
+}
+
+type VoidArgs struct {
+	Req *payment.VoidReq
+}
+
+func (p *VoidArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.VoidReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *VoidArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *VoidArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *VoidArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *VoidArgs) Unmarshal(in []byte) error {
+	msg := new(payment.VoidReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var VoidArgs_Req_DEFAULT *payment.VoidReq
+
+func (p *VoidArgs) GetReq() *payment.VoidReq {
+	if !p.IsSetReq() {
+		return VoidArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *VoidArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VoidArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type VoidResult struct {
+	Success *payment.VoidResp
+}
+
+var VoidResult_Success_DEFAULT *payment.VoidResp
+
+func (p *VoidResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.VoidResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *VoidResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *VoidResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *VoidResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *VoidResult) Unmarshal(in []byte) error {
+	msg := new(payment.VoidResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *VoidResult) GetSuccess() *payment.VoidResp {
+	if !p.IsSetSuccess() {
+		return VoidResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *VoidResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.VoidResp)
+}
+
+func (p *VoidResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VoidResult) GetResult() interface{} {
+	return p.Success
+}
+
+func refundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.RefundReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).Refund(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RefundArgs:
+		success, err := handler.(payment.PaymentService).Refund(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RefundResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRefundArgs() interface{} {
+	return &RefundArgs{}
+}
+
+func newRefundResult() interface{} {
+	return &RefundResult{}
+}
+
+type RefundArgs struct {
+	Req *payment.RefundReq
+}
+
+func (p *RefundArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.RefundReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RefundArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RefundArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RefundArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RefundArgs) Unmarshal(in []byte) error {
+	msg := new(payment.RefundReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RefundArgs_Req_DEFAULT *payment.RefundReq
+
+func (p *RefundArgs) GetReq() *payment.RefundReq {
+	if !p.IsSetReq() {
+		return RefundArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RefundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RefundArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RefundResult struct {
+	Success *payment.RefundResp
+}
+
+var RefundResult_Success_DEFAULT *payment.RefundResp
+
+func (p *RefundResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.RefundResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RefundResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RefundResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RefundResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RefundResult) Unmarshal(in []byte) error {
+	msg := new(payment.RefundResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RefundResult) GetSuccess() *payment.RefundResp {
+	if !p.IsSetSuccess() {
+		return RefundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.RefundResp)
+}
+
+func (p *RefundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RefundResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getPaymentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.GetPaymentReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).GetPayment(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetPaymentArgs:
+		success, err := handler.(payment.PaymentService).GetPayment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetPaymentResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetPaymentArgs() interface{} {
+	return &GetPaymentArgs{}
+}
+
+func newGetPaymentResult() interface{} {
+	return &GetPaymentResult{}
+}
+
+type GetPaymentArgs struct {
+	Req *payment.GetPaymentReq
+}
+
+func (p *GetPaymentArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.GetPaymentReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetPaymentArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetPaymentArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetPaymentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetPaymentArgs) Unmarshal(in []byte) error {
+	msg := new(payment.GetPaymentReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetPaymentArgs_Req_DEFAULT *payment.GetPaymentReq
+
+func (p *GetPaymentArgs) GetReq() *payment.GetPaymentReq {
+	if !p.IsSetReq() {
+		return GetPaymentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetPaymentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetPaymentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetPaymentResult struct {
+	Success *payment.GetPaymentResp
+}
+
+var GetPaymentResult_Success_DEFAULT *payment.GetPaymentResp
+
+func (p *GetPaymentResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.GetPaymentResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetPaymentResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetPaymentResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetPaymentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetPaymentResult) Unmarshal(in []byte) error {
+	msg := new(payment.GetPaymentResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetPaymentResult) GetSuccess() *payment.GetPaymentResp {
+	if !p.IsSetSuccess() {
+		return GetPaymentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetPaymentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.GetPaymentResp)
+}
+
+func (p *GetPaymentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetPaymentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func issueGiftCardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.IssueGiftCardReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).IssueGiftCard(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *IssueGiftCardArgs:
+		success, err := handler.(payment.PaymentService).IssueGiftCard(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*IssueGiftCardResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newIssueGiftCardArgs() interface{} {
+	return &IssueGiftCardArgs{}
+}
+
+func newIssueGiftCardResult() interface{} {
+	return &IssueGiftCardResult{}
+}
+
+type IssueGiftCardArgs struct {
+	Req *payment.IssueGiftCardReq
+}
+
+func (p *IssueGiftCardArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.IssueGiftCardReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *IssueGiftCardArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *IssueGiftCardArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *IssueGiftCardArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *IssueGiftCardArgs) Unmarshal(in []byte) error {
+	msg := new(payment.IssueGiftCardReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var IssueGiftCardArgs_Req_DEFAULT *payment.IssueGiftCardReq
+
+func (p *IssueGiftCardArgs) GetReq() *payment.IssueGiftCardReq {
+	if !p.IsSetReq() {
+		return IssueGiftCardArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *IssueGiftCardArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IssueGiftCardArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type IssueGiftCardResult struct {
+	Success *payment.IssueGiftCardResp
+}
+
+var IssueGiftCardResult_Success_DEFAULT *payment.IssueGiftCardResp
+
+func (p *IssueGiftCardResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.IssueGiftCardResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *IssueGiftCardResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *IssueGiftCardResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *IssueGiftCardResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *IssueGiftCardResult) Unmarshal(in []byte) error {
+	msg := new(payment.IssueGiftCardResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *IssueGiftCardResult) GetSuccess() *payment.IssueGiftCardResp {
+	if !p.IsSetSuccess() {
+		return IssueGiftCardResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *IssueGiftCardResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.IssueGiftCardResp)
+}
+
+func (p *IssueGiftCardResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IssueGiftCardResult) GetResult() interface{} {
+	return p.Success
+}
+
+func issueStoreGiftCardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.IssueStoreGiftCardReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).IssueStoreGiftCard(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *IssueStoreGiftCardArgs:
+		success, err := handler.(payment.PaymentService).IssueStoreGiftCard(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*IssueStoreGiftCardResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newIssueStoreGiftCardArgs() interface{} {
+	return &IssueStoreGiftCardArgs{}
+}
+
+func newIssueStoreGiftCardResult() interface{} {
+	return &IssueStoreGiftCardResult{}
+}
+
+type IssueStoreGiftCardArgs struct {
+	Req *payment.IssueStoreGiftCardReq
+}
+
+func (p *IssueStoreGiftCardArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.IssueStoreGiftCardReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *IssueStoreGiftCardArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *IssueStoreGiftCardArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *IssueStoreGiftCardArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *IssueStoreGiftCardArgs) Unmarshal(in []byte) error {
+	msg := new(payment.IssueStoreGiftCardReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var IssueStoreGiftCardArgs_Req_DEFAULT *payment.IssueStoreGiftCardReq
+
+func (p *IssueStoreGiftCardArgs) GetReq() *payment.IssueStoreGiftCardReq {
+	if !p.IsSetReq() {
+		return IssueStoreGiftCardArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *IssueStoreGiftCardArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IssueStoreGiftCardArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type IssueStoreGiftCardResult struct {
+	Success *payment.IssueStoreGiftCardResp
+}
+
+var IssueStoreGiftCardResult_Success_DEFAULT *payment.IssueStoreGiftCardResp
+
+func (p *IssueStoreGiftCardResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.IssueStoreGiftCardResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *IssueStoreGiftCardResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *IssueStoreGiftCardResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *IssueStoreGiftCardResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *IssueStoreGiftCardResult) Unmarshal(in []byte) error {
+	msg := new(payment.IssueStoreGiftCardResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *IssueStoreGiftCardResult) GetSuccess() *payment.IssueStoreGiftCardResp {
+	if !p.IsSetSuccess() {
+		return IssueStoreGiftCardResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *IssueStoreGiftCardResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.IssueStoreGiftCardResp)
+}
+
+func (p *IssueStoreGiftCardResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IssueStoreGiftCardResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getGiftCardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.GetGiftCardReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).GetGiftCard(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetGiftCardArgs:
+		success, err := handler.(payment.PaymentService).GetGiftCard(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetGiftCardResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetGiftCardArgs() interface{} {
+	return &GetGiftCardArgs{}
+}
+
+func newGetGiftCardResult() interface{} {
+	return &GetGiftCardResult{}
+}
+
+type GetGiftCardArgs struct {
+	Req *payment.GetGiftCardReq
+}
+
+func (p *GetGiftCardArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.GetGiftCardReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetGiftCardArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetGiftCardArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetGiftCardArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetGiftCardArgs) Unmarshal(in []byte) error {
+	msg := new(payment.GetGiftCardReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetGiftCardArgs_Req_DEFAULT *payment.GetGiftCardReq
+
+func (p *GetGiftCardArgs) GetReq() *payment.GetGiftCardReq {
+	if !p.IsSetReq() {
+		return GetGiftCardArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetGiftCardArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetGiftCardArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetGiftCardResult struct {
+	Success *payment.GetGiftCardResp
+}
+
+var GetGiftCardResult_Success_DEFAULT *payment.GetGiftCardResp
+
+func (p *GetGiftCardResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.GetGiftCardResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetGiftCardResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetGiftCardResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetGiftCardResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetGiftCardResult) Unmarshal(in []byte) error {
+	msg := new(payment.GetGiftCardResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetGiftCardResult) GetSuccess() *payment.GetGiftCardResp {
+	if !p.IsSetSuccess() {
+		return GetGiftCardResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetGiftCardResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.GetGiftCardResp)
+}
+
+func (p *GetGiftCardResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetGiftCardResult) GetResult() interface{} {
+	return p.Success
+}
+
+func redeemGiftCardHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.RedeemGiftCardReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).RedeemGiftCard(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *RedeemGiftCardArgs:
+		success, err := handler.(payment.PaymentService).RedeemGiftCard(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RedeemGiftCardResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newRedeemGiftCardArgs() interface{} {
+	return &RedeemGiftCardArgs{}
+}
+
+func newRedeemGiftCardResult() interface{} {
+	return &RedeemGiftCardResult{}
+}
+
+type RedeemGiftCardArgs struct {
+	Req *payment.RedeemGiftCardReq
+}
+
+func (p *RedeemGiftCardArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.RedeemGiftCardReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *RedeemGiftCardArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *RedeemGiftCardArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *RedeemGiftCardArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RedeemGiftCardArgs) Unmarshal(in []byte) error {
+	msg := new(payment.RedeemGiftCardReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RedeemGiftCardArgs_Req_DEFAULT *payment.RedeemGiftCardReq
+
+func (p *RedeemGiftCardArgs) GetReq() *payment.RedeemGiftCardReq {
+	if !p.IsSetReq() {
+		return RedeemGiftCardArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RedeemGiftCardArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RedeemGiftCardArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RedeemGiftCardResult struct {
+	Success *payment.RedeemGiftCardResp
+}
+
+var RedeemGiftCardResult_Success_DEFAULT *payment.RedeemGiftCardResp
+
+func (p *RedeemGiftCardResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.RedeemGiftCardResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *RedeemGiftCardResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *RedeemGiftCardResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *RedeemGiftCardResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RedeemGiftCardResult) Unmarshal(in []byte) error {
+	msg := new(payment.RedeemGiftCardResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RedeemGiftCardResult) GetSuccess() *payment.RedeemGiftCardResp {
+	if !p.IsSetSuccess() {
+		return RedeemGiftCardResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RedeemGiftCardResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.RedeemGiftCardResp)
+}
+
+func (p *RedeemGiftCardResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RedeemGiftCardResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getWalletsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(payment.GetWalletsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(payment.PaymentService).GetWallets(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetWalletsArgs:
+		success, err := handler.(payment.PaymentService).GetWallets(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetWalletsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetWalletsArgs() interface{} {
+	return &GetWalletsArgs{}
+}
+
+func newGetWalletsResult() interface{} {
+	return &GetWalletsResult{}
+}
+
+type GetWalletsArgs struct {
+	Req *payment.GetWalletsReq
+}
+
+func (p *GetWalletsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(payment.GetWalletsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetWalletsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetWalletsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetWalletsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetWalletsArgs) Unmarshal(in []byte) error {
+	msg := new(payment.GetWalletsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetWalletsArgs_Req_DEFAULT *payment.GetWalletsReq
+
+func (p *GetWalletsArgs) GetReq() *payment.GetWalletsReq {
+	if !p.IsSetReq() {
+		return GetWalletsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetWalletsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetWalletsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetWalletsResult struct {
+	Success *payment.GetWalletsResp
+}
+
+var GetWalletsResult_Success_DEFAULT *payment.GetWalletsResp
+
+func (p *GetWalletsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(payment.GetWalletsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetWalletsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetWalletsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetWalletsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetWalletsResult) Unmarshal(in []byte) error {
+	msg := new(payment.GetWalletsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetWalletsResult) GetSuccess() *payment.GetWalletsResp {
+	if !p.IsSetSuccess() {
+		return GetWalletsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetWalletsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*payment.GetWalletsResp)
+}
+
+func (p *GetWalletsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetWalletsResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -210,3 +1596,93 @@ func (p *kClient) Charge(ctx context.Context, Req *payment.ChargeReq) (r *paymen
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) Capture(ctx context.Context, Req *payment.CaptureReq) (r *payment.CaptureResp, err error) {
+	var _args CaptureArgs
+	_args.Req = Req
+	var _result CaptureResult
+	if err = p.c.Call(ctx, "Capture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Void(ctx context.Context, Req *payment.VoidReq) (r *payment.VoidResp, err error) {
+	var _args VoidArgs
+	_args.Req = Req
+	var _result VoidResult
+	if err = p.c.Call(ctx, "Void", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Refund(ctx context.Context, Req *payment.RefundReq) (r *payment.RefundResp, err error) {
+	var _args RefundArgs
+	_args.Req = Req
+	var _result RefundResult
+	if err = p.c.Call(ctx, "Refund", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPayment(ctx context.Context, Req *payment.GetPaymentReq) (r *payment.GetPaymentResp, err error) {
+	var _args GetPaymentArgs
+	_args.Req = Req
+	var _result GetPaymentResult
+	if err = p.c.Call(ctx, "GetPayment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) IssueGiftCard(ctx context.Context, Req *payment.IssueGiftCardReq) (r *payment.IssueGiftCardResp, err error) {
+	var _args IssueGiftCardArgs
+	_args.Req = Req
+	var _result IssueGiftCardResult
+	if err = p.c.Call(ctx, "IssueGiftCard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) IssueStoreGiftCard(ctx context.Context, Req *payment.IssueStoreGiftCardReq) (r *payment.IssueStoreGiftCardResp, err error) {
+	var _args IssueStoreGiftCardArgs
+	_args.Req = Req
+	var _result IssueStoreGiftCardResult
+	if err = p.c.Call(ctx, "IssueStoreGiftCard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetGiftCard(ctx context.Context, Req *payment.GetGiftCardReq) (r *payment.GetGiftCardResp, err error) {
+	var _args GetGiftCardArgs
+	_args.Req = Req
+	var _result GetGiftCardResult
+	if err = p.c.Call(ctx, "GetGiftCard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RedeemGiftCard(ctx context.Context, Req *payment.RedeemGiftCardReq) (r *payment.RedeemGiftCardResp, err error) {
+	var _args RedeemGiftCardArgs
+	_args.Req = Req
+	var _result RedeemGiftCardResult
+	if err = p.c.Call(ctx, "RedeemGiftCard", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetWallets(ctx context.Context, Req *payment.GetWalletsReq) (r *payment.GetWalletsResp, err error) {
+	var _args GetWalletsArgs
+	_args.Req = Req
+	var _result GetWalletsResult
+	if err = p.c.Call(ctx, "GetWallets", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -13,10 +13,28 @@ import (
 type Client interface {
 	ListProducts(ctx context.Context, Req *product.ListProductsReq, callOptions ...callopt.Option) (r *product.ListProductsResp, err error)
 	GetProduct(ctx context.Context, Req *product.GetProductReq, callOptions ...callopt.Option) (r *product.GetProductResp, err error)
+	BatchGetProducts(ctx context.Context, Req *product.BatchGetProductsReq, callOptions ...callopt.Option) (r *product.BatchGetProductsResp, err error)
 	SearchProducts(ctx context.Context, Req *product.SearchProductsReq, callOptions ...callopt.Option) (r *product.SearchProductsResp, err error)
 	CreateProduct(ctx context.Context, Req *product.CreateProductReq, callOptions ...callopt.Option) (r *product.CreateProductResp, err error)
 	UpdateProduct(ctx context.Context, Req *product.UpdateProductReq, callOptions ...callopt.Option) (r *product.UpdateProductResp, err error)
 	DeleteProduct(ctx context.Context, Req *product.DeleteProductReq, callOptions ...callopt.Option) (r *product.DeleteProductResp, err error)
+	ReserveStock(ctx context.Context, Req *product.ReserveStockReq, callOptions ...callopt.Option) (r *product.ReserveStockResp, err error)
+	ReleaseStock(ctx context.Context, Req *product.ReleaseStockReq, callOptions ...callopt.Option) (r *product.ReleaseStockResp, err error)
+	CreateCategory(ctx context.Context, Req *product.CreateCategoryReq, callOptions ...callopt.Option) (r *product.CreateCategoryResp, err error)
+	UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq, callOptions ...callopt.Option) (r *product.UpdateCategoryResp, err error)
+	DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq, callOptions ...callopt.Option) (r *product.DeleteCategoryResp, err error)
+	ListCategoryTree(ctx context.Context, Req *product.ListCategoryTreeReq, callOptions ...callopt.Option) (r *product.ListCategoryTreeResp, err error)
+	CreateReview(ctx context.Context, Req *product.CreateReviewReq, callOptions ...callopt.Option) (r *product.CreateReviewResp, err error)
+	ListReviews(ctx context.Context, Req *product.ListReviewsReq, callOptions ...callopt.Option) (r *product.ListReviewsResp, err error)
+	ModerateReview(ctx context.Context, Req *product.ModerateReviewReq, callOptions ...callopt.Option) (r *product.ModerateReviewResp, err error)
+	ImportProducts(ctx context.Context, Req *product.ImportProductsReq, callOptions ...callopt.Option) (r *product.ImportProductsResp, err error)
+	ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...callopt.Option) (r *product.ExportProductsResp, err error)
+	UploadProductImage(ctx context.Context, Req *product.UploadProductImageReq, callOptions ...callopt.Option) (r *product.UploadProductImageResp, err error)
+	UpdateProductImage(ctx context.Context, Req *product.UpdateProductImageReq, callOptions ...callopt.Option) (r *product.UpdateProductImageResp, err error)
+	DeleteProductImage(ctx context.Context, Req *product.DeleteProductImageReq, callOptions ...callopt.Option) (r *product.DeleteProductImageResp, err error)
+	GetImage(ctx context.Context, Req *product.GetImageReq, callOptions ...callopt.Option) (r *product.GetImageResp, err error)
+	ScheduleProductPrice(ctx context.Context, Req *product.ScheduleProductPriceReq, callOptions ...callopt.Option) (r *product.ScheduleProductPriceResp, err error)
+	ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryReq, callOptions ...callopt.Option) (r *product.ListPriceHistoryResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -58,6 +76,11 @@ func (p *kProductCatalogServiceClient) GetProduct(ctx context.Context, Req *prod
 	return p.kClient.GetProduct(ctx, Req)
 }
 
+func (p *kProductCatalogServiceClient) BatchGetProducts(ctx context.Context, Req *product.BatchGetProductsReq, callOptions ...callopt.Option) (r *product.BatchGetProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchGetProducts(ctx, Req)
+}
+
 func (p *kProductCatalogServiceClient) SearchProducts(ctx context.Context, Req *product.SearchProductsReq, callOptions ...callopt.Option) (r *product.SearchProductsResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.SearchProducts(ctx, Req)
@@ -77,3 +100,88 @@ func (p *kProductCatalogServiceClient) DeleteProduct(ctx context.Context, Req *p
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.DeleteProduct(ctx, Req)
 }
+
+func (p *kProductCatalogServiceClient) ReserveStock(ctx context.Context, Req *product.ReserveStockReq, callOptions ...callopt.Option) (r *product.ReserveStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReserveStock(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ReleaseStock(ctx context.Context, Req *product.ReleaseStockReq, callOptions ...callopt.Option) (r *product.ReleaseStockResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReleaseStock(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) CreateCategory(ctx context.Context, Req *product.CreateCategoryReq, callOptions ...callopt.Option) (r *product.CreateCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateCategory(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq, callOptions ...callopt.Option) (r *product.UpdateCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCategory(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq, callOptions ...callopt.Option) (r *product.DeleteCategoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteCategory(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ListCategoryTree(ctx context.Context, Req *product.ListCategoryTreeReq, callOptions ...callopt.Option) (r *product.ListCategoryTreeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCategoryTree(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) CreateReview(ctx context.Context, Req *product.CreateReviewReq, callOptions ...callopt.Option) (r *product.CreateReviewResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateReview(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ListReviews(ctx context.Context, Req *product.ListReviewsReq, callOptions ...callopt.Option) (r *product.ListReviewsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListReviews(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ModerateReview(ctx context.Context, Req *product.ModerateReviewReq, callOptions ...callopt.Option) (r *product.ModerateReviewResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ModerateReview(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ImportProducts(ctx context.Context, Req *product.ImportProductsReq, callOptions ...callopt.Option) (r *product.ImportProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ImportProducts(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...callopt.Option) (r *product.ExportProductsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportProducts(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) UploadProductImage(ctx context.Context, Req *product.UploadProductImageReq, callOptions ...callopt.Option) (r *product.UploadProductImageResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadProductImage(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) UpdateProductImage(ctx context.Context, Req *product.UpdateProductImageReq, callOptions ...callopt.Option) (r *product.UpdateProductImageResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateProductImage(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) DeleteProductImage(ctx context.Context, Req *product.DeleteProductImageReq, callOptions ...callopt.Option) (r *product.DeleteProductImageResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteProductImage(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) GetImage(ctx context.Context, Req *product.GetImageReq, callOptions ...callopt.Option) (r *product.GetImageResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetImage(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ScheduleProductPrice(ctx context.Context, Req *product.ScheduleProductPriceReq, callOptions ...callopt.Option) (r *product.ScheduleProductPriceResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ScheduleProductPrice(ctx, Req)
+}
+
+func (p *kProductCatalogServiceClient) ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryReq, callOptions ...callopt.Option) (r *product.ListPriceHistoryResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPriceHistory(ctx, Req)
+}
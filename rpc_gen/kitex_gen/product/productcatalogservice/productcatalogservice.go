@@ -29,6 +29,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"BatchGetProducts": kitex.NewMethodInfo(
+		batchGetProductsHandler,
+		newBatchGetProductsArgs,
+		newBatchGetProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"SearchProducts": kitex.NewMethodInfo(
 		searchProductsHandler,
 		newSearchProductsArgs,
@@ -57,6 +64,125 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"ReserveStock": kitex.NewMethodInfo(
+		reserveStockHandler,
+		newReserveStockArgs,
+		newReserveStockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ReleaseStock": kitex.NewMethodInfo(
+		releaseStockHandler,
+		newReleaseStockArgs,
+		newReleaseStockResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateCategory": kitex.NewMethodInfo(
+		createCategoryHandler,
+		newCreateCategoryArgs,
+		newCreateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateCategory": kitex.NewMethodInfo(
+		updateCategoryHandler,
+		newUpdateCategoryArgs,
+		newUpdateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteCategory": kitex.NewMethodInfo(
+		deleteCategoryHandler,
+		newDeleteCategoryArgs,
+		newDeleteCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListCategoryTree": kitex.NewMethodInfo(
+		listCategoryTreeHandler,
+		newListCategoryTreeArgs,
+		newListCategoryTreeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateReview": kitex.NewMethodInfo(
+		createReviewHandler,
+		newCreateReviewArgs,
+		newCreateReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListReviews": kitex.NewMethodInfo(
+		listReviewsHandler,
+		newListReviewsArgs,
+		newListReviewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ModerateReview": kitex.NewMethodInfo(
+		moderateReviewHandler,
+		newModerateReviewArgs,
+		newModerateReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ImportProducts": kitex.NewMethodInfo(
+		importProductsHandler,
+		newImportProductsArgs,
+		newImportProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ExportProducts": kitex.NewMethodInfo(
+		exportProductsHandler,
+		newExportProductsArgs,
+		newExportProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UploadProductImage": kitex.NewMethodInfo(
+		uploadProductImageHandler,
+		newUploadProductImageArgs,
+		newUploadProductImageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateProductImage": kitex.NewMethodInfo(
+		updateProductImageHandler,
+		newUpdateProductImageArgs,
+		newUpdateProductImageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteProductImage": kitex.NewMethodInfo(
+		deleteProductImageHandler,
+		newDeleteProductImageArgs,
+		newDeleteProductImageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetImage": kitex.NewMethodInfo(
+		getImageHandler,
+		newGetImageArgs,
+		newGetImageResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ScheduleProductPrice": kitex.NewMethodInfo(
+		scheduleProductPriceHandler,
+		newScheduleProductPriceArgs,
+		newScheduleProductPriceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListPriceHistory": kitex.NewMethodInfo(
+		listPriceHistoryHandler,
+		newListPriceHistoryArgs,
+		newListPriceHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -429,6 +555,159 @@ func (p *GetProductResult) GetResult() interface{} {
 	return p.Success
 }
 
+func batchGetProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.BatchGetProductsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).BatchGetProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *BatchGetProductsArgs:
+		success, err := handler.(product.ProductCatalogService).BatchGetProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*BatchGetProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newBatchGetProductsArgs() interface{} {
+	return &BatchGetProductsArgs{}
+}
+
+func newBatchGetProductsResult() interface{} {
+	return &BatchGetProductsResult{}
+}
+
+type BatchGetProductsArgs struct {
+	Req *product.BatchGetProductsReq
+}
+
+func (p *BatchGetProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.BatchGetProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *BatchGetProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *BatchGetProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *BatchGetProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BatchGetProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.BatchGetProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BatchGetProductsArgs_Req_DEFAULT *product.BatchGetProductsReq
+
+func (p *BatchGetProductsArgs) GetReq() *product.BatchGetProductsReq {
+	if !p.IsSetReq() {
+		return BatchGetProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BatchGetProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BatchGetProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BatchGetProductsResult struct {
+	Success *product.BatchGetProductsResp
+}
+
+var BatchGetProductsResult_Success_DEFAULT *product.BatchGetProductsResp
+
+func (p *BatchGetProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.BatchGetProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *BatchGetProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *BatchGetProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *BatchGetProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BatchGetProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.BatchGetProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BatchGetProductsResult) GetSuccess() *product.BatchGetProductsResp {
+	if !p.IsSetSuccess() {
+		return BatchGetProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BatchGetProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.BatchGetProductsResp)
+}
+
+func (p *BatchGetProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BatchGetProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
 func searchProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
@@ -1041,71 +1320,2852 @@ func (p *DeleteProductResult) GetResult() interface{} {
 	return p.Success
 }
 
-type kClient struct {
-	c client.Client
+func reserveStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ReserveStockReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ReserveStock(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ReserveStockArgs:
+		success, err := handler.(product.ProductCatalogService).ReserveStock(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReserveStockResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newReserveStockArgs() interface{} {
+	return &ReserveStockArgs{}
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
+func newReserveStockResult() interface{} {
+	return &ReserveStockResult{}
+}
+
+type ReserveStockArgs struct {
+	Req *product.ReserveStockReq
+}
+
+func (p *ReserveStockArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ReserveStockReq)
 	}
+	return p.Req.FastRead(buf, _type, number)
 }
 
-func (p *kClient) ListProducts(ctx context.Context, Req *product.ListProductsReq) (r *product.ListProductsResp, err error) {
-	var _args ListProductsArgs
-	_args.Req = Req
-	var _result ListProductsResult
-	if err = p.c.Call(ctx, "ListProducts", &_args, &_result); err != nil {
-		return
+func (p *ReserveStockArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
 	}
-	return _result.GetSuccess(), nil
+	return p.Req.FastWrite(buf)
 }
 
-func (p *kClient) GetProduct(ctx context.Context, Req *product.GetProductReq) (r *product.GetProductResp, err error) {
-	var _args GetProductArgs
-	_args.Req = Req
-	var _result GetProductResult
-	if err = p.c.Call(ctx, "GetProduct", &_args, &_result); err != nil {
-		return
+func (p *ReserveStockArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
 	}
-	return _result.GetSuccess(), nil
+	return p.Req.Size()
 }
 
-func (p *kClient) SearchProducts(ctx context.Context, Req *product.SearchProductsReq) (r *product.SearchProductsResp, err error) {
-	var _args SearchProductsArgs
-	_args.Req = Req
-	var _result SearchProductsResult
-	if err = p.c.Call(ctx, "SearchProducts", &_args, &_result); err != nil {
-		return
+func (p *ReserveStockArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
 	}
-	return _result.GetSuccess(), nil
+	return proto.Marshal(p.Req)
 }
 
-func (p *kClient) CreateProduct(ctx context.Context, Req *product.CreateProductReq) (r *product.CreateProductResp, err error) {
-	var _args CreateProductArgs
-	_args.Req = Req
-	var _result CreateProductResult
-	if err = p.c.Call(ctx, "CreateProduct", &_args, &_result); err != nil {
-		return
+func (p *ReserveStockArgs) Unmarshal(in []byte) error {
+	msg := new(product.ReserveStockReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Req = msg
+	return nil
 }
 
-func (p *kClient) UpdateProduct(ctx context.Context, Req *product.UpdateProductReq) (r *product.UpdateProductResp, err error) {
-	var _args UpdateProductArgs
-	_args.Req = Req
-	var _result UpdateProductResult
-	if err = p.c.Call(ctx, "UpdateProduct", &_args, &_result); err != nil {
-		return
+var ReserveStockArgs_Req_DEFAULT *product.ReserveStockReq
+
+func (p *ReserveStockArgs) GetReq() *product.ReserveStockReq {
+	if !p.IsSetReq() {
+		return ReserveStockArgs_Req_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.Req
 }
 
-func (p *kClient) DeleteProduct(ctx context.Context, Req *product.DeleteProductReq) (r *product.DeleteProductResp, err error) {
-	var _args DeleteProductArgs
-	_args.Req = Req
-	var _result DeleteProductResult
-	if err = p.c.Call(ctx, "DeleteProduct", &_args, &_result); err != nil {
+func (p *ReserveStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReserveStockArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReserveStockResult struct {
+	Success *product.ReserveStockResp
+}
+
+var ReserveStockResult_Success_DEFAULT *product.ReserveStockResp
+
+func (p *ReserveStockResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ReserveStockResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReserveStockResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReserveStockResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReserveStockResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReserveStockResult) Unmarshal(in []byte) error {
+	msg := new(product.ReserveStockResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReserveStockResult) GetSuccess() *product.ReserveStockResp {
+	if !p.IsSetSuccess() {
+		return ReserveStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReserveStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ReserveStockResp)
+}
+
+func (p *ReserveStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReserveStockResult) GetResult() interface{} {
+	return p.Success
+}
+
+func releaseStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ReleaseStockReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ReleaseStock(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ReleaseStockArgs:
+		success, err := handler.(product.ProductCatalogService).ReleaseStock(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReleaseStockResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newReleaseStockArgs() interface{} {
+	return &ReleaseStockArgs{}
+}
+
+func newReleaseStockResult() interface{} {
+	return &ReleaseStockResult{}
+}
+
+type ReleaseStockArgs struct {
+	Req *product.ReleaseStockReq
+}
+
+func (p *ReleaseStockArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ReleaseStockReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ReleaseStockArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ReleaseStockArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ReleaseStockArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReleaseStockArgs) Unmarshal(in []byte) error {
+	msg := new(product.ReleaseStockReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReleaseStockArgs_Req_DEFAULT *product.ReleaseStockReq
+
+func (p *ReleaseStockArgs) GetReq() *product.ReleaseStockReq {
+	if !p.IsSetReq() {
+		return ReleaseStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReleaseStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReleaseStockArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReleaseStockResult struct {
+	Success *product.ReleaseStockResp
+}
+
+var ReleaseStockResult_Success_DEFAULT *product.ReleaseStockResp
+
+func (p *ReleaseStockResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ReleaseStockResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReleaseStockResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReleaseStockResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReleaseStockResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReleaseStockResult) Unmarshal(in []byte) error {
+	msg := new(product.ReleaseStockResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReleaseStockResult) GetSuccess() *product.ReleaseStockResp {
+	if !p.IsSetSuccess() {
+		return ReleaseStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReleaseStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ReleaseStockResp)
+}
+
+func (p *ReleaseStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReleaseStockResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CreateCategoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).CreateCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateCategoryArgs:
+		success, err := handler.(product.ProductCatalogService).CreateCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateCategoryArgs() interface{} {
+	return &CreateCategoryArgs{}
+}
+
+func newCreateCategoryResult() interface{} {
+	return &CreateCategoryResult{}
+}
+
+type CreateCategoryArgs struct {
+	Req *product.CreateCategoryReq
+}
+
+func (p *CreateCategoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.CreateCategoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateCategoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateCategoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateCategoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateCategoryArgs_Req_DEFAULT *product.CreateCategoryReq
+
+func (p *CreateCategoryArgs) GetReq() *product.CreateCategoryReq {
+	if !p.IsSetReq() {
+		return CreateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateCategoryResult struct {
+	Success *product.CreateCategoryResp
+}
+
+var CreateCategoryResult_Success_DEFAULT *product.CreateCategoryResp
+
+func (p *CreateCategoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.CreateCategoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateCategoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateCategoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateCategoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateCategoryResult) GetSuccess() *product.CreateCategoryResp {
+	if !p.IsSetSuccess() {
+		return CreateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateCategoryResp)
+}
+
+func (p *CreateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UpdateCategoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).UpdateCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateCategoryArgs:
+		success, err := handler.(product.ProductCatalogService).UpdateCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateCategoryArgs() interface{} {
+	return &UpdateCategoryArgs{}
+}
+
+func newUpdateCategoryResult() interface{} {
+	return &UpdateCategoryResult{}
+}
+
+type UpdateCategoryArgs struct {
+	Req *product.UpdateCategoryReq
+}
+
+func (p *UpdateCategoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.UpdateCategoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateCategoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateCategoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.UpdateCategoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateCategoryArgs_Req_DEFAULT *product.UpdateCategoryReq
+
+func (p *UpdateCategoryArgs) GetReq() *product.UpdateCategoryReq {
+	if !p.IsSetReq() {
+		return UpdateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateCategoryResult struct {
+	Success *product.UpdateCategoryResp
+}
+
+var UpdateCategoryResult_Success_DEFAULT *product.UpdateCategoryResp
+
+func (p *UpdateCategoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.UpdateCategoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateCategoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateCategoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.UpdateCategoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateCategoryResult) GetSuccess() *product.UpdateCategoryResp {
+	if !p.IsSetSuccess() {
+		return UpdateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UpdateCategoryResp)
+}
+
+func (p *UpdateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeleteCategoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).DeleteCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteCategoryArgs:
+		success, err := handler.(product.ProductCatalogService).DeleteCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteCategoryArgs() interface{} {
+	return &DeleteCategoryArgs{}
+}
+
+func newDeleteCategoryResult() interface{} {
+	return &DeleteCategoryResult{}
+}
+
+type DeleteCategoryArgs struct {
+	Req *product.DeleteCategoryReq
+}
+
+func (p *DeleteCategoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.DeleteCategoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteCategoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteCategoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteCategoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteCategoryArgs_Req_DEFAULT *product.DeleteCategoryReq
+
+func (p *DeleteCategoryArgs) GetReq() *product.DeleteCategoryReq {
+	if !p.IsSetReq() {
+		return DeleteCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteCategoryResult struct {
+	Success *product.DeleteCategoryResp
+}
+
+var DeleteCategoryResult_Success_DEFAULT *product.DeleteCategoryResp
+
+func (p *DeleteCategoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.DeleteCategoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteCategoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteCategoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteCategoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteCategoryResult) GetSuccess() *product.DeleteCategoryResp {
+	if !p.IsSetSuccess() {
+		return DeleteCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteCategoryResp)
+}
+
+func (p *DeleteCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listCategoryTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListCategoryTreeReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ListCategoryTree(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListCategoryTreeArgs:
+		success, err := handler.(product.ProductCatalogService).ListCategoryTree(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListCategoryTreeResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListCategoryTreeArgs() interface{} {
+	return &ListCategoryTreeArgs{}
+}
+
+func newListCategoryTreeResult() interface{} {
+	return &ListCategoryTreeResult{}
+}
+
+type ListCategoryTreeArgs struct {
+	Req *product.ListCategoryTreeReq
+}
+
+func (p *ListCategoryTreeArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ListCategoryTreeReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListCategoryTreeArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListCategoryTreeArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListCategoryTreeArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListCategoryTreeArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListCategoryTreeReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListCategoryTreeArgs_Req_DEFAULT *product.ListCategoryTreeReq
+
+func (p *ListCategoryTreeArgs) GetReq() *product.ListCategoryTreeReq {
+	if !p.IsSetReq() {
+		return ListCategoryTreeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListCategoryTreeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListCategoryTreeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListCategoryTreeResult struct {
+	Success *product.ListCategoryTreeResp
+}
+
+var ListCategoryTreeResult_Success_DEFAULT *product.ListCategoryTreeResp
+
+func (p *ListCategoryTreeResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ListCategoryTreeResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListCategoryTreeResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListCategoryTreeResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListCategoryTreeResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListCategoryTreeResult) Unmarshal(in []byte) error {
+	msg := new(product.ListCategoryTreeResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListCategoryTreeResult) GetSuccess() *product.ListCategoryTreeResp {
+	if !p.IsSetSuccess() {
+		return ListCategoryTreeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListCategoryTreeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListCategoryTreeResp)
+}
+
+func (p *ListCategoryTreeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListCategoryTreeResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CreateReviewReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).CreateReview(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateReviewArgs:
+		success, err := handler.(product.ProductCatalogService).CreateReview(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateReviewResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateReviewArgs() interface{} {
+	return &CreateReviewArgs{}
+}
+
+func newCreateReviewResult() interface{} {
+	return &CreateReviewResult{}
+}
+
+type CreateReviewArgs struct {
+	Req *product.CreateReviewReq
+}
+
+func (p *CreateReviewArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.CreateReviewReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreateReviewArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreateReviewArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreateReviewArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateReviewArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateReviewReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateReviewArgs_Req_DEFAULT *product.CreateReviewReq
+
+func (p *CreateReviewArgs) GetReq() *product.CreateReviewReq {
+	if !p.IsSetReq() {
+		return CreateReviewArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateReviewArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateReviewArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateReviewResult struct {
+	Success *product.CreateReviewResp
+}
+
+var CreateReviewResult_Success_DEFAULT *product.CreateReviewResp
+
+func (p *CreateReviewResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.CreateReviewResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreateReviewResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreateReviewResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreateReviewResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateReviewResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateReviewResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateReviewResult) GetSuccess() *product.CreateReviewResp {
+	if !p.IsSetSuccess() {
+		return CreateReviewResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateReviewResp)
+}
+
+func (p *CreateReviewResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateReviewResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listReviewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListReviewsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ListReviews(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListReviewsArgs:
+		success, err := handler.(product.ProductCatalogService).ListReviews(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListReviewsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListReviewsArgs() interface{} {
+	return &ListReviewsArgs{}
+}
+
+func newListReviewsResult() interface{} {
+	return &ListReviewsResult{}
+}
+
+type ListReviewsArgs struct {
+	Req *product.ListReviewsReq
+}
+
+func (p *ListReviewsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ListReviewsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListReviewsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListReviewsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListReviewsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListReviewsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListReviewsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListReviewsArgs_Req_DEFAULT *product.ListReviewsReq
+
+func (p *ListReviewsArgs) GetReq() *product.ListReviewsReq {
+	if !p.IsSetReq() {
+		return ListReviewsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListReviewsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListReviewsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListReviewsResult struct {
+	Success *product.ListReviewsResp
+}
+
+var ListReviewsResult_Success_DEFAULT *product.ListReviewsResp
+
+func (p *ListReviewsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ListReviewsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListReviewsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListReviewsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListReviewsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListReviewsResult) Unmarshal(in []byte) error {
+	msg := new(product.ListReviewsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListReviewsResult) GetSuccess() *product.ListReviewsResp {
+	if !p.IsSetSuccess() {
+		return ListReviewsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListReviewsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListReviewsResp)
+}
+
+func (p *ListReviewsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListReviewsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func moderateReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ModerateReviewReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ModerateReview(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ModerateReviewArgs:
+		success, err := handler.(product.ProductCatalogService).ModerateReview(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ModerateReviewResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newModerateReviewArgs() interface{} {
+	return &ModerateReviewArgs{}
+}
+
+func newModerateReviewResult() interface{} {
+	return &ModerateReviewResult{}
+}
+
+type ModerateReviewArgs struct {
+	Req *product.ModerateReviewReq
+}
+
+func (p *ModerateReviewArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ModerateReviewReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ModerateReviewArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ModerateReviewArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ModerateReviewArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ModerateReviewArgs) Unmarshal(in []byte) error {
+	msg := new(product.ModerateReviewReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ModerateReviewArgs_Req_DEFAULT *product.ModerateReviewReq
+
+func (p *ModerateReviewArgs) GetReq() *product.ModerateReviewReq {
+	if !p.IsSetReq() {
+		return ModerateReviewArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ModerateReviewArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ModerateReviewArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ModerateReviewResult struct {
+	Success *product.ModerateReviewResp
+}
+
+var ModerateReviewResult_Success_DEFAULT *product.ModerateReviewResp
+
+func (p *ModerateReviewResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ModerateReviewResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ModerateReviewResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ModerateReviewResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ModerateReviewResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ModerateReviewResult) Unmarshal(in []byte) error {
+	msg := new(product.ModerateReviewResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ModerateReviewResult) GetSuccess() *product.ModerateReviewResp {
+	if !p.IsSetSuccess() {
+		return ModerateReviewResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ModerateReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ModerateReviewResp)
+}
+
+func (p *ModerateReviewResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ModerateReviewResult) GetResult() interface{} {
+	return p.Success
+}
+
+func importProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ImportProductsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ImportProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ImportProductsArgs:
+		success, err := handler.(product.ProductCatalogService).ImportProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ImportProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newImportProductsArgs() interface{} {
+	return &ImportProductsArgs{}
+}
+
+func newImportProductsResult() interface{} {
+	return &ImportProductsResult{}
+}
+
+type ImportProductsArgs struct {
+	Req *product.ImportProductsReq
+}
+
+func (p *ImportProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ImportProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ImportProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ImportProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ImportProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ImportProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ImportProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ImportProductsArgs_Req_DEFAULT *product.ImportProductsReq
+
+func (p *ImportProductsArgs) GetReq() *product.ImportProductsReq {
+	if !p.IsSetReq() {
+		return ImportProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ImportProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ImportProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ImportProductsResult struct {
+	Success *product.ImportProductsResp
+}
+
+var ImportProductsResult_Success_DEFAULT *product.ImportProductsResp
+
+func (p *ImportProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ImportProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ImportProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ImportProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ImportProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ImportProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.ImportProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ImportProductsResult) GetSuccess() *product.ImportProductsResp {
+	if !p.IsSetSuccess() {
+		return ImportProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ImportProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ImportProductsResp)
+}
+
+func (p *ImportProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ImportProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ExportProductsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ExportProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ExportProductsArgs:
+		success, err := handler.(product.ProductCatalogService).ExportProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newExportProductsArgs() interface{} {
+	return &ExportProductsArgs{}
+}
+
+func newExportProductsResult() interface{} {
+	return &ExportProductsResult{}
+}
+
+type ExportProductsArgs struct {
+	Req *product.ExportProductsReq
+}
+
+func (p *ExportProductsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ExportProductsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ExportProductsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ExportProductsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ExportProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ExportProductsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportProductsArgs_Req_DEFAULT *product.ExportProductsReq
+
+func (p *ExportProductsArgs) GetReq() *product.ExportProductsReq {
+	if !p.IsSetReq() {
+		return ExportProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportProductsResult struct {
+	Success *product.ExportProductsResp
+}
+
+var ExportProductsResult_Success_DEFAULT *product.ExportProductsResp
+
+func (p *ExportProductsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ExportProductsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ExportProductsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ExportProductsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ExportProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.ExportProductsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportProductsResult) GetSuccess() *product.ExportProductsResp {
+	if !p.IsSetSuccess() {
+		return ExportProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ExportProductsResp)
+}
+
+func (p *ExportProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func uploadProductImageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UploadProductImageReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).UploadProductImage(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UploadProductImageArgs:
+		success, err := handler.(product.ProductCatalogService).UploadProductImage(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadProductImageResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUploadProductImageArgs() interface{} {
+	return &UploadProductImageArgs{}
+}
+
+func newUploadProductImageResult() interface{} {
+	return &UploadProductImageResult{}
+}
+
+type UploadProductImageArgs struct {
+	Req *product.UploadProductImageReq
+}
+
+func (p *UploadProductImageArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.UploadProductImageReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UploadProductImageArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UploadProductImageArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UploadProductImageArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadProductImageArgs) Unmarshal(in []byte) error {
+	msg := new(product.UploadProductImageReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadProductImageArgs_Req_DEFAULT *product.UploadProductImageReq
+
+func (p *UploadProductImageArgs) GetReq() *product.UploadProductImageReq {
+	if !p.IsSetReq() {
+		return UploadProductImageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadProductImageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UploadProductImageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UploadProductImageResult struct {
+	Success *product.UploadProductImageResp
+}
+
+var UploadProductImageResult_Success_DEFAULT *product.UploadProductImageResp
+
+func (p *UploadProductImageResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.UploadProductImageResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UploadProductImageResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UploadProductImageResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UploadProductImageResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadProductImageResult) Unmarshal(in []byte) error {
+	msg := new(product.UploadProductImageResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadProductImageResult) GetSuccess() *product.UploadProductImageResp {
+	if !p.IsSetSuccess() {
+		return UploadProductImageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadProductImageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UploadProductImageResp)
+}
+
+func (p *UploadProductImageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UploadProductImageResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateProductImageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UpdateProductImageReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).UpdateProductImage(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateProductImageArgs:
+		success, err := handler.(product.ProductCatalogService).UpdateProductImage(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateProductImageResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateProductImageArgs() interface{} {
+	return &UpdateProductImageArgs{}
+}
+
+func newUpdateProductImageResult() interface{} {
+	return &UpdateProductImageResult{}
+}
+
+type UpdateProductImageArgs struct {
+	Req *product.UpdateProductImageReq
+}
+
+func (p *UpdateProductImageArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.UpdateProductImageReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProductImageArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateProductImageArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateProductImageArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateProductImageArgs) Unmarshal(in []byte) error {
+	msg := new(product.UpdateProductImageReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateProductImageArgs_Req_DEFAULT *product.UpdateProductImageReq
+
+func (p *UpdateProductImageArgs) GetReq() *product.UpdateProductImageReq {
+	if !p.IsSetReq() {
+		return UpdateProductImageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateProductImageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateProductImageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateProductImageResult struct {
+	Success *product.UpdateProductImageResp
+}
+
+var UpdateProductImageResult_Success_DEFAULT *product.UpdateProductImageResp
+
+func (p *UpdateProductImageResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.UpdateProductImageResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateProductImageResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateProductImageResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateProductImageResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateProductImageResult) Unmarshal(in []byte) error {
+	msg := new(product.UpdateProductImageResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateProductImageResult) GetSuccess() *product.UpdateProductImageResp {
+	if !p.IsSetSuccess() {
+		return UpdateProductImageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateProductImageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UpdateProductImageResp)
+}
+
+func (p *UpdateProductImageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateProductImageResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteProductImageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeleteProductImageReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).DeleteProductImage(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteProductImageArgs:
+		success, err := handler.(product.ProductCatalogService).DeleteProductImage(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteProductImageResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteProductImageArgs() interface{} {
+	return &DeleteProductImageArgs{}
+}
+
+func newDeleteProductImageResult() interface{} {
+	return &DeleteProductImageResult{}
+}
+
+type DeleteProductImageArgs struct {
+	Req *product.DeleteProductImageReq
+}
+
+func (p *DeleteProductImageArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.DeleteProductImageReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteProductImageArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteProductImageArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteProductImageArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteProductImageArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteProductImageReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteProductImageArgs_Req_DEFAULT *product.DeleteProductImageReq
+
+func (p *DeleteProductImageArgs) GetReq() *product.DeleteProductImageReq {
+	if !p.IsSetReq() {
+		return DeleteProductImageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteProductImageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteProductImageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteProductImageResult struct {
+	Success *product.DeleteProductImageResp
+}
+
+var DeleteProductImageResult_Success_DEFAULT *product.DeleteProductImageResp
+
+func (p *DeleteProductImageResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.DeleteProductImageResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteProductImageResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteProductImageResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteProductImageResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteProductImageResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteProductImageResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteProductImageResult) GetSuccess() *product.DeleteProductImageResp {
+	if !p.IsSetSuccess() {
+		return DeleteProductImageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteProductImageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteProductImageResp)
+}
+
+func (p *DeleteProductImageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteProductImageResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getImageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetImageReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).GetImage(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetImageArgs:
+		success, err := handler.(product.ProductCatalogService).GetImage(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetImageResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetImageArgs() interface{} {
+	return &GetImageArgs{}
+}
+
+func newGetImageResult() interface{} {
+	return &GetImageResult{}
+}
+
+type GetImageArgs struct {
+	Req *product.GetImageReq
+}
+
+func (p *GetImageArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.GetImageReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetImageArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetImageArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetImageArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetImageArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetImageReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetImageArgs_Req_DEFAULT *product.GetImageReq
+
+func (p *GetImageArgs) GetReq() *product.GetImageReq {
+	if !p.IsSetReq() {
+		return GetImageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetImageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetImageArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetImageResult struct {
+	Success *product.GetImageResp
+}
+
+var GetImageResult_Success_DEFAULT *product.GetImageResp
+
+func (p *GetImageResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.GetImageResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetImageResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetImageResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetImageResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetImageResult) Unmarshal(in []byte) error {
+	msg := new(product.GetImageResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetImageResult) GetSuccess() *product.GetImageResp {
+	if !p.IsSetSuccess() {
+		return GetImageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetImageResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetImageResp)
+}
+
+func (p *GetImageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetImageResult) GetResult() interface{} {
+	return p.Success
+}
+
+func scheduleProductPriceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ScheduleProductPriceReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ScheduleProductPrice(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ScheduleProductPriceArgs:
+		success, err := handler.(product.ProductCatalogService).ScheduleProductPrice(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ScheduleProductPriceResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newScheduleProductPriceArgs() interface{} {
+	return &ScheduleProductPriceArgs{}
+}
+
+func newScheduleProductPriceResult() interface{} {
+	return &ScheduleProductPriceResult{}
+}
+
+type ScheduleProductPriceArgs struct {
+	Req *product.ScheduleProductPriceReq
+}
+
+func (p *ScheduleProductPriceArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ScheduleProductPriceReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ScheduleProductPriceArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ScheduleProductPriceArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ScheduleProductPriceArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ScheduleProductPriceArgs) Unmarshal(in []byte) error {
+	msg := new(product.ScheduleProductPriceReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ScheduleProductPriceArgs_Req_DEFAULT *product.ScheduleProductPriceReq
+
+func (p *ScheduleProductPriceArgs) GetReq() *product.ScheduleProductPriceReq {
+	if !p.IsSetReq() {
+		return ScheduleProductPriceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ScheduleProductPriceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ScheduleProductPriceArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ScheduleProductPriceResult struct {
+	Success *product.ScheduleProductPriceResp
+}
+
+var ScheduleProductPriceResult_Success_DEFAULT *product.ScheduleProductPriceResp
+
+func (p *ScheduleProductPriceResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ScheduleProductPriceResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ScheduleProductPriceResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ScheduleProductPriceResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ScheduleProductPriceResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ScheduleProductPriceResult) Unmarshal(in []byte) error {
+	msg := new(product.ScheduleProductPriceResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ScheduleProductPriceResult) GetSuccess() *product.ScheduleProductPriceResp {
+	if !p.IsSetSuccess() {
+		return ScheduleProductPriceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ScheduleProductPriceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ScheduleProductPriceResp)
+}
+
+func (p *ScheduleProductPriceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ScheduleProductPriceResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listPriceHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListPriceHistoryReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductCatalogService).ListPriceHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListPriceHistoryArgs:
+		success, err := handler.(product.ProductCatalogService).ListPriceHistory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListPriceHistoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListPriceHistoryArgs() interface{} {
+	return &ListPriceHistoryArgs{}
+}
+
+func newListPriceHistoryResult() interface{} {
+	return &ListPriceHistoryResult{}
+}
+
+type ListPriceHistoryArgs struct {
+	Req *product.ListPriceHistoryReq
+}
+
+func (p *ListPriceHistoryArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(product.ListPriceHistoryReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListPriceHistoryArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListPriceHistoryArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListPriceHistoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListPriceHistoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListPriceHistoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListPriceHistoryArgs_Req_DEFAULT *product.ListPriceHistoryReq
+
+func (p *ListPriceHistoryArgs) GetReq() *product.ListPriceHistoryReq {
+	if !p.IsSetReq() {
+		return ListPriceHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListPriceHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListPriceHistoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListPriceHistoryResult struct {
+	Success *product.ListPriceHistoryResp
+}
+
+var ListPriceHistoryResult_Success_DEFAULT *product.ListPriceHistoryResp
+
+func (p *ListPriceHistoryResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(product.ListPriceHistoryResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListPriceHistoryResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListPriceHistoryResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListPriceHistoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListPriceHistoryResult) Unmarshal(in []byte) error {
+	msg := new(product.ListPriceHistoryResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListPriceHistoryResult) GetSuccess() *product.ListPriceHistoryResp {
+	if !p.IsSetSuccess() {
+		return ListPriceHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListPriceHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListPriceHistoryResp)
+}
+
+func (p *ListPriceHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListPriceHistoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) ListProducts(ctx context.Context, Req *product.ListProductsReq) (r *product.ListProductsResp, err error) {
+	var _args ListProductsArgs
+	_args.Req = Req
+	var _result ListProductsResult
+	if err = p.c.Call(ctx, "ListProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProduct(ctx context.Context, Req *product.GetProductReq) (r *product.GetProductResp, err error) {
+	var _args GetProductArgs
+	_args.Req = Req
+	var _result GetProductResult
+	if err = p.c.Call(ctx, "GetProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BatchGetProducts(ctx context.Context, Req *product.BatchGetProductsReq) (r *product.BatchGetProductsResp, err error) {
+	var _args BatchGetProductsArgs
+	_args.Req = Req
+	var _result BatchGetProductsResult
+	if err = p.c.Call(ctx, "BatchGetProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchProducts(ctx context.Context, Req *product.SearchProductsReq) (r *product.SearchProductsResp, err error) {
+	var _args SearchProductsArgs
+	_args.Req = Req
+	var _result SearchProductsResult
+	if err = p.c.Call(ctx, "SearchProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateProduct(ctx context.Context, Req *product.CreateProductReq) (r *product.CreateProductResp, err error) {
+	var _args CreateProductArgs
+	_args.Req = Req
+	var _result CreateProductResult
+	if err = p.c.Call(ctx, "CreateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProduct(ctx context.Context, Req *product.UpdateProductReq) (r *product.UpdateProductResp, err error) {
+	var _args UpdateProductArgs
+	_args.Req = Req
+	var _result UpdateProductResult
+	if err = p.c.Call(ctx, "UpdateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteProduct(ctx context.Context, Req *product.DeleteProductReq) (r *product.DeleteProductResp, err error) {
+	var _args DeleteProductArgs
+	_args.Req = Req
+	var _result DeleteProductResult
+	if err = p.c.Call(ctx, "DeleteProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReserveStock(ctx context.Context, Req *product.ReserveStockReq) (r *product.ReserveStockResp, err error) {
+	var _args ReserveStockArgs
+	_args.Req = Req
+	var _result ReserveStockResult
+	if err = p.c.Call(ctx, "ReserveStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReleaseStock(ctx context.Context, Req *product.ReleaseStockReq) (r *product.ReleaseStockResp, err error) {
+	var _args ReleaseStockArgs
+	_args.Req = Req
+	var _result ReleaseStockResult
+	if err = p.c.Call(ctx, "ReleaseStock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateCategory(ctx context.Context, Req *product.CreateCategoryReq) (r *product.CreateCategoryResp, err error) {
+	var _args CreateCategoryArgs
+	_args.Req = Req
+	var _result CreateCategoryResult
+	if err = p.c.Call(ctx, "CreateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq) (r *product.UpdateCategoryResp, err error) {
+	var _args UpdateCategoryArgs
+	_args.Req = Req
+	var _result UpdateCategoryResult
+	if err = p.c.Call(ctx, "UpdateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq) (r *product.DeleteCategoryResp, err error) {
+	var _args DeleteCategoryArgs
+	_args.Req = Req
+	var _result DeleteCategoryResult
+	if err = p.c.Call(ctx, "DeleteCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCategoryTree(ctx context.Context, Req *product.ListCategoryTreeReq) (r *product.ListCategoryTreeResp, err error) {
+	var _args ListCategoryTreeArgs
+	_args.Req = Req
+	var _result ListCategoryTreeResult
+	if err = p.c.Call(ctx, "ListCategoryTree", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateReview(ctx context.Context, Req *product.CreateReviewReq) (r *product.CreateReviewResp, err error) {
+	var _args CreateReviewArgs
+	_args.Req = Req
+	var _result CreateReviewResult
+	if err = p.c.Call(ctx, "CreateReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListReviews(ctx context.Context, Req *product.ListReviewsReq) (r *product.ListReviewsResp, err error) {
+	var _args ListReviewsArgs
+	_args.Req = Req
+	var _result ListReviewsResult
+	if err = p.c.Call(ctx, "ListReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ModerateReview(ctx context.Context, Req *product.ModerateReviewReq) (r *product.ModerateReviewResp, err error) {
+	var _args ModerateReviewArgs
+	_args.Req = Req
+	var _result ModerateReviewResult
+	if err = p.c.Call(ctx, "ModerateReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ImportProducts(ctx context.Context, Req *product.ImportProductsReq) (r *product.ImportProductsResp, err error) {
+	var _args ImportProductsArgs
+	_args.Req = Req
+	var _result ImportProductsResult
+	if err = p.c.Call(ctx, "ImportProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportProducts(ctx context.Context, Req *product.ExportProductsReq) (r *product.ExportProductsResp, err error) {
+	var _args ExportProductsArgs
+	_args.Req = Req
+	var _result ExportProductsResult
+	if err = p.c.Call(ctx, "ExportProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadProductImage(ctx context.Context, Req *product.UploadProductImageReq) (r *product.UploadProductImageResp, err error) {
+	var _args UploadProductImageArgs
+	_args.Req = Req
+	var _result UploadProductImageResult
+	if err = p.c.Call(ctx, "UploadProductImage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProductImage(ctx context.Context, Req *product.UpdateProductImageReq) (r *product.UpdateProductImageResp, err error) {
+	var _args UpdateProductImageArgs
+	_args.Req = Req
+	var _result UpdateProductImageResult
+	if err = p.c.Call(ctx, "UpdateProductImage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteProductImage(ctx context.Context, Req *product.DeleteProductImageReq) (r *product.DeleteProductImageResp, err error) {
+	var _args DeleteProductImageArgs
+	_args.Req = Req
+	var _result DeleteProductImageResult
+	if err = p.c.Call(ctx, "DeleteProductImage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetImage(ctx context.Context, Req *product.GetImageReq) (r *product.GetImageResp, err error) {
+	var _args GetImageArgs
+	_args.Req = Req
+	var _result GetImageResult
+	if err = p.c.Call(ctx, "GetImage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ScheduleProductPrice(ctx context.Context, Req *product.ScheduleProductPriceReq) (r *product.ScheduleProductPriceResp, err error) {
+	var _args ScheduleProductPriceArgs
+	_args.Req = Req
+	var _result ScheduleProductPriceResult
+	if err = p.c.Call(ctx, "ScheduleProductPrice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryReq) (r *product.ListPriceHistoryResp, err error) {
+	var _args ListPriceHistoryArgs
+	_args.Req = Req
+	var _result ListPriceHistoryResult
+	if err = p.c.Call(ctx, "ListPriceHistory", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
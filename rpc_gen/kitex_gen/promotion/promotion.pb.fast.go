@@ -0,0 +1,1851 @@
+// Code generated by Fastpb v0.0.2. DO NOT EDIT.
+
+package promotion
+
+import (
+	fmt "fmt"
+	fastpb "github.com/cloudwego/fastpb"
+)
+
+var (
+	_ = fmt.Errorf
+	_ = fastpb.Skip
+)
+
+func (x *Promotion) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 9:
+		offset, err = x.fastReadField9(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 10:
+		offset, err = x.fastReadField10(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 11:
+		offset, err = x.fastReadField11(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 12:
+		offset, err = x.fastReadField12(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 13:
+		offset, err = x.fastReadField13(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 14:
+		offset, err = x.fastReadField14(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 15:
+		offset, err = x.fastReadField15(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Promotion[number], err)
+}
+
+func (x *Promotion) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Id, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Type, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Value, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	x.BuyQuantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.GetQuantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Categories = append(x.Categories, v)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField9(buf []byte, _type int8) (offset int, err error) {
+	x.MinSpend, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField10(buf []byte, _type int8) (offset int, err error) {
+	x.UsageLimit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField11(buf []byte, _type int8) (offset int, err error) {
+	x.PerUserLimit, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField12(buf []byte, _type int8) (offset int, err error) {
+	x.UsedCount, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField13(buf []byte, _type int8) (offset int, err error) {
+	x.StartsAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField14(buf []byte, _type int8) (offset int, err error) {
+	x.EndsAt, offset, err = fastpb.ReadInt64(buf, _type)
+	return offset, err
+}
+
+func (x *Promotion) fastReadField15(buf []byte, _type int8) (offset int, err error) {
+	x.Active, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *CreatePromotionReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreatePromotionReq[number], err)
+}
+
+func (x *CreatePromotionReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Promotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Promotion = &v
+	return offset, nil
+}
+
+func (x *CreatePromotionResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_CreatePromotionResp[number], err)
+}
+
+func (x *CreatePromotionResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Promotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Promotion = &v
+	return offset, nil
+}
+
+func (x *ListPromotionsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListPromotionsReq[number], err)
+}
+
+func (x *ListPromotionsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ActiveOnly, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *ListPromotionsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ListPromotionsResp[number], err)
+}
+
+func (x *ListPromotionsResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v Promotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Promotions = append(x.Promotions, &v)
+	return offset, nil
+}
+
+func (x *Line) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_Line[number], err)
+}
+
+func (x *Line) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.ProductId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *Line) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.SkuId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *Line) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.Categories = append(x.Categories, v)
+	return offset, err
+}
+
+func (x *Line) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.UnitPrice, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *Line) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.Quantity, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *AppliedPromotion) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_AppliedPromotion[number], err)
+}
+
+func (x *AppliedPromotion) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.PromotionId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *AppliedPromotion) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Name, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AppliedPromotion) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Code, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *AppliedPromotion) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *PreviewDiscountReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PreviewDiscountReq[number], err)
+}
+
+func (x *PreviewDiscountReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *PreviewDiscountReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CouponCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *PreviewDiscountReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Line
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Lines = append(x.Lines, &v)
+	return offset, nil
+}
+
+func (x *PreviewDiscountResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_PreviewDiscountResp[number], err)
+}
+
+func (x *PreviewDiscountResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v AppliedPromotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Promotions = append(x.Promotions, &v)
+	return offset, nil
+}
+
+func (x *PreviewDiscountResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v float32
+			v, offset, err = fastpb.ReadFloat(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.LineDiscounts = append(x.LineDiscounts, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *PreviewDiscountResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *ApplyCouponReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ApplyCouponReq[number], err)
+}
+
+func (x *ApplyCouponReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadUint32(buf, _type)
+	return offset, err
+}
+
+func (x *ApplyCouponReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.CouponCode, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ApplyCouponReq) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	var v Line
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Lines = append(x.Lines, &v)
+	return offset, nil
+}
+
+func (x *ApplyCouponReq) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.RedemptionKey, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ApplyCouponResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ApplyCouponResp[number], err)
+}
+
+func (x *ApplyCouponResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v AppliedPromotion
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Promotions = append(x.Promotions, &v)
+	return offset, nil
+}
+
+func (x *ApplyCouponResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v float32
+			v, offset, err = fastpb.ReadFloat(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.LineDiscounts = append(x.LineDiscounts, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *ApplyCouponResp) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.Discount, offset, err = fastpb.ReadFloat(buf, _type)
+	return offset, err
+}
+
+func (x *ApplyCouponResp) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v uint32
+			v, offset, err = fastpb.ReadUint32(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.RedemptionIds = append(x.RedemptionIds, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *ReleaseRedemptionsReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_ReleaseRedemptionsReq[number], err)
+}
+
+func (x *ReleaseRedemptionsReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	offset, err = fastpb.ReadList(buf, _type,
+		func(buf []byte, _type int8) (n int, err error) {
+			var v uint32
+			v, offset, err = fastpb.ReadUint32(buf, _type)
+			if err != nil {
+				return offset, err
+			}
+			x.RedemptionIds = append(x.RedemptionIds, v)
+			return offset, err
+		})
+	return offset, err
+}
+
+func (x *ReleaseRedemptionsReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.RedemptionKey, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *ReleaseRedemptionsResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+}
+
+func (x *Promotion) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	offset += x.fastWriteField9(buf[offset:])
+	offset += x.fastWriteField10(buf[offset:])
+	offset += x.fastWriteField11(buf[offset:])
+	offset += x.fastWriteField12(buf[offset:])
+	offset += x.fastWriteField13(buf[offset:])
+	offset += x.fastWriteField14(buf[offset:])
+	offset += x.fastWriteField15(buf[offset:])
+	return offset
+}
+
+func (x *Promotion) fastWriteField1(buf []byte) (offset int) {
+	if x.Id == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetId())
+	return offset
+}
+
+func (x *Promotion) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *Promotion) fastWriteField3(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCode())
+	return offset
+}
+
+func (x *Promotion) fastWriteField4(buf []byte) (offset int) {
+	if x.Type == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetType())
+	return offset
+}
+
+func (x *Promotion) fastWriteField5(buf []byte) (offset int) {
+	if x.Value == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 5, x.GetValue())
+	return offset
+}
+
+func (x *Promotion) fastWriteField6(buf []byte) (offset int) {
+	if x.BuyQuantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 6, x.GetBuyQuantity())
+	return offset
+}
+
+func (x *Promotion) fastWriteField7(buf []byte) (offset int) {
+	if x.GetQuantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 7, x.GetGetQuantity())
+	return offset
+}
+
+func (x *Promotion) fastWriteField8(buf []byte) (offset int) {
+	if len(x.Categories) == 0 {
+		return offset
+	}
+	for i := range x.GetCategories() {
+		offset += fastpb.WriteString(buf[offset:], 8, x.GetCategories()[i])
+	}
+	return offset
+}
+
+func (x *Promotion) fastWriteField9(buf []byte) (offset int) {
+	if x.MinSpend == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 9, x.GetMinSpend())
+	return offset
+}
+
+func (x *Promotion) fastWriteField10(buf []byte) (offset int) {
+	if x.UsageLimit == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 10, x.GetUsageLimit())
+	return offset
+}
+
+func (x *Promotion) fastWriteField11(buf []byte) (offset int) {
+	if x.PerUserLimit == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 11, x.GetPerUserLimit())
+	return offset
+}
+
+func (x *Promotion) fastWriteField12(buf []byte) (offset int) {
+	if x.UsedCount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 12, x.GetUsedCount())
+	return offset
+}
+
+func (x *Promotion) fastWriteField13(buf []byte) (offset int) {
+	if x.StartsAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 13, x.GetStartsAt())
+	return offset
+}
+
+func (x *Promotion) fastWriteField14(buf []byte) (offset int) {
+	if x.EndsAt == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt64(buf[offset:], 14, x.GetEndsAt())
+	return offset
+}
+
+func (x *Promotion) fastWriteField15(buf []byte) (offset int) {
+	if !x.Active {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 15, x.GetActive())
+	return offset
+}
+
+func (x *CreatePromotionReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreatePromotionReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Promotion == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPromotion())
+	return offset
+}
+
+func (x *CreatePromotionResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *CreatePromotionResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Promotion == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPromotion())
+	return offset
+}
+
+func (x *ListPromotionsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListPromotionsReq) fastWriteField1(buf []byte) (offset int) {
+	if !x.ActiveOnly {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetActiveOnly())
+	return offset
+}
+
+func (x *ListPromotionsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *ListPromotionsResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Promotions == nil {
+		return offset
+	}
+	for i := range x.GetPromotions() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPromotions()[i])
+	}
+	return offset
+}
+
+func (x *Line) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	return offset
+}
+
+func (x *Line) fastWriteField1(buf []byte) (offset int) {
+	if x.ProductId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetProductId())
+	return offset
+}
+
+func (x *Line) fastWriteField2(buf []byte) (offset int) {
+	if x.SkuId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 2, x.GetSkuId())
+	return offset
+}
+
+func (x *Line) fastWriteField3(buf []byte) (offset int) {
+	if len(x.Categories) == 0 {
+		return offset
+	}
+	for i := range x.GetCategories() {
+		offset += fastpb.WriteString(buf[offset:], 3, x.GetCategories()[i])
+	}
+	return offset
+}
+
+func (x *Line) fastWriteField4(buf []byte) (offset int) {
+	if x.UnitPrice == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetUnitPrice())
+	return offset
+}
+
+func (x *Line) fastWriteField5(buf []byte) (offset int) {
+	if x.Quantity == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 5, x.GetQuantity())
+	return offset
+}
+
+func (x *AppliedPromotion) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *AppliedPromotion) fastWriteField1(buf []byte) (offset int) {
+	if x.PromotionId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetPromotionId())
+	return offset
+}
+
+func (x *AppliedPromotion) fastWriteField2(buf []byte) (offset int) {
+	if x.Name == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetName())
+	return offset
+}
+
+func (x *AppliedPromotion) fastWriteField3(buf []byte) (offset int) {
+	if x.Code == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetCode())
+	return offset
+}
+
+func (x *AppliedPromotion) fastWriteField4(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 4, x.GetDiscount())
+	return offset
+}
+
+func (x *PreviewDiscountReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PreviewDiscountReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *PreviewDiscountReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CouponCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCouponCode())
+	return offset
+}
+
+func (x *PreviewDiscountReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Lines == nil {
+		return offset
+	}
+	for i := range x.GetLines() {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.GetLines()[i])
+	}
+	return offset
+}
+
+func (x *PreviewDiscountResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *PreviewDiscountResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Promotions == nil {
+		return offset
+	}
+	for i := range x.GetPromotions() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPromotions()[i])
+	}
+	return offset
+}
+
+func (x *PreviewDiscountResp) fastWriteField2(buf []byte) (offset int) {
+	if len(x.LineDiscounts) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 2, len(x.GetLineDiscounts()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteFloat(buf[offset:], numTagOrKey, x.GetLineDiscounts()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *PreviewDiscountResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetDiscount())
+	return offset
+}
+
+func (x *ApplyCouponReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ApplyCouponReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteUint32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *ApplyCouponReq) fastWriteField2(buf []byte) (offset int) {
+	if x.CouponCode == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetCouponCode())
+	return offset
+}
+
+func (x *ApplyCouponReq) fastWriteField3(buf []byte) (offset int) {
+	if x.Lines == nil {
+		return offset
+	}
+	for i := range x.GetLines() {
+		offset += fastpb.WriteMessage(buf[offset:], 3, x.GetLines()[i])
+	}
+	return offset
+}
+
+func (x *ApplyCouponReq) fastWriteField4(buf []byte) (offset int) {
+	if x.RedemptionKey == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetRedemptionKey())
+	return offset
+}
+
+func (x *ApplyCouponResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	return offset
+}
+
+func (x *ApplyCouponResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Promotions == nil {
+		return offset
+	}
+	for i := range x.GetPromotions() {
+		offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPromotions()[i])
+	}
+	return offset
+}
+
+func (x *ApplyCouponResp) fastWriteField2(buf []byte) (offset int) {
+	if len(x.LineDiscounts) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 2, len(x.GetLineDiscounts()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteFloat(buf[offset:], numTagOrKey, x.GetLineDiscounts()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *ApplyCouponResp) fastWriteField3(buf []byte) (offset int) {
+	if x.Discount == 0 {
+		return offset
+	}
+	offset += fastpb.WriteFloat(buf[offset:], 3, x.GetDiscount())
+	return offset
+}
+
+func (x *ApplyCouponResp) fastWriteField4(buf []byte) (offset int) {
+	if len(x.RedemptionIds) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 4, len(x.GetRedemptionIds()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteUint32(buf[offset:], numTagOrKey, x.GetRedemptionIds()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *ReleaseRedemptionsReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *ReleaseRedemptionsReq) fastWriteField1(buf []byte) (offset int) {
+	if len(x.RedemptionIds) == 0 {
+		return offset
+	}
+	offset += fastpb.WriteListPacked(buf[offset:], 1, len(x.GetRedemptionIds()),
+		func(buf []byte, numTagOrKey, numIdxOrVal int32) int {
+			offset := 0
+			offset += fastpb.WriteUint32(buf[offset:], numTagOrKey, x.GetRedemptionIds()[numIdxOrVal])
+			return offset
+		})
+	return offset
+}
+
+func (x *ReleaseRedemptionsReq) fastWriteField2(buf []byte) (offset int) {
+	if x.RedemptionKey == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetRedemptionKey())
+	return offset
+}
+
+func (x *ReleaseRedemptionsResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	return offset
+}
+
+func (x *Promotion) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	n += x.sizeField9()
+	n += x.sizeField10()
+	n += x.sizeField11()
+	n += x.sizeField12()
+	n += x.sizeField13()
+	n += x.sizeField14()
+	n += x.sizeField15()
+	return n
+}
+
+func (x *Promotion) sizeField1() (n int) {
+	if x.Id == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetId())
+	return n
+}
+
+func (x *Promotion) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *Promotion) sizeField3() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetCode())
+	return n
+}
+
+func (x *Promotion) sizeField4() (n int) {
+	if x.Type == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetType())
+	return n
+}
+
+func (x *Promotion) sizeField5() (n int) {
+	if x.Value == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(5, x.GetValue())
+	return n
+}
+
+func (x *Promotion) sizeField6() (n int) {
+	if x.BuyQuantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(6, x.GetBuyQuantity())
+	return n
+}
+
+func (x *Promotion) sizeField7() (n int) {
+	if x.GetQuantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(7, x.GetGetQuantity())
+	return n
+}
+
+func (x *Promotion) sizeField8() (n int) {
+	if len(x.Categories) == 0 {
+		return n
+	}
+	for i := range x.GetCategories() {
+		n += fastpb.SizeString(8, x.GetCategories()[i])
+	}
+	return n
+}
+
+func (x *Promotion) sizeField9() (n int) {
+	if x.MinSpend == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(9, x.GetMinSpend())
+	return n
+}
+
+func (x *Promotion) sizeField10() (n int) {
+	if x.UsageLimit == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(10, x.GetUsageLimit())
+	return n
+}
+
+func (x *Promotion) sizeField11() (n int) {
+	if x.PerUserLimit == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(11, x.GetPerUserLimit())
+	return n
+}
+
+func (x *Promotion) sizeField12() (n int) {
+	if x.UsedCount == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(12, x.GetUsedCount())
+	return n
+}
+
+func (x *Promotion) sizeField13() (n int) {
+	if x.StartsAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(13, x.GetStartsAt())
+	return n
+}
+
+func (x *Promotion) sizeField14() (n int) {
+	if x.EndsAt == 0 {
+		return n
+	}
+	n += fastpb.SizeInt64(14, x.GetEndsAt())
+	return n
+}
+
+func (x *Promotion) sizeField15() (n int) {
+	if !x.Active {
+		return n
+	}
+	n += fastpb.SizeBool(15, x.GetActive())
+	return n
+}
+
+func (x *CreatePromotionReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CreatePromotionReq) sizeField1() (n int) {
+	if x.Promotion == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetPromotion())
+	return n
+}
+
+func (x *CreatePromotionResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *CreatePromotionResp) sizeField1() (n int) {
+	if x.Promotion == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetPromotion())
+	return n
+}
+
+func (x *ListPromotionsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListPromotionsReq) sizeField1() (n int) {
+	if !x.ActiveOnly {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetActiveOnly())
+	return n
+}
+
+func (x *ListPromotionsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *ListPromotionsResp) sizeField1() (n int) {
+	if x.Promotions == nil {
+		return n
+	}
+	for i := range x.GetPromotions() {
+		n += fastpb.SizeMessage(1, x.GetPromotions()[i])
+	}
+	return n
+}
+
+func (x *Line) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	return n
+}
+
+func (x *Line) sizeField1() (n int) {
+	if x.ProductId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetProductId())
+	return n
+}
+
+func (x *Line) sizeField2() (n int) {
+	if x.SkuId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(2, x.GetSkuId())
+	return n
+}
+
+func (x *Line) sizeField3() (n int) {
+	if len(x.Categories) == 0 {
+		return n
+	}
+	for i := range x.GetCategories() {
+		n += fastpb.SizeString(3, x.GetCategories()[i])
+	}
+	return n
+}
+
+func (x *Line) sizeField4() (n int) {
+	if x.UnitPrice == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(4, x.GetUnitPrice())
+	return n
+}
+
+func (x *Line) sizeField5() (n int) {
+	if x.Quantity == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(5, x.GetQuantity())
+	return n
+}
+
+func (x *AppliedPromotion) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *AppliedPromotion) sizeField1() (n int) {
+	if x.PromotionId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetPromotionId())
+	return n
+}
+
+func (x *AppliedPromotion) sizeField2() (n int) {
+	if x.Name == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetName())
+	return n
+}
+
+func (x *AppliedPromotion) sizeField3() (n int) {
+	if x.Code == "" {
+		return n
+	}
+	n += fastpb.SizeString(3, x.GetCode())
+	return n
+}
+
+func (x *AppliedPromotion) sizeField4() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(4, x.GetDiscount())
+	return n
+}
+
+func (x *PreviewDiscountReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *PreviewDiscountReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *PreviewDiscountReq) sizeField2() (n int) {
+	if x.CouponCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCouponCode())
+	return n
+}
+
+func (x *PreviewDiscountReq) sizeField3() (n int) {
+	if x.Lines == nil {
+		return n
+	}
+	for i := range x.GetLines() {
+		n += fastpb.SizeMessage(3, x.GetLines()[i])
+	}
+	return n
+}
+
+func (x *PreviewDiscountResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	return n
+}
+
+func (x *PreviewDiscountResp) sizeField1() (n int) {
+	if x.Promotions == nil {
+		return n
+	}
+	for i := range x.GetPromotions() {
+		n += fastpb.SizeMessage(1, x.GetPromotions()[i])
+	}
+	return n
+}
+
+func (x *PreviewDiscountResp) sizeField2() (n int) {
+	if len(x.LineDiscounts) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(2, len(x.GetLineDiscounts()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeFloat(numTagOrKey, x.GetLineDiscounts()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *PreviewDiscountResp) sizeField3() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetDiscount())
+	return n
+}
+
+func (x *ApplyCouponReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *ApplyCouponReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeUint32(1, x.GetUserId())
+	return n
+}
+
+func (x *ApplyCouponReq) sizeField2() (n int) {
+	if x.CouponCode == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetCouponCode())
+	return n
+}
+
+func (x *ApplyCouponReq) sizeField3() (n int) {
+	if x.Lines == nil {
+		return n
+	}
+	for i := range x.GetLines() {
+		n += fastpb.SizeMessage(3, x.GetLines()[i])
+	}
+	return n
+}
+
+func (x *ApplyCouponReq) sizeField4() (n int) {
+	if x.RedemptionKey == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetRedemptionKey())
+	return n
+}
+
+func (x *ApplyCouponResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	return n
+}
+
+func (x *ApplyCouponResp) sizeField1() (n int) {
+	if x.Promotions == nil {
+		return n
+	}
+	for i := range x.GetPromotions() {
+		n += fastpb.SizeMessage(1, x.GetPromotions()[i])
+	}
+	return n
+}
+
+func (x *ApplyCouponResp) sizeField2() (n int) {
+	if len(x.LineDiscounts) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(2, len(x.GetLineDiscounts()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeFloat(numTagOrKey, x.GetLineDiscounts()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *ApplyCouponResp) sizeField3() (n int) {
+	if x.Discount == 0 {
+		return n
+	}
+	n += fastpb.SizeFloat(3, x.GetDiscount())
+	return n
+}
+
+func (x *ApplyCouponResp) sizeField4() (n int) {
+	if len(x.RedemptionIds) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(4, len(x.GetRedemptionIds()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeUint32(numTagOrKey, x.GetRedemptionIds()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *ReleaseRedemptionsReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *ReleaseRedemptionsReq) sizeField1() (n int) {
+	if len(x.RedemptionIds) == 0 {
+		return n
+	}
+	n += fastpb.SizeListPacked(1, len(x.GetRedemptionIds()),
+		func(numTagOrKey, numIdxOrVal int32) int {
+			n := 0
+			n += fastpb.SizeUint32(numTagOrKey, x.GetRedemptionIds()[numIdxOrVal])
+			return n
+		})
+	return n
+}
+
+func (x *ReleaseRedemptionsReq) sizeField2() (n int) {
+	if x.RedemptionKey == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetRedemptionKey())
+	return n
+}
+
+func (x *ReleaseRedemptionsResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	return n
+}
+
+var fieldIDToName_Promotion = map[int32]string{
+	1:  "Id",
+	2:  "Name",
+	3:  "Code",
+	4:  "Type",
+	5:  "Value",
+	6:  "BuyQuantity",
+	7:  "GetQuantity",
+	8:  "Categories",
+	9:  "MinSpend",
+	10: "UsageLimit",
+	11: "PerUserLimit",
+	12: "UsedCount",
+	13: "StartsAt",
+	14: "EndsAt",
+	15: "Active",
+}
+
+var fieldIDToName_CreatePromotionReq = map[int32]string{
+	1: "Promotion",
+}
+
+var fieldIDToName_CreatePromotionResp = map[int32]string{
+	1: "Promotion",
+}
+
+var fieldIDToName_ListPromotionsReq = map[int32]string{
+	1: "ActiveOnly",
+}
+
+var fieldIDToName_ListPromotionsResp = map[int32]string{
+	1: "Promotions",
+}
+
+var fieldIDToName_Line = map[int32]string{
+	1: "ProductId",
+	2: "SkuId",
+	3: "Categories",
+	4: "UnitPrice",
+	5: "Quantity",
+}
+
+var fieldIDToName_AppliedPromotion = map[int32]string{
+	1: "PromotionId",
+	2: "Name",
+	3: "Code",
+	4: "Discount",
+}
+
+var fieldIDToName_PreviewDiscountReq = map[int32]string{
+	1: "UserId",
+	2: "CouponCode",
+	3: "Lines",
+}
+
+var fieldIDToName_PreviewDiscountResp = map[int32]string{
+	1: "Promotions",
+	2: "LineDiscounts",
+	3: "Discount",
+}
+
+var fieldIDToName_ApplyCouponReq = map[int32]string{
+	1: "UserId",
+	2: "CouponCode",
+	3: "Lines",
+	4: "RedemptionKey",
+}
+
+var fieldIDToName_ApplyCouponResp = map[int32]string{
+	1: "Promotions",
+	2: "LineDiscounts",
+	3: "Discount",
+	4: "RedemptionIds",
+}
+
+var fieldIDToName_ReleaseRedemptionsReq = map[int32]string{
+	1: "RedemptionIds",
+	2: "RedemptionKey",
+}
+
+var fieldIDToName_ReleaseRedemptionsResp = map[int32]string{}
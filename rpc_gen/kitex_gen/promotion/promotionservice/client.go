@@ -0,0 +1,73 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package promotionservice
+
+import (
+	"context"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	CreatePromotion(ctx context.Context, Req *promotion.CreatePromotionReq, callOptions ...callopt.Option) (r *promotion.CreatePromotionResp, err error)
+	ListPromotions(ctx context.Context, Req *promotion.ListPromotionsReq, callOptions ...callopt.Option) (r *promotion.ListPromotionsResp, err error)
+	PreviewDiscount(ctx context.Context, Req *promotion.PreviewDiscountReq, callOptions ...callopt.Option) (r *promotion.PreviewDiscountResp, err error)
+	ApplyCoupon(ctx context.Context, Req *promotion.ApplyCouponReq, callOptions ...callopt.Option) (r *promotion.ApplyCouponResp, err error)
+	ReleaseRedemptions(ctx context.Context, Req *promotion.ReleaseRedemptionsReq, callOptions ...callopt.Option) (r *promotion.ReleaseRedemptionsResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kPromotionServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kPromotionServiceClient struct {
+	*kClient
+}
+
+func (p *kPromotionServiceClient) CreatePromotion(ctx context.Context, Req *promotion.CreatePromotionReq, callOptions ...callopt.Option) (r *promotion.CreatePromotionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreatePromotion(ctx, Req)
+}
+
+func (p *kPromotionServiceClient) ListPromotions(ctx context.Context, Req *promotion.ListPromotionsReq, callOptions ...callopt.Option) (r *promotion.ListPromotionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPromotions(ctx, Req)
+}
+
+func (p *kPromotionServiceClient) PreviewDiscount(ctx context.Context, Req *promotion.PreviewDiscountReq, callOptions ...callopt.Option) (r *promotion.PreviewDiscountResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PreviewDiscount(ctx, Req)
+}
+
+func (p *kPromotionServiceClient) ApplyCoupon(ctx context.Context, Req *promotion.ApplyCouponReq, callOptions ...callopt.Option) (r *promotion.ApplyCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ApplyCoupon(ctx, Req)
+}
+
+func (p *kPromotionServiceClient) ReleaseRedemptions(ctx context.Context, Req *promotion.ReleaseRedemptionsReq, callOptions ...callopt.Option) (r *promotion.ReleaseRedemptionsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReleaseRedemptions(ctx, Req)
+}
@@ -0,0 +1,868 @@
+// Code generated by Kitex v0.8.0. DO NOT EDIT.
+
+package promotionservice
+
+import (
+	"context"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "google.golang.org/protobuf/proto"
+)
+
+func serviceInfo() *kitex.ServiceInfo {
+	return promotionServiceServiceInfo
+}
+
+var promotionServiceServiceInfo = NewServiceInfo()
+
+func NewServiceInfo() *kitex.ServiceInfo {
+	serviceName := "PromotionService"
+	handlerType := (*promotion.PromotionService)(nil)
+	methods := map[string]kitex.MethodInfo{
+		"CreatePromotion":    kitex.NewMethodInfo(createPromotionHandler, newCreatePromotionArgs, newCreatePromotionResult, false),
+		"ListPromotions":     kitex.NewMethodInfo(listPromotionsHandler, newListPromotionsArgs, newListPromotionsResult, false),
+		"PreviewDiscount":    kitex.NewMethodInfo(previewDiscountHandler, newPreviewDiscountArgs, newPreviewDiscountResult, false),
+		"ApplyCoupon":        kitex.NewMethodInfo(applyCouponHandler, newApplyCouponArgs, newApplyCouponResult, false),
+		"ReleaseRedemptions": kitex.NewMethodInfo(releaseRedemptionsHandler, newReleaseRedemptionsArgs, newReleaseRedemptionsResult, false),
+	}
+	extra := map[string]interface{}{
+		"PackageName":     "promotion",
+		"ServiceFilePath": ``,
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.8.0",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func createPromotionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(promotion.CreatePromotionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(promotion.PromotionService).CreatePromotion(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *CreatePromotionArgs:
+		success, err := handler.(promotion.PromotionService).CreatePromotion(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreatePromotionResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newCreatePromotionArgs() interface{} {
+	return &CreatePromotionArgs{}
+}
+
+func newCreatePromotionResult() interface{} {
+	return &CreatePromotionResult{}
+}
+
+type CreatePromotionArgs struct {
+	Req *promotion.CreatePromotionReq
+}
+
+func (p *CreatePromotionArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(promotion.CreatePromotionReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *CreatePromotionArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *CreatePromotionArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *CreatePromotionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreatePromotionArgs) Unmarshal(in []byte) error {
+	msg := new(promotion.CreatePromotionReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreatePromotionArgs_Req_DEFAULT *promotion.CreatePromotionReq
+
+func (p *CreatePromotionArgs) GetReq() *promotion.CreatePromotionReq {
+	if !p.IsSetReq() {
+		return CreatePromotionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreatePromotionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreatePromotionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreatePromotionResult struct {
+	Success *promotion.CreatePromotionResp
+}
+
+var CreatePromotionResult_Success_DEFAULT *promotion.CreatePromotionResp
+
+func (p *CreatePromotionResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(promotion.CreatePromotionResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *CreatePromotionResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *CreatePromotionResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *CreatePromotionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreatePromotionResult) Unmarshal(in []byte) error {
+	msg := new(promotion.CreatePromotionResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreatePromotionResult) GetSuccess() *promotion.CreatePromotionResp {
+	if !p.IsSetSuccess() {
+		return CreatePromotionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreatePromotionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*promotion.CreatePromotionResp)
+}
+
+func (p *CreatePromotionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreatePromotionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listPromotionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(promotion.ListPromotionsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(promotion.PromotionService).ListPromotions(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ListPromotionsArgs:
+		success, err := handler.(promotion.PromotionService).ListPromotions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListPromotionsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newListPromotionsArgs() interface{} {
+	return &ListPromotionsArgs{}
+}
+
+func newListPromotionsResult() interface{} {
+	return &ListPromotionsResult{}
+}
+
+type ListPromotionsArgs struct {
+	Req *promotion.ListPromotionsReq
+}
+
+func (p *ListPromotionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(promotion.ListPromotionsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ListPromotionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ListPromotionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ListPromotionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListPromotionsArgs) Unmarshal(in []byte) error {
+	msg := new(promotion.ListPromotionsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListPromotionsArgs_Req_DEFAULT *promotion.ListPromotionsReq
+
+func (p *ListPromotionsArgs) GetReq() *promotion.ListPromotionsReq {
+	if !p.IsSetReq() {
+		return ListPromotionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListPromotionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListPromotionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListPromotionsResult struct {
+	Success *promotion.ListPromotionsResp
+}
+
+var ListPromotionsResult_Success_DEFAULT *promotion.ListPromotionsResp
+
+func (p *ListPromotionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(promotion.ListPromotionsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ListPromotionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ListPromotionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ListPromotionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListPromotionsResult) Unmarshal(in []byte) error {
+	msg := new(promotion.ListPromotionsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListPromotionsResult) GetSuccess() *promotion.ListPromotionsResp {
+	if !p.IsSetSuccess() {
+		return ListPromotionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListPromotionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*promotion.ListPromotionsResp)
+}
+
+func (p *ListPromotionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListPromotionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func previewDiscountHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(promotion.PreviewDiscountReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(promotion.PromotionService).PreviewDiscount(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *PreviewDiscountArgs:
+		success, err := handler.(promotion.PromotionService).PreviewDiscount(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*PreviewDiscountResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newPreviewDiscountArgs() interface{} {
+	return &PreviewDiscountArgs{}
+}
+
+func newPreviewDiscountResult() interface{} {
+	return &PreviewDiscountResult{}
+}
+
+type PreviewDiscountArgs struct {
+	Req *promotion.PreviewDiscountReq
+}
+
+func (p *PreviewDiscountArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(promotion.PreviewDiscountReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *PreviewDiscountArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *PreviewDiscountArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *PreviewDiscountArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *PreviewDiscountArgs) Unmarshal(in []byte) error {
+	msg := new(promotion.PreviewDiscountReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var PreviewDiscountArgs_Req_DEFAULT *promotion.PreviewDiscountReq
+
+func (p *PreviewDiscountArgs) GetReq() *promotion.PreviewDiscountReq {
+	if !p.IsSetReq() {
+		return PreviewDiscountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *PreviewDiscountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PreviewDiscountArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type PreviewDiscountResult struct {
+	Success *promotion.PreviewDiscountResp
+}
+
+var PreviewDiscountResult_Success_DEFAULT *promotion.PreviewDiscountResp
+
+func (p *PreviewDiscountResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(promotion.PreviewDiscountResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *PreviewDiscountResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *PreviewDiscountResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *PreviewDiscountResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *PreviewDiscountResult) Unmarshal(in []byte) error {
+	msg := new(promotion.PreviewDiscountResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *PreviewDiscountResult) GetSuccess() *promotion.PreviewDiscountResp {
+	if !p.IsSetSuccess() {
+		return PreviewDiscountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *PreviewDiscountResult) SetSuccess(x interface{}) {
+	p.Success = x.(*promotion.PreviewDiscountResp)
+}
+
+func (p *PreviewDiscountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PreviewDiscountResult) GetResult() interface{} {
+	return p.Success
+}
+
+func applyCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(promotion.ApplyCouponReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(promotion.PromotionService).ApplyCoupon(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ApplyCouponArgs:
+		success, err := handler.(promotion.PromotionService).ApplyCoupon(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ApplyCouponResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newApplyCouponArgs() interface{} {
+	return &ApplyCouponArgs{}
+}
+
+func newApplyCouponResult() interface{} {
+	return &ApplyCouponResult{}
+}
+
+type ApplyCouponArgs struct {
+	Req *promotion.ApplyCouponReq
+}
+
+func (p *ApplyCouponArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(promotion.ApplyCouponReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ApplyCouponArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ApplyCouponArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ApplyCouponArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ApplyCouponArgs) Unmarshal(in []byte) error {
+	msg := new(promotion.ApplyCouponReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ApplyCouponArgs_Req_DEFAULT *promotion.ApplyCouponReq
+
+func (p *ApplyCouponArgs) GetReq() *promotion.ApplyCouponReq {
+	if !p.IsSetReq() {
+		return ApplyCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ApplyCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApplyCouponArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ApplyCouponResult struct {
+	Success *promotion.ApplyCouponResp
+}
+
+var ApplyCouponResult_Success_DEFAULT *promotion.ApplyCouponResp
+
+func (p *ApplyCouponResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(promotion.ApplyCouponResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ApplyCouponResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ApplyCouponResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ApplyCouponResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ApplyCouponResult) Unmarshal(in []byte) error {
+	msg := new(promotion.ApplyCouponResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ApplyCouponResult) GetSuccess() *promotion.ApplyCouponResp {
+	if !p.IsSetSuccess() {
+		return ApplyCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ApplyCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*promotion.ApplyCouponResp)
+}
+
+func (p *ApplyCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApplyCouponResult) GetResult() interface{} {
+	return p.Success
+}
+
+func releaseRedemptionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(promotion.ReleaseRedemptionsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(promotion.PromotionService).ReleaseRedemptions(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *ReleaseRedemptionsArgs:
+		success, err := handler.(promotion.PromotionService).ReleaseRedemptions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReleaseRedemptionsResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newReleaseRedemptionsArgs() interface{} {
+	return &ReleaseRedemptionsArgs{}
+}
+
+func newReleaseRedemptionsResult() interface{} {
+	return &ReleaseRedemptionsResult{}
+}
+
+type ReleaseRedemptionsArgs struct {
+	Req *promotion.ReleaseRedemptionsReq
+}
+
+func (p *ReleaseRedemptionsArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(promotion.ReleaseRedemptionsReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *ReleaseRedemptionsArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *ReleaseRedemptionsArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *ReleaseRedemptionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReleaseRedemptionsArgs) Unmarshal(in []byte) error {
+	msg := new(promotion.ReleaseRedemptionsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReleaseRedemptionsArgs_Req_DEFAULT *promotion.ReleaseRedemptionsReq
+
+func (p *ReleaseRedemptionsArgs) GetReq() *promotion.ReleaseRedemptionsReq {
+	if !p.IsSetReq() {
+		return ReleaseRedemptionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReleaseRedemptionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReleaseRedemptionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReleaseRedemptionsResult struct {
+	Success *promotion.ReleaseRedemptionsResp
+}
+
+var ReleaseRedemptionsResult_Success_DEFAULT *promotion.ReleaseRedemptionsResp
+
+func (p *ReleaseRedemptionsResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(promotion.ReleaseRedemptionsResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *ReleaseRedemptionsResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *ReleaseRedemptionsResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *ReleaseRedemptionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReleaseRedemptionsResult) Unmarshal(in []byte) error {
+	msg := new(promotion.ReleaseRedemptionsResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReleaseRedemptionsResult) GetSuccess() *promotion.ReleaseRedemptionsResp {
+	if !p.IsSetSuccess() {
+		return ReleaseRedemptionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReleaseRedemptionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*promotion.ReleaseRedemptionsResp)
+}
+
+func (p *ReleaseRedemptionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReleaseRedemptionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreatePromotion(ctx context.Context, Req *promotion.CreatePromotionReq) (r *promotion.CreatePromotionResp, err error) {
+	var _args CreatePromotionArgs
+	_args.Req = Req
+	var _result CreatePromotionResult
+	if err = p.c.Call(ctx, "CreatePromotion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPromotions(ctx context.Context, Req *promotion.ListPromotionsReq) (r *promotion.ListPromotionsResp, err error) {
+	var _args ListPromotionsArgs
+	_args.Req = Req
+	var _result ListPromotionsResult
+	if err = p.c.Call(ctx, "ListPromotions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PreviewDiscount(ctx context.Context, Req *promotion.PreviewDiscountReq) (r *promotion.PreviewDiscountResp, err error) {
+	var _args PreviewDiscountArgs
+	_args.Req = Req
+	var _result PreviewDiscountResult
+	if err = p.c.Call(ctx, "PreviewDiscount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ApplyCoupon(ctx context.Context, Req *promotion.ApplyCouponReq) (r *promotion.ApplyCouponResp, err error) {
+	var _args ApplyCouponArgs
+	_args.Req = Req
+	var _result ApplyCouponResult
+	if err = p.c.Call(ctx, "ApplyCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReleaseRedemptions(ctx context.Context, Req *promotion.ReleaseRedemptionsReq) (r *promotion.ReleaseRedemptionsResp, err error) {
+	var _args ReleaseRedemptionsArgs
+	_args.Req = Req
+	var _result ReleaseRedemptionsResult
+	if err = p.c.Call(ctx, "ReleaseRedemptions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -12,6 +12,66 @@ var (
 	_ = fastpb.Skip
 )
 
+func (x *DeleteReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteReq[number], err)
+}
+
+func (x *DeleteReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Token, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *DeleteResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_DeleteResp[number], err)
+}
+
+func (x *DeleteResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Success, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
 func (x *RegisterReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
 	switch number {
 	case 1:
@@ -100,83 +160,497 @@ func (x *LoginReq) FastRead(buf []byte, _type int8, number int32) (offset int, e
 			goto SkipFieldError
 		}
 	}
-	return offset, nil
-SkipFieldError:
-	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
-ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LoginReq[number], err)
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LoginReq[number], err)
+}
+
+func (x *LoginReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LoginReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.Password, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *LoginResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LoginResp[number], err)
+}
+
+func (x *LoginResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *NotificationPreferences) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 3:
+		offset, err = x.fastReadField3(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 4:
+		offset, err = x.fastReadField4(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 5:
+		offset, err = x.fastReadField5(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 6:
+		offset, err = x.fastReadField6(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 7:
+		offset, err = x.fastReadField7(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 8:
+		offset, err = x.fastReadField8(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_NotificationPreferences[number], err)
+}
+
+func (x *NotificationPreferences) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.EmailEnabled, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *NotificationPreferences) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	x.SmsEnabled, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *NotificationPreferences) fastReadField3(buf []byte, _type int8) (offset int, err error) {
+	x.WebhookEnabled, offset, err = fastpb.ReadBool(buf, _type)
+	return offset, err
+}
+
+func (x *NotificationPreferences) fastReadField4(buf []byte, _type int8) (offset int, err error) {
+	x.Phone, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *NotificationPreferences) fastReadField5(buf []byte, _type int8) (offset int, err error) {
+	x.WebhookUrl, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *NotificationPreferences) fastReadField6(buf []byte, _type int8) (offset int, err error) {
+	var v string
+	v, offset, err = fastpb.ReadString(buf, _type)
+	if err != nil {
+		return offset, err
+	}
+	x.OptOuts = append(x.OptOuts, v)
+	return offset, err
+}
+
+func (x *NotificationPreferences) fastReadField7(buf []byte, _type int8) (offset int, err error) {
+	x.Locale, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *NotificationPreferences) fastReadField8(buf []byte, _type int8) (offset int, err error) {
+	x.Currency, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetNotificationPreferencesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetNotificationPreferencesReq[number], err)
+}
+
+func (x *GetNotificationPreferencesReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *GetNotificationPreferencesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_GetNotificationPreferencesResp[number], err)
+}
+
+func (x *GetNotificationPreferencesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.Email, offset, err = fastpb.ReadString(buf, _type)
+	return offset, err
+}
+
+func (x *GetNotificationPreferencesResp) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v NotificationPreferences
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Preferences = &v
+	return offset, nil
+}
+
+func (x *UpdateNotificationPreferencesReq) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	case 2:
+		offset, err = x.fastReadField2(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateNotificationPreferencesReq[number], err)
+}
+
+func (x *UpdateNotificationPreferencesReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
+	return offset, err
+}
+
+func (x *UpdateNotificationPreferencesReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
+	var v NotificationPreferences
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Preferences = &v
+	return offset, nil
+}
+
+func (x *UpdateNotificationPreferencesResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
+	switch number {
+	case 1:
+		offset, err = x.fastReadField1(buf, _type)
+		if err != nil {
+			goto ReadFieldError
+		}
+	default:
+		offset, err = fastpb.Skip(buf, _type, number)
+		if err != nil {
+			goto SkipFieldError
+		}
+	}
+	return offset, nil
+SkipFieldError:
+	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
+ReadFieldError:
+	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_UpdateNotificationPreferencesResp[number], err)
+}
+
+func (x *UpdateNotificationPreferencesResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
+	var v NotificationPreferences
+	offset, err = fastpb.ReadMessage(buf, _type, &v)
+	if err != nil {
+		return offset, err
+	}
+	x.Preferences = &v
+	return offset, nil
+}
+
+func (x *DeleteReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *DeleteReq) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *DeleteReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Token == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetToken())
+	return offset
+}
+
+func (x *DeleteResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *DeleteResp) fastWriteField1(buf []byte) (offset int) {
+	if !x.Success {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetSuccess())
+	return offset
+}
+
+func (x *RegisterReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *RegisterReq) fastWriteField3(buf []byte) (offset int) {
+	if x.ConfirmPassword == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 3, x.GetConfirmPassword())
+	return offset
+}
+
+func (x *RegisterResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *RegisterResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *LoginReq) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	return offset
+}
+
+func (x *LoginReq) fastWriteField1(buf []byte) (offset int) {
+	if x.Email == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	return offset
+}
+
+func (x *LoginReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Password == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	return offset
+}
+
+func (x *LoginResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *LoginResp) fastWriteField1(buf []byte) (offset int) {
+	if x.UserId == 0 {
+		return offset
+	}
+	offset += fastpb.WriteInt32(buf[offset:], 1, x.GetUserId())
+	return offset
+}
+
+func (x *NotificationPreferences) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
+	offset += x.fastWriteField3(buf[offset:])
+	offset += x.fastWriteField4(buf[offset:])
+	offset += x.fastWriteField5(buf[offset:])
+	offset += x.fastWriteField6(buf[offset:])
+	offset += x.fastWriteField7(buf[offset:])
+	offset += x.fastWriteField8(buf[offset:])
+	return offset
 }
 
-func (x *LoginReq) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.Email, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *NotificationPreferences) fastWriteField1(buf []byte) (offset int) {
+	if !x.EmailEnabled {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 1, x.GetEmailEnabled())
+	return offset
 }
 
-func (x *LoginReq) fastReadField2(buf []byte, _type int8) (offset int, err error) {
-	x.Password, offset, err = fastpb.ReadString(buf, _type)
-	return offset, err
+func (x *NotificationPreferences) fastWriteField2(buf []byte) (offset int) {
+	if !x.SmsEnabled {
+		return offset
+	}
+	offset += fastpb.WriteBool(buf[offset:], 2, x.GetSmsEnabled())
+	return offset
 }
 
-func (x *LoginResp) FastRead(buf []byte, _type int8, number int32) (offset int, err error) {
-	switch number {
-	case 1:
-		offset, err = x.fastReadField1(buf, _type)
-		if err != nil {
-			goto ReadFieldError
-		}
-	default:
-		offset, err = fastpb.Skip(buf, _type, number)
-		if err != nil {
-			goto SkipFieldError
-		}
+func (x *NotificationPreferences) fastWriteField3(buf []byte) (offset int) {
+	if !x.WebhookEnabled {
+		return offset
 	}
-	return offset, nil
-SkipFieldError:
-	return offset, fmt.Errorf("%T cannot parse invalid wire-format data, error: %s", x, err)
-ReadFieldError:
-	return offset, fmt.Errorf("%T read field %d '%s' error: %s", x, number, fieldIDToName_LoginResp[number], err)
+	offset += fastpb.WriteBool(buf[offset:], 3, x.GetWebhookEnabled())
+	return offset
 }
 
-func (x *LoginResp) fastReadField1(buf []byte, _type int8) (offset int, err error) {
-	x.UserId, offset, err = fastpb.ReadInt32(buf, _type)
-	return offset, err
+func (x *NotificationPreferences) fastWriteField4(buf []byte) (offset int) {
+	if x.Phone == "" {
+		return offset
+	}
+	offset += fastpb.WriteString(buf[offset:], 4, x.GetPhone())
+	return offset
 }
 
-func (x *RegisterReq) FastWrite(buf []byte) (offset int) {
-	if x == nil {
+func (x *NotificationPreferences) fastWriteField5(buf []byte) (offset int) {
+	if x.WebhookUrl == "" {
 		return offset
 	}
-	offset += x.fastWriteField1(buf[offset:])
-	offset += x.fastWriteField2(buf[offset:])
-	offset += x.fastWriteField3(buf[offset:])
+	offset += fastpb.WriteString(buf[offset:], 5, x.GetWebhookUrl())
 	return offset
 }
 
-func (x *RegisterReq) fastWriteField1(buf []byte) (offset int) {
-	if x.Email == "" {
+func (x *NotificationPreferences) fastWriteField6(buf []byte) (offset int) {
+	if len(x.OptOuts) == 0 {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 1, x.GetEmail())
+	for i := range x.GetOptOuts() {
+		offset += fastpb.WriteString(buf[offset:], 6, x.GetOptOuts()[i])
+	}
 	return offset
 }
 
-func (x *RegisterReq) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
+func (x *NotificationPreferences) fastWriteField7(buf []byte) (offset int) {
+	if x.Locale == "" {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	offset += fastpb.WriteString(buf[offset:], 7, x.GetLocale())
 	return offset
 }
 
-func (x *RegisterReq) fastWriteField3(buf []byte) (offset int) {
-	if x.ConfirmPassword == "" {
+func (x *NotificationPreferences) fastWriteField8(buf []byte) (offset int) {
+	if x.Currency == "" {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 3, x.GetConfirmPassword())
+	offset += fastpb.WriteString(buf[offset:], 8, x.GetCurrency())
 	return offset
 }
 
-func (x *RegisterResp) FastWrite(buf []byte) (offset int) {
+func (x *GetNotificationPreferencesReq) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
@@ -184,7 +658,7 @@ func (x *RegisterResp) FastWrite(buf []byte) (offset int) {
 	return offset
 }
 
-func (x *RegisterResp) fastWriteField1(buf []byte) (offset int) {
+func (x *GetNotificationPreferencesReq) fastWriteField1(buf []byte) (offset int) {
 	if x.UserId == 0 {
 		return offset
 	}
@@ -192,7 +666,7 @@ func (x *RegisterResp) fastWriteField1(buf []byte) (offset int) {
 	return offset
 }
 
-func (x *LoginReq) FastWrite(buf []byte) (offset int) {
+func (x *GetNotificationPreferencesResp) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
@@ -201,7 +675,7 @@ func (x *LoginReq) FastWrite(buf []byte) (offset int) {
 	return offset
 }
 
-func (x *LoginReq) fastWriteField1(buf []byte) (offset int) {
+func (x *GetNotificationPreferencesResp) fastWriteField1(buf []byte) (offset int) {
 	if x.Email == "" {
 		return offset
 	}
@@ -209,23 +683,24 @@ func (x *LoginReq) fastWriteField1(buf []byte) (offset int) {
 	return offset
 }
 
-func (x *LoginReq) fastWriteField2(buf []byte) (offset int) {
-	if x.Password == "" {
+func (x *GetNotificationPreferencesResp) fastWriteField2(buf []byte) (offset int) {
+	if x.Preferences == nil {
 		return offset
 	}
-	offset += fastpb.WriteString(buf[offset:], 2, x.GetPassword())
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetPreferences())
 	return offset
 }
 
-func (x *LoginResp) FastWrite(buf []byte) (offset int) {
+func (x *UpdateNotificationPreferencesReq) FastWrite(buf []byte) (offset int) {
 	if x == nil {
 		return offset
 	}
 	offset += x.fastWriteField1(buf[offset:])
+	offset += x.fastWriteField2(buf[offset:])
 	return offset
 }
 
-func (x *LoginResp) fastWriteField1(buf []byte) (offset int) {
+func (x *UpdateNotificationPreferencesReq) fastWriteField1(buf []byte) (offset int) {
 	if x.UserId == 0 {
 		return offset
 	}
@@ -233,6 +708,71 @@ func (x *LoginResp) fastWriteField1(buf []byte) (offset int) {
 	return offset
 }
 
+func (x *UpdateNotificationPreferencesReq) fastWriteField2(buf []byte) (offset int) {
+	if x.Preferences == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 2, x.GetPreferences())
+	return offset
+}
+
+func (x *UpdateNotificationPreferencesResp) FastWrite(buf []byte) (offset int) {
+	if x == nil {
+		return offset
+	}
+	offset += x.fastWriteField1(buf[offset:])
+	return offset
+}
+
+func (x *UpdateNotificationPreferencesResp) fastWriteField1(buf []byte) (offset int) {
+	if x.Preferences == nil {
+		return offset
+	}
+	offset += fastpb.WriteMessage(buf[offset:], 1, x.GetPreferences())
+	return offset
+}
+
+func (x *DeleteReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *DeleteReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetUserId())
+	return n
+}
+
+func (x *DeleteReq) sizeField2() (n int) {
+	if x.Token == "" {
+		return n
+	}
+	n += fastpb.SizeString(2, x.GetToken())
+	return n
+}
+
+func (x *DeleteResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *DeleteResp) sizeField1() (n int) {
+	if !x.Success {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetSuccess())
+	return n
+}
+
 func (x *RegisterReq) Size() (n int) {
 	if x == nil {
 		return n
@@ -324,6 +864,178 @@ func (x *LoginResp) sizeField1() (n int) {
 	return n
 }
 
+func (x *NotificationPreferences) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	n += x.sizeField3()
+	n += x.sizeField4()
+	n += x.sizeField5()
+	n += x.sizeField6()
+	n += x.sizeField7()
+	n += x.sizeField8()
+	return n
+}
+
+func (x *NotificationPreferences) sizeField1() (n int) {
+	if !x.EmailEnabled {
+		return n
+	}
+	n += fastpb.SizeBool(1, x.GetEmailEnabled())
+	return n
+}
+
+func (x *NotificationPreferences) sizeField2() (n int) {
+	if !x.SmsEnabled {
+		return n
+	}
+	n += fastpb.SizeBool(2, x.GetSmsEnabled())
+	return n
+}
+
+func (x *NotificationPreferences) sizeField3() (n int) {
+	if !x.WebhookEnabled {
+		return n
+	}
+	n += fastpb.SizeBool(3, x.GetWebhookEnabled())
+	return n
+}
+
+func (x *NotificationPreferences) sizeField4() (n int) {
+	if x.Phone == "" {
+		return n
+	}
+	n += fastpb.SizeString(4, x.GetPhone())
+	return n
+}
+
+func (x *NotificationPreferences) sizeField5() (n int) {
+	if x.WebhookUrl == "" {
+		return n
+	}
+	n += fastpb.SizeString(5, x.GetWebhookUrl())
+	return n
+}
+
+func (x *NotificationPreferences) sizeField6() (n int) {
+	if len(x.OptOuts) == 0 {
+		return n
+	}
+	for i := range x.GetOptOuts() {
+		n += fastpb.SizeString(6, x.GetOptOuts()[i])
+	}
+	return n
+}
+
+func (x *NotificationPreferences) sizeField7() (n int) {
+	if x.Locale == "" {
+		return n
+	}
+	n += fastpb.SizeString(7, x.GetLocale())
+	return n
+}
+
+func (x *NotificationPreferences) sizeField8() (n int) {
+	if x.Currency == "" {
+		return n
+	}
+	n += fastpb.SizeString(8, x.GetCurrency())
+	return n
+}
+
+func (x *GetNotificationPreferencesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *GetNotificationPreferencesReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetUserId())
+	return n
+}
+
+func (x *GetNotificationPreferencesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *GetNotificationPreferencesResp) sizeField1() (n int) {
+	if x.Email == "" {
+		return n
+	}
+	n += fastpb.SizeString(1, x.GetEmail())
+	return n
+}
+
+func (x *GetNotificationPreferencesResp) sizeField2() (n int) {
+	if x.Preferences == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetPreferences())
+	return n
+}
+
+func (x *UpdateNotificationPreferencesReq) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	n += x.sizeField2()
+	return n
+}
+
+func (x *UpdateNotificationPreferencesReq) sizeField1() (n int) {
+	if x.UserId == 0 {
+		return n
+	}
+	n += fastpb.SizeInt32(1, x.GetUserId())
+	return n
+}
+
+func (x *UpdateNotificationPreferencesReq) sizeField2() (n int) {
+	if x.Preferences == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(2, x.GetPreferences())
+	return n
+}
+
+func (x *UpdateNotificationPreferencesResp) Size() (n int) {
+	if x == nil {
+		return n
+	}
+	n += x.sizeField1()
+	return n
+}
+
+func (x *UpdateNotificationPreferencesResp) sizeField1() (n int) {
+	if x.Preferences == nil {
+		return n
+	}
+	n += fastpb.SizeMessage(1, x.GetPreferences())
+	return n
+}
+
+var fieldIDToName_DeleteReq = map[int32]string{
+	1: "UserId",
+	2: "Token",
+}
+
+var fieldIDToName_DeleteResp = map[int32]string{
+	1: "Success",
+}
+
 var fieldIDToName_RegisterReq = map[int32]string{
 	1: "Email",
 	2: "Password",
@@ -342,3 +1054,32 @@ var fieldIDToName_LoginReq = map[int32]string{
 var fieldIDToName_LoginResp = map[int32]string{
 	1: "UserId",
 }
+
+var fieldIDToName_NotificationPreferences = map[int32]string{
+	1: "EmailEnabled",
+	2: "SmsEnabled",
+	3: "WebhookEnabled",
+	4: "Phone",
+	5: "WebhookUrl",
+	6: "OptOuts",
+	7: "Locale",
+	8: "Currency",
+}
+
+var fieldIDToName_GetNotificationPreferencesReq = map[int32]string{
+	1: "UserId",
+}
+
+var fieldIDToName_GetNotificationPreferencesResp = map[int32]string{
+	1: "Email",
+	2: "Preferences",
+}
+
+var fieldIDToName_UpdateNotificationPreferencesReq = map[int32]string{
+	1: "UserId",
+	2: "Preferences",
+}
+
+var fieldIDToName_UpdateNotificationPreferencesResp = map[int32]string{
+	1: "Preferences",
+}
@@ -13,6 +13,9 @@ import (
 type Client interface {
 	Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error)
 	Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	Delete(ctx context.Context, Req *user.DeleteReq, callOptions ...callopt.Option) (r *user.DeleteResp, err error)
+	GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error)
+	UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -53,3 +56,18 @@ func (p *kUserServiceClient) Login(ctx context.Context, Req *user.LoginReq, call
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Login(ctx, Req)
 }
+
+func (p *kUserServiceClient) Delete(ctx context.Context, Req *user.DeleteReq, callOptions ...callopt.Option) (r *user.DeleteResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Delete(ctx, Req)
+}
+
+func (p *kUserServiceClient) GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetNotificationPreferences(ctx, Req)
+}
+
+func (p *kUserServiceClient) UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateNotificationPreferences(ctx, Req)
+}
@@ -21,8 +21,11 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "UserService"
 	handlerType := (*user.UserService)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"Register": kitex.NewMethodInfo(registerHandler, newRegisterArgs, newRegisterResult, false),
-		"Login":    kitex.NewMethodInfo(loginHandler, newLoginArgs, newLoginResult, false),
+		"Register":                      kitex.NewMethodInfo(registerHandler, newRegisterArgs, newRegisterResult, false),
+		"Login":                         kitex.NewMethodInfo(loginHandler, newLoginArgs, newLoginResult, false),
+		"Delete":                        kitex.NewMethodInfo(deleteHandler, newDeleteArgs, newDeleteResult, false),
+		"GetNotificationPreferences":    kitex.NewMethodInfo(getNotificationPreferencesHandler, newGetNotificationPreferencesArgs, newGetNotificationPreferencesResult, false),
+		"UpdateNotificationPreferences": kitex.NewMethodInfo(updateNotificationPreferencesHandler, newUpdateNotificationPreferencesArgs, newUpdateNotificationPreferencesResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName":     "user",
@@ -345,6 +348,465 @@ func (p *LoginResult) GetResult() interface{} {
 	return p.Success
 }
 
+func deleteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.DeleteReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).Delete(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *DeleteArgs:
+		success, err := handler.(user.UserService).Delete(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newDeleteArgs() interface{} {
+	return &DeleteArgs{}
+}
+
+func newDeleteResult() interface{} {
+	return &DeleteResult{}
+}
+
+type DeleteArgs struct {
+	Req *user.DeleteReq
+}
+
+func (p *DeleteArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.DeleteReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *DeleteArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *DeleteArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *DeleteArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteArgs) Unmarshal(in []byte) error {
+	msg := new(user.DeleteReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteArgs_Req_DEFAULT *user.DeleteReq
+
+func (p *DeleteArgs) GetReq() *user.DeleteReq {
+	if !p.IsSetReq() {
+		return DeleteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteResult struct {
+	Success *user.DeleteResp
+}
+
+var DeleteResult_Success_DEFAULT *user.DeleteResp
+
+func (p *DeleteResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.DeleteResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *DeleteResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *DeleteResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *DeleteResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteResult) Unmarshal(in []byte) error {
+	msg := new(user.DeleteResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteResult) GetSuccess() *user.DeleteResp {
+	if !p.IsSetSuccess() {
+		return DeleteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.DeleteResp)
+}
+
+func (p *DeleteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getNotificationPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.GetNotificationPreferencesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).GetNotificationPreferences(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *GetNotificationPreferencesArgs:
+		success, err := handler.(user.UserService).GetNotificationPreferences(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetNotificationPreferencesResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newGetNotificationPreferencesArgs() interface{} {
+	return &GetNotificationPreferencesArgs{}
+}
+
+func newGetNotificationPreferencesResult() interface{} {
+	return &GetNotificationPreferencesResult{}
+}
+
+type GetNotificationPreferencesArgs struct {
+	Req *user.GetNotificationPreferencesReq
+}
+
+func (p *GetNotificationPreferencesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.GetNotificationPreferencesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *GetNotificationPreferencesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *GetNotificationPreferencesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *GetNotificationPreferencesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetNotificationPreferencesArgs) Unmarshal(in []byte) error {
+	msg := new(user.GetNotificationPreferencesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetNotificationPreferencesArgs_Req_DEFAULT *user.GetNotificationPreferencesReq
+
+func (p *GetNotificationPreferencesArgs) GetReq() *user.GetNotificationPreferencesReq {
+	if !p.IsSetReq() {
+		return GetNotificationPreferencesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetNotificationPreferencesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetNotificationPreferencesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetNotificationPreferencesResult struct {
+	Success *user.GetNotificationPreferencesResp
+}
+
+var GetNotificationPreferencesResult_Success_DEFAULT *user.GetNotificationPreferencesResp
+
+func (p *GetNotificationPreferencesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.GetNotificationPreferencesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *GetNotificationPreferencesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *GetNotificationPreferencesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *GetNotificationPreferencesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetNotificationPreferencesResult) Unmarshal(in []byte) error {
+	msg := new(user.GetNotificationPreferencesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetNotificationPreferencesResult) GetSuccess() *user.GetNotificationPreferencesResp {
+	if !p.IsSetSuccess() {
+		return GetNotificationPreferencesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetNotificationPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.GetNotificationPreferencesResp)
+}
+
+func (p *GetNotificationPreferencesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetNotificationPreferencesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateNotificationPreferencesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.UpdateNotificationPreferencesReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).UpdateNotificationPreferences(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := st.SendMsg(resp); err != nil {
+			return err
+		}
+	case *UpdateNotificationPreferencesArgs:
+		success, err := handler.(user.UserService).UpdateNotificationPreferences(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateNotificationPreferencesResult)
+		realResult.Success = success
+	}
+	return nil
+}
+func newUpdateNotificationPreferencesArgs() interface{} {
+	return &UpdateNotificationPreferencesArgs{}
+}
+
+func newUpdateNotificationPreferencesResult() interface{} {
+	return &UpdateNotificationPreferencesResult{}
+}
+
+type UpdateNotificationPreferencesArgs struct {
+	Req *user.UpdateNotificationPreferencesReq
+}
+
+func (p *UpdateNotificationPreferencesArgs) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetReq() {
+		p.Req = new(user.UpdateNotificationPreferencesReq)
+	}
+	return p.Req.FastRead(buf, _type, number)
+}
+
+func (p *UpdateNotificationPreferencesArgs) FastWrite(buf []byte) (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.FastWrite(buf)
+}
+
+func (p *UpdateNotificationPreferencesArgs) Size() (n int) {
+	if !p.IsSetReq() {
+		return 0
+	}
+	return p.Req.Size()
+}
+
+func (p *UpdateNotificationPreferencesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateNotificationPreferencesArgs) Unmarshal(in []byte) error {
+	msg := new(user.UpdateNotificationPreferencesReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateNotificationPreferencesArgs_Req_DEFAULT *user.UpdateNotificationPreferencesReq
+
+func (p *UpdateNotificationPreferencesArgs) GetReq() *user.UpdateNotificationPreferencesReq {
+	if !p.IsSetReq() {
+		return UpdateNotificationPreferencesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateNotificationPreferencesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateNotificationPreferencesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateNotificationPreferencesResult struct {
+	Success *user.UpdateNotificationPreferencesResp
+}
+
+var UpdateNotificationPreferencesResult_Success_DEFAULT *user.UpdateNotificationPreferencesResp
+
+func (p *UpdateNotificationPreferencesResult) FastRead(buf []byte, _type int8, number int32) (n int, err error) {
+	if !p.IsSetSuccess() {
+		p.Success = new(user.UpdateNotificationPreferencesResp)
+	}
+	return p.Success.FastRead(buf, _type, number)
+}
+
+func (p *UpdateNotificationPreferencesResult) FastWrite(buf []byte) (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.FastWrite(buf)
+}
+
+func (p *UpdateNotificationPreferencesResult) Size() (n int) {
+	if !p.IsSetSuccess() {
+		return 0
+	}
+	return p.Success.Size()
+}
+
+func (p *UpdateNotificationPreferencesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateNotificationPreferencesResult) Unmarshal(in []byte) error {
+	msg := new(user.UpdateNotificationPreferencesResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateNotificationPreferencesResult) GetSuccess() *user.UpdateNotificationPreferencesResp {
+	if !p.IsSetSuccess() {
+		return UpdateNotificationPreferencesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateNotificationPreferencesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.UpdateNotificationPreferencesResp)
+}
+
+func (p *UpdateNotificationPreferencesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateNotificationPreferencesResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -374,3 +836,33 @@ func (p *kClient) Login(ctx context.Context, Req *user.LoginReq) (r *user.LoginR
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) Delete(ctx context.Context, Req *user.DeleteReq) (r *user.DeleteResp, err error) {
+	var _args DeleteArgs
+	_args.Req = Req
+	var _result DeleteResult
+	if err = p.c.Call(ctx, "Delete", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq) (r *user.GetNotificationPreferencesResp, err error) {
+	var _args GetNotificationPreferencesArgs
+	_args.Req = Req
+	var _result GetNotificationPreferencesResult
+	if err = p.c.Call(ctx, "GetNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq) (r *user.UpdateNotificationPreferencesResp, err error) {
+	var _args UpdateNotificationPreferencesArgs
+	_args.Req = Req
+	var _result UpdateNotificationPreferencesResult
+	if err = p.c.Call(ctx, "UpdateNotificationPreferences", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
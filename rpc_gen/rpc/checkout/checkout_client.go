@@ -13,6 +13,10 @@ type RPCClient interface {
 	KitexClient() checkoutservice.Client
 	Service() string
 	Checkout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.CheckoutResp, err error)
+	Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error)
+	ListShippingOptions(ctx context.Context, Req *checkout.ListShippingOptionsReq, callOptions ...callopt.Option) (r *checkout.ListShippingOptionsResp, err error)
+	GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error)
+	ReviewOrder(ctx context.Context, Req *checkout.ReviewOrderReq, callOptions ...callopt.Option) (r *checkout.ReviewOrderResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -44,3 +48,19 @@ func (c *clientImpl) KitexClient() checkoutservice.Client {
 func (c *clientImpl) Checkout(ctx context.Context, Req *checkout.CheckoutReq, callOptions ...callopt.Option) (r *checkout.CheckoutResp, err error) {
 	return c.kitexClient.Checkout(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) Quote(ctx context.Context, Req *checkout.QuoteReq, callOptions ...callopt.Option) (r *checkout.QuoteResp, err error) {
+	return c.kitexClient.Quote(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListShippingOptions(ctx context.Context, Req *checkout.ListShippingOptionsReq, callOptions ...callopt.Option) (r *checkout.ListShippingOptionsResp, err error) {
+	return c.kitexClient.ListShippingOptions(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetCheckoutStatus(ctx context.Context, Req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (r *checkout.GetCheckoutStatusResp, err error) {
+	return c.kitexClient.GetCheckoutStatus(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ReviewOrder(ctx context.Context, Req *checkout.ReviewOrderReq, callOptions ...callopt.Option) (r *checkout.ReviewOrderResp, err error) {
+	return c.kitexClient.ReviewOrder(ctx, Req, callOptions...)
+}
@@ -15,3 +15,39 @@ func Checkout(ctx context.Context, req *checkout.CheckoutReq, callOptions ...cal
 	}
 	return resp, nil
 }
+
+func Quote(ctx context.Context, req *checkout.QuoteReq, callOptions ...callopt.Option) (resp *checkout.QuoteResp, err error) {
+	resp, err = defaultClient.Quote(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Quote call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListShippingOptions(ctx context.Context, req *checkout.ListShippingOptionsReq, callOptions ...callopt.Option) (resp *checkout.ListShippingOptionsResp, err error) {
+	resp, err = defaultClient.ListShippingOptions(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListShippingOptions call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetCheckoutStatus(ctx context.Context, req *checkout.GetCheckoutStatusReq, callOptions ...callopt.Option) (resp *checkout.GetCheckoutStatusResp, err error) {
+	resp, err = defaultClient.GetCheckoutStatus(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetCheckoutStatus call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ReviewOrder(ctx context.Context, req *checkout.ReviewOrderReq, callOptions ...callopt.Option) (resp *checkout.ReviewOrderResp, err error) {
+	resp, err = defaultClient.ReviewOrder(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ReviewOrder call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
@@ -13,6 +13,11 @@ type RPCClient interface {
 	KitexClient() emailservice.Client
 	Service() string
 	Send(ctx context.Context, Req *email.EmailReq, callOptions ...callopt.Option) (r *email.EmailResp, err error)
+	ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error)
+	ReplayDeadLetter(ctx context.Context, Req *email.ReplayDeadLetterReq, callOptions ...callopt.Option) (r *email.ReplayDeadLetterResp, err error)
+	ListEmails(ctx context.Context, Req *email.ListEmailsReq, callOptions ...callopt.Option) (r *email.ListEmailsResp, err error)
+	GetEmailStatus(ctx context.Context, Req *email.GetEmailStatusReq, callOptions ...callopt.Option) (r *email.GetEmailStatusResp, err error)
+	Notify(ctx context.Context, Req *email.NotifyReq, callOptions ...callopt.Option) (r *email.NotifyResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -44,3 +49,23 @@ func (c *clientImpl) KitexClient() emailservice.Client {
 func (c *clientImpl) Send(ctx context.Context, Req *email.EmailReq, callOptions ...callopt.Option) (r *email.EmailResp, err error) {
 	return c.kitexClient.Send(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) ListDeadLetters(ctx context.Context, Req *email.ListDeadLettersReq, callOptions ...callopt.Option) (r *email.ListDeadLettersResp, err error) {
+	return c.kitexClient.ListDeadLetters(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ReplayDeadLetter(ctx context.Context, Req *email.ReplayDeadLetterReq, callOptions ...callopt.Option) (r *email.ReplayDeadLetterResp, err error) {
+	return c.kitexClient.ReplayDeadLetter(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListEmails(ctx context.Context, Req *email.ListEmailsReq, callOptions ...callopt.Option) (r *email.ListEmailsResp, err error) {
+	return c.kitexClient.ListEmails(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetEmailStatus(ctx context.Context, Req *email.GetEmailStatusReq, callOptions ...callopt.Option) (r *email.GetEmailStatusResp, err error) {
+	return c.kitexClient.GetEmailStatus(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) Notify(ctx context.Context, Req *email.NotifyReq, callOptions ...callopt.Option) (r *email.NotifyResp, err error) {
+	return c.kitexClient.Notify(ctx, Req, callOptions...)
+}
@@ -15,3 +15,48 @@ func Send(ctx context.Context, req *email.EmailReq, callOptions ...callopt.Optio
 	}
 	return resp, nil
 }
+
+func ListDeadLetters(ctx context.Context, req *email.ListDeadLettersReq, callOptions ...callopt.Option) (resp *email.ListDeadLettersResp, err error) {
+	resp, err = defaultClient.ListDeadLetters(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListDeadLetters call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ReplayDeadLetter(ctx context.Context, req *email.ReplayDeadLetterReq, callOptions ...callopt.Option) (resp *email.ReplayDeadLetterResp, err error) {
+	resp, err = defaultClient.ReplayDeadLetter(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ReplayDeadLetter call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListEmails(ctx context.Context, req *email.ListEmailsReq, callOptions ...callopt.Option) (resp *email.ListEmailsResp, err error) {
+	resp, err = defaultClient.ListEmails(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListEmails call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetEmailStatus(ctx context.Context, req *email.GetEmailStatusReq, callOptions ...callopt.Option) (resp *email.GetEmailStatusResp, err error) {
+	resp, err = defaultClient.GetEmailStatus(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetEmailStatus call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func Notify(ctx context.Context, req *email.NotifyReq, callOptions ...callopt.Option) (resp *email.NotifyResp, err error) {
+	resp, err = defaultClient.Notify(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Notify call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
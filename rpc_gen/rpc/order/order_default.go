@@ -34,3 +34,21 @@ func MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq, callOptions
 	}
 	return resp, nil
 }
+
+func HasPurchased(ctx context.Context, req *order.HasPurchasedReq, callOptions ...callopt.Option) (resp *order.HasPurchasedResp, err error) {
+	resp, err = defaultClient.HasPurchased(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "HasPurchased call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func CancelOrder(ctx context.Context, req *order.CancelOrderReq, callOptions ...callopt.Option) (resp *order.CancelOrderResp, err error) {
+	resp, err = defaultClient.CancelOrder(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CancelOrder call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
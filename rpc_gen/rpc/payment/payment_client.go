@@ -13,6 +13,15 @@ type RPCClient interface {
 	KitexClient() paymentservice.Client
 	Service() string
 	Charge(ctx context.Context, Req *payment.ChargeReq, callOptions ...callopt.Option) (r *payment.ChargeResp, err error)
+	Capture(ctx context.Context, Req *payment.CaptureReq, callOptions ...callopt.Option) (r *payment.CaptureResp, err error)
+	Void(ctx context.Context, Req *payment.VoidReq, callOptions ...callopt.Option) (r *payment.VoidResp, err error)
+	Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error)
+	IssueGiftCard(ctx context.Context, Req *payment.IssueGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueGiftCardResp, err error)
+	GetGiftCard(ctx context.Context, Req *payment.GetGiftCardReq, callOptions ...callopt.Option) (r *payment.GetGiftCardResp, err error)
+	RedeemGiftCard(ctx context.Context, Req *payment.RedeemGiftCardReq, callOptions ...callopt.Option) (r *payment.RedeemGiftCardResp, err error)
+	GetWallets(ctx context.Context, Req *payment.GetWalletsReq, callOptions ...callopt.Option) (r *payment.GetWalletsResp, err error)
+	GetPayment(ctx context.Context, Req *payment.GetPaymentReq, callOptions ...callopt.Option) (r *payment.GetPaymentResp, err error)
+	IssueStoreGiftCard(ctx context.Context, Req *payment.IssueStoreGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueStoreGiftCardResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -44,3 +53,39 @@ func (c *clientImpl) KitexClient() paymentservice.Client {
 func (c *clientImpl) Charge(ctx context.Context, Req *payment.ChargeReq, callOptions ...callopt.Option) (r *payment.ChargeResp, err error) {
 	return c.kitexClient.Charge(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) Capture(ctx context.Context, Req *payment.CaptureReq, callOptions ...callopt.Option) (r *payment.CaptureResp, err error) {
+	return c.kitexClient.Capture(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) Void(ctx context.Context, Req *payment.VoidReq, callOptions ...callopt.Option) (r *payment.VoidResp, err error) {
+	return c.kitexClient.Void(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) Refund(ctx context.Context, Req *payment.RefundReq, callOptions ...callopt.Option) (r *payment.RefundResp, err error) {
+	return c.kitexClient.Refund(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) IssueGiftCard(ctx context.Context, Req *payment.IssueGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueGiftCardResp, err error) {
+	return c.kitexClient.IssueGiftCard(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetGiftCard(ctx context.Context, Req *payment.GetGiftCardReq, callOptions ...callopt.Option) (r *payment.GetGiftCardResp, err error) {
+	return c.kitexClient.GetGiftCard(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) RedeemGiftCard(ctx context.Context, Req *payment.RedeemGiftCardReq, callOptions ...callopt.Option) (r *payment.RedeemGiftCardResp, err error) {
+	return c.kitexClient.RedeemGiftCard(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetWallets(ctx context.Context, Req *payment.GetWalletsReq, callOptions ...callopt.Option) (r *payment.GetWalletsResp, err error) {
+	return c.kitexClient.GetWallets(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetPayment(ctx context.Context, Req *payment.GetPaymentReq, callOptions ...callopt.Option) (r *payment.GetPaymentResp, err error) {
+	return c.kitexClient.GetPayment(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) IssueStoreGiftCard(ctx context.Context, Req *payment.IssueStoreGiftCardReq, callOptions ...callopt.Option) (r *payment.IssueStoreGiftCardResp, err error) {
+	return c.kitexClient.IssueStoreGiftCard(ctx, Req, callOptions...)
+}
@@ -15,3 +15,84 @@ func Charge(ctx context.Context, req *payment.ChargeReq, callOptions ...callopt.
 	}
 	return resp, nil
 }
+
+func Capture(ctx context.Context, req *payment.CaptureReq, callOptions ...callopt.Option) (resp *payment.CaptureResp, err error) {
+	resp, err = defaultClient.Capture(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Capture call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func Void(ctx context.Context, req *payment.VoidReq, callOptions ...callopt.Option) (resp *payment.VoidResp, err error) {
+	resp, err = defaultClient.Void(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Void call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func Refund(ctx context.Context, req *payment.RefundReq, callOptions ...callopt.Option) (resp *payment.RefundResp, err error) {
+	resp, err = defaultClient.Refund(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Refund call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func IssueGiftCard(ctx context.Context, req *payment.IssueGiftCardReq, callOptions ...callopt.Option) (resp *payment.IssueGiftCardResp, err error) {
+	resp, err = defaultClient.IssueGiftCard(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "IssueGiftCard call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetGiftCard(ctx context.Context, req *payment.GetGiftCardReq, callOptions ...callopt.Option) (resp *payment.GetGiftCardResp, err error) {
+	resp, err = defaultClient.GetGiftCard(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetGiftCard call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func RedeemGiftCard(ctx context.Context, req *payment.RedeemGiftCardReq, callOptions ...callopt.Option) (resp *payment.RedeemGiftCardResp, err error) {
+	resp, err = defaultClient.RedeemGiftCard(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "RedeemGiftCard call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetWallets(ctx context.Context, req *payment.GetWalletsReq, callOptions ...callopt.Option) (resp *payment.GetWalletsResp, err error) {
+	resp, err = defaultClient.GetWallets(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetWallets call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetPayment(ctx context.Context, req *payment.GetPaymentReq, callOptions ...callopt.Option) (resp *payment.GetPaymentResp, err error) {
+	resp, err = defaultClient.GetPayment(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetPayment call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func IssueStoreGiftCard(ctx context.Context, req *payment.IssueStoreGiftCardReq, callOptions ...callopt.Option) (resp *payment.IssueStoreGiftCardResp, err error) {
+	resp, err = defaultClient.IssueStoreGiftCard(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "IssueStoreGiftCard call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
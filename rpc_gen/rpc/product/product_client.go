@@ -18,6 +18,24 @@ type RPCClient interface {
 	CreateProduct(ctx context.Context, Req *product.CreateProductReq, callOptions ...callopt.Option) (r *product.CreateProductResp, err error)
 	UpdateProduct(ctx context.Context, Req *product.UpdateProductReq, callOptions ...callopt.Option) (r *product.UpdateProductResp, err error)
 	DeleteProduct(ctx context.Context, Req *product.DeleteProductReq, callOptions ...callopt.Option) (r *product.DeleteProductResp, err error)
+	ReserveStock(ctx context.Context, Req *product.ReserveStockReq, callOptions ...callopt.Option) (r *product.ReserveStockResp, err error)
+	ReleaseStock(ctx context.Context, Req *product.ReleaseStockReq, callOptions ...callopt.Option) (r *product.ReleaseStockResp, err error)
+	CreateCategory(ctx context.Context, Req *product.CreateCategoryReq, callOptions ...callopt.Option) (r *product.CreateCategoryResp, err error)
+	UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq, callOptions ...callopt.Option) (r *product.UpdateCategoryResp, err error)
+	DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq, callOptions ...callopt.Option) (r *product.DeleteCategoryResp, err error)
+	ListCategoryTree(ctx context.Context, Req *product.ListCategoryTreeReq, callOptions ...callopt.Option) (r *product.ListCategoryTreeResp, err error)
+	BatchGetProducts(ctx context.Context, Req *product.BatchGetProductsReq, callOptions ...callopt.Option) (r *product.BatchGetProductsResp, err error)
+	CreateReview(ctx context.Context, Req *product.CreateReviewReq, callOptions ...callopt.Option) (r *product.CreateReviewResp, err error)
+	ListReviews(ctx context.Context, Req *product.ListReviewsReq, callOptions ...callopt.Option) (r *product.ListReviewsResp, err error)
+	ModerateReview(ctx context.Context, Req *product.ModerateReviewReq, callOptions ...callopt.Option) (r *product.ModerateReviewResp, err error)
+	ImportProducts(ctx context.Context, Req *product.ImportProductsReq, callOptions ...callopt.Option) (r *product.ImportProductsResp, err error)
+	ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...callopt.Option) (r *product.ExportProductsResp, err error)
+	UploadProductImage(ctx context.Context, Req *product.UploadProductImageReq, callOptions ...callopt.Option) (r *product.UploadProductImageResp, err error)
+	UpdateProductImage(ctx context.Context, Req *product.UpdateProductImageReq, callOptions ...callopt.Option) (r *product.UpdateProductImageResp, err error)
+	DeleteProductImage(ctx context.Context, Req *product.DeleteProductImageReq, callOptions ...callopt.Option) (r *product.DeleteProductImageResp, err error)
+	GetImage(ctx context.Context, Req *product.GetImageReq, callOptions ...callopt.Option) (r *product.GetImageResp, err error)
+	ScheduleProductPrice(ctx context.Context, Req *product.ScheduleProductPriceReq, callOptions ...callopt.Option) (r *product.ScheduleProductPriceResp, err error)
+	ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryReq, callOptions ...callopt.Option) (r *product.ListPriceHistoryResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -69,3 +87,75 @@ func (c *clientImpl) UpdateProduct(ctx context.Context, Req *product.UpdateProdu
 func (c *clientImpl) DeleteProduct(ctx context.Context, Req *product.DeleteProductReq, callOptions ...callopt.Option) (r *product.DeleteProductResp, err error) {
 	return c.kitexClient.DeleteProduct(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) ReserveStock(ctx context.Context, Req *product.ReserveStockReq, callOptions ...callopt.Option) (r *product.ReserveStockResp, err error) {
+	return c.kitexClient.ReserveStock(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ReleaseStock(ctx context.Context, Req *product.ReleaseStockReq, callOptions ...callopt.Option) (r *product.ReleaseStockResp, err error) {
+	return c.kitexClient.ReleaseStock(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) CreateCategory(ctx context.Context, Req *product.CreateCategoryReq, callOptions ...callopt.Option) (r *product.CreateCategoryResp, err error) {
+	return c.kitexClient.CreateCategory(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UpdateCategory(ctx context.Context, Req *product.UpdateCategoryReq, callOptions ...callopt.Option) (r *product.UpdateCategoryResp, err error) {
+	return c.kitexClient.UpdateCategory(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) DeleteCategory(ctx context.Context, Req *product.DeleteCategoryReq, callOptions ...callopt.Option) (r *product.DeleteCategoryResp, err error) {
+	return c.kitexClient.DeleteCategory(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListCategoryTree(ctx context.Context, Req *product.ListCategoryTreeReq, callOptions ...callopt.Option) (r *product.ListCategoryTreeResp, err error) {
+	return c.kitexClient.ListCategoryTree(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) BatchGetProducts(ctx context.Context, Req *product.BatchGetProductsReq, callOptions ...callopt.Option) (r *product.BatchGetProductsResp, err error) {
+	return c.kitexClient.BatchGetProducts(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) CreateReview(ctx context.Context, Req *product.CreateReviewReq, callOptions ...callopt.Option) (r *product.CreateReviewResp, err error) {
+	return c.kitexClient.CreateReview(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListReviews(ctx context.Context, Req *product.ListReviewsReq, callOptions ...callopt.Option) (r *product.ListReviewsResp, err error) {
+	return c.kitexClient.ListReviews(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ModerateReview(ctx context.Context, Req *product.ModerateReviewReq, callOptions ...callopt.Option) (r *product.ModerateReviewResp, err error) {
+	return c.kitexClient.ModerateReview(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ImportProducts(ctx context.Context, Req *product.ImportProductsReq, callOptions ...callopt.Option) (r *product.ImportProductsResp, err error) {
+	return c.kitexClient.ImportProducts(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ExportProducts(ctx context.Context, Req *product.ExportProductsReq, callOptions ...callopt.Option) (r *product.ExportProductsResp, err error) {
+	return c.kitexClient.ExportProducts(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UploadProductImage(ctx context.Context, Req *product.UploadProductImageReq, callOptions ...callopt.Option) (r *product.UploadProductImageResp, err error) {
+	return c.kitexClient.UploadProductImage(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UpdateProductImage(ctx context.Context, Req *product.UpdateProductImageReq, callOptions ...callopt.Option) (r *product.UpdateProductImageResp, err error) {
+	return c.kitexClient.UpdateProductImage(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) DeleteProductImage(ctx context.Context, Req *product.DeleteProductImageReq, callOptions ...callopt.Option) (r *product.DeleteProductImageResp, err error) {
+	return c.kitexClient.DeleteProductImage(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetImage(ctx context.Context, Req *product.GetImageReq, callOptions ...callopt.Option) (r *product.GetImageResp, err error) {
+	return c.kitexClient.GetImage(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ScheduleProductPrice(ctx context.Context, Req *product.ScheduleProductPriceReq, callOptions ...callopt.Option) (r *product.ScheduleProductPriceResp, err error) {
+	return c.kitexClient.ScheduleProductPrice(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryReq, callOptions ...callopt.Option) (r *product.ListPriceHistoryResp, err error) {
+	return c.kitexClient.ListPriceHistory(ctx, Req, callOptions...)
+}
@@ -60,3 +60,165 @@ func DeleteProduct(ctx context.Context, req *product.DeleteProductReq, callOptio
 	}
 	return resp, nil
 }
+
+func ReserveStock(ctx context.Context, req *product.ReserveStockReq, callOptions ...callopt.Option) (resp *product.ReserveStockResp, err error) {
+	resp, err = defaultClient.ReserveStock(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ReserveStock call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ReleaseStock(ctx context.Context, req *product.ReleaseStockReq, callOptions ...callopt.Option) (resp *product.ReleaseStockResp, err error) {
+	resp, err = defaultClient.ReleaseStock(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ReleaseStock call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func CreateCategory(ctx context.Context, req *product.CreateCategoryReq, callOptions ...callopt.Option) (resp *product.CreateCategoryResp, err error) {
+	resp, err = defaultClient.CreateCategory(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CreateCategory call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UpdateCategory(ctx context.Context, req *product.UpdateCategoryReq, callOptions ...callopt.Option) (resp *product.UpdateCategoryResp, err error) {
+	resp, err = defaultClient.UpdateCategory(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UpdateCategory call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func DeleteCategory(ctx context.Context, req *product.DeleteCategoryReq, callOptions ...callopt.Option) (resp *product.DeleteCategoryResp, err error) {
+	resp, err = defaultClient.DeleteCategory(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "DeleteCategory call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListCategoryTree(ctx context.Context, req *product.ListCategoryTreeReq, callOptions ...callopt.Option) (resp *product.ListCategoryTreeResp, err error) {
+	resp, err = defaultClient.ListCategoryTree(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListCategoryTree call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func BatchGetProducts(ctx context.Context, req *product.BatchGetProductsReq, callOptions ...callopt.Option) (resp *product.BatchGetProductsResp, err error) {
+	resp, err = defaultClient.BatchGetProducts(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "BatchGetProducts call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func CreateReview(ctx context.Context, req *product.CreateReviewReq, callOptions ...callopt.Option) (resp *product.CreateReviewResp, err error) {
+	resp, err = defaultClient.CreateReview(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CreateReview call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListReviews(ctx context.Context, req *product.ListReviewsReq, callOptions ...callopt.Option) (resp *product.ListReviewsResp, err error) {
+	resp, err = defaultClient.ListReviews(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListReviews call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ModerateReview(ctx context.Context, req *product.ModerateReviewReq, callOptions ...callopt.Option) (resp *product.ModerateReviewResp, err error) {
+	resp, err = defaultClient.ModerateReview(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ModerateReview call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ImportProducts(ctx context.Context, req *product.ImportProductsReq, callOptions ...callopt.Option) (resp *product.ImportProductsResp, err error) {
+	resp, err = defaultClient.ImportProducts(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ImportProducts call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ExportProducts(ctx context.Context, req *product.ExportProductsReq, callOptions ...callopt.Option) (resp *product.ExportProductsResp, err error) {
+	resp, err = defaultClient.ExportProducts(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ExportProducts call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UploadProductImage(ctx context.Context, req *product.UploadProductImageReq, callOptions ...callopt.Option) (resp *product.UploadProductImageResp, err error) {
+	resp, err = defaultClient.UploadProductImage(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UploadProductImage call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UpdateProductImage(ctx context.Context, req *product.UpdateProductImageReq, callOptions ...callopt.Option) (resp *product.UpdateProductImageResp, err error) {
+	resp, err = defaultClient.UpdateProductImage(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UpdateProductImage call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func DeleteProductImage(ctx context.Context, req *product.DeleteProductImageReq, callOptions ...callopt.Option) (resp *product.DeleteProductImageResp, err error) {
+	resp, err = defaultClient.DeleteProductImage(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "DeleteProductImage call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetImage(ctx context.Context, req *product.GetImageReq, callOptions ...callopt.Option) (resp *product.GetImageResp, err error) {
+	resp, err = defaultClient.GetImage(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetImage call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ScheduleProductPrice(ctx context.Context, req *product.ScheduleProductPriceReq, callOptions ...callopt.Option) (resp *product.ScheduleProductPriceResp, err error) {
+	resp, err = defaultClient.ScheduleProductPrice(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ScheduleProductPrice call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListPriceHistory(ctx context.Context, req *product.ListPriceHistoryReq, callOptions ...callopt.Option) (resp *product.ListPriceHistoryResp, err error) {
+	resp, err = defaultClient.ListPriceHistory(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListPriceHistory call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
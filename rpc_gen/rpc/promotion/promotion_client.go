@@ -0,0 +1,66 @@
+package promotion
+
+import (
+	"context"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+
+	"github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion/promotionservice"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/client/callopt"
+)
+
+type RPCClient interface {
+	KitexClient() promotionservice.Client
+	Service() string
+	CreatePromotion(ctx context.Context, Req *promotion.CreatePromotionReq, callOptions ...callopt.Option) (r *promotion.CreatePromotionResp, err error)
+	ListPromotions(ctx context.Context, Req *promotion.ListPromotionsReq, callOptions ...callopt.Option) (r *promotion.ListPromotionsResp, err error)
+	PreviewDiscount(ctx context.Context, Req *promotion.PreviewDiscountReq, callOptions ...callopt.Option) (r *promotion.PreviewDiscountResp, err error)
+	ApplyCoupon(ctx context.Context, Req *promotion.ApplyCouponReq, callOptions ...callopt.Option) (r *promotion.ApplyCouponResp, err error)
+	ReleaseRedemptions(ctx context.Context, Req *promotion.ReleaseRedemptionsReq, callOptions ...callopt.Option) (r *promotion.ReleaseRedemptionsResp, err error)
+}
+
+func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
+	kitexClient, err := promotionservice.NewClient(dstService, opts...)
+	if err != nil {
+		return nil, err
+	}
+	cli := &clientImpl{
+		service:     dstService,
+		kitexClient: kitexClient,
+	}
+
+	return cli, nil
+}
+
+type clientImpl struct {
+	service     string
+	kitexClient promotionservice.Client
+}
+
+func (c *clientImpl) Service() string {
+	return c.service
+}
+
+func (c *clientImpl) KitexClient() promotionservice.Client {
+	return c.kitexClient
+}
+
+func (c *clientImpl) CreatePromotion(ctx context.Context, Req *promotion.CreatePromotionReq, callOptions ...callopt.Option) (r *promotion.CreatePromotionResp, err error) {
+	return c.kitexClient.CreatePromotion(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ListPromotions(ctx context.Context, Req *promotion.ListPromotionsReq, callOptions ...callopt.Option) (r *promotion.ListPromotionsResp, err error) {
+	return c.kitexClient.ListPromotions(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) PreviewDiscount(ctx context.Context, Req *promotion.PreviewDiscountReq, callOptions ...callopt.Option) (r *promotion.PreviewDiscountResp, err error) {
+	return c.kitexClient.PreviewDiscount(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ApplyCoupon(ctx context.Context, Req *promotion.ApplyCouponReq, callOptions ...callopt.Option) (r *promotion.ApplyCouponResp, err error) {
+	return c.kitexClient.ApplyCoupon(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) ReleaseRedemptions(ctx context.Context, Req *promotion.ReleaseRedemptionsReq, callOptions ...callopt.Option) (r *promotion.ReleaseRedemptionsResp, err error) {
+	return c.kitexClient.ReleaseRedemptions(ctx, Req, callOptions...)
+}
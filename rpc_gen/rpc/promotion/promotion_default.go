@@ -0,0 +1,53 @@
+package promotion
+
+import (
+	"context"
+	promotion "github.com/cloudwego/biz-demo/gomall/rpc_gen/kitex_gen/promotion"
+	"github.com/cloudwego/kitex/client/callopt"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+func CreatePromotion(ctx context.Context, req *promotion.CreatePromotionReq, callOptions ...callopt.Option) (resp *promotion.CreatePromotionResp, err error) {
+	resp, err = defaultClient.CreatePromotion(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "CreatePromotion call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ListPromotions(ctx context.Context, req *promotion.ListPromotionsReq, callOptions ...callopt.Option) (resp *promotion.ListPromotionsResp, err error) {
+	resp, err = defaultClient.ListPromotions(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ListPromotions call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func PreviewDiscount(ctx context.Context, req *promotion.PreviewDiscountReq, callOptions ...callopt.Option) (resp *promotion.PreviewDiscountResp, err error) {
+	resp, err = defaultClient.PreviewDiscount(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "PreviewDiscount call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ApplyCoupon(ctx context.Context, req *promotion.ApplyCouponReq, callOptions ...callopt.Option) (resp *promotion.ApplyCouponResp, err error) {
+	resp, err = defaultClient.ApplyCoupon(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ApplyCoupon call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ReleaseRedemptions(ctx context.Context, req *promotion.ReleaseRedemptionsReq, callOptions ...callopt.Option) (resp *promotion.ReleaseRedemptionsResp, err error) {
+	resp, err = defaultClient.ReleaseRedemptions(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "ReleaseRedemptions call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
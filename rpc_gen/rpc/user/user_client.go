@@ -14,6 +14,9 @@ type RPCClient interface {
 	Service() string
 	Register(ctx context.Context, Req *user.RegisterReq, callOptions ...callopt.Option) (r *user.RegisterResp, err error)
 	Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error)
+	Delete(ctx context.Context, Req *user.DeleteReq, callOptions ...callopt.Option) (r *user.DeleteResp, err error)
+	GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error)
+	UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error)
 }
 
 func NewRPCClient(dstService string, opts ...client.Option) (RPCClient, error) {
@@ -49,3 +52,15 @@ func (c *clientImpl) Register(ctx context.Context, Req *user.RegisterReq, callOp
 func (c *clientImpl) Login(ctx context.Context, Req *user.LoginReq, callOptions ...callopt.Option) (r *user.LoginResp, err error) {
 	return c.kitexClient.Login(ctx, Req, callOptions...)
 }
+
+func (c *clientImpl) Delete(ctx context.Context, Req *user.DeleteReq, callOptions ...callopt.Option) (r *user.DeleteResp, err error) {
+	return c.kitexClient.Delete(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) GetNotificationPreferences(ctx context.Context, Req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.GetNotificationPreferencesResp, err error) {
+	return c.kitexClient.GetNotificationPreferences(ctx, Req, callOptions...)
+}
+
+func (c *clientImpl) UpdateNotificationPreferences(ctx context.Context, Req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (r *user.UpdateNotificationPreferencesResp, err error) {
+	return c.kitexClient.UpdateNotificationPreferences(ctx, Req, callOptions...)
+}
@@ -24,3 +24,30 @@ func Login(ctx context.Context, req *user.LoginReq, callOptions ...callopt.Optio
 	}
 	return resp, nil
 }
+
+func Delete(ctx context.Context, req *user.DeleteReq, callOptions ...callopt.Option) (resp *user.DeleteResp, err error) {
+	resp, err = defaultClient.Delete(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "Delete call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func GetNotificationPreferences(ctx context.Context, req *user.GetNotificationPreferencesReq, callOptions ...callopt.Option) (resp *user.GetNotificationPreferencesResp, err error) {
+	resp, err = defaultClient.GetNotificationPreferences(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "GetNotificationPreferences call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func UpdateNotificationPreferences(ctx context.Context, req *user.UpdateNotificationPreferencesReq, callOptions ...callopt.Option) (resp *user.UpdateNotificationPreferencesResp, err error) {
+	resp, err = defaultClient.UpdateNotificationPreferences(ctx, req, callOptions...)
+	if err != nil {
+		klog.CtxErrorf(ctx, "UpdateNotificationPreferences call failed,err =%+v", err)
+		return nil, err
+	}
+	return resp, nil
+}